@@ -1,6 +1,7 @@
 package main
 
 import (
+	"expvar"
 	"flag"
 	"fmt"
 	"log"
@@ -10,6 +11,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
@@ -39,20 +41,38 @@ type config struct {
 	DataPath         string
 	ClientExportPath string
 	AdminPassword    string
+	TickRate         int
+	PacketsPerTick   int
+	SessionGrace     int
+	SqlConsole       string
+	SqlTimeout       int
+	SqlMaxRows       int
+	ChatLogRetention int
+	DayLength        int
+	MetricsAddr      string
 }
 
 func loadConfig() *config {
 	cfg := &config{
-		PgHost:        os.Getenv("PG_HOST"),
-		PgPort:        5432,
-		PgUser:        os.Getenv("PG_USER"),
-		PgPassword:    os.Getenv("PG_PASSWORD"),
-		PgDatabase:    os.Getenv("PG_DATABASE"),
-		Port:          43200,
-		DataPath:      coalescePaths(os.Getenv("DATA_PATH"), dockerMountedDataDir, "data", "."),
-		CertPath:      os.Getenv("CERT_PATH"),
-		KeyPath:       os.Getenv("KEY_PATH"),
-		AdminPassword: os.Getenv("ADMIN_PASSWORD"),
+		PgHost:           os.Getenv("PG_HOST"),
+		PgPort:           5432,
+		PgUser:           os.Getenv("PG_USER"),
+		PgPassword:       os.Getenv("PG_PASSWORD"),
+		PgDatabase:       os.Getenv("PG_DATABASE"),
+		Port:             43200,
+		DataPath:         coalescePaths(os.Getenv("DATA_PATH"), dockerMountedDataDir, "data", "."),
+		CertPath:         os.Getenv("CERT_PATH"),
+		KeyPath:          os.Getenv("KEY_PATH"),
+		AdminPassword:    os.Getenv("ADMIN_PASSWORD"),
+		TickRate:         10,
+		PacketsPerTick:   1,
+		SessionGrace:     60,
+		SqlConsole:       string(central.SqlConsoleReadOnly),
+		SqlTimeout:       5,
+		SqlMaxRows:       500,
+		ChatLogRetention: 30,
+		DayLength:        60,
+		MetricsAddr:      "127.0.0.1:43201",
 	}
 	cfg.ClientExportPath = coalescePaths(path.Join(cfg.DataPath, "exports", "web"), "../exports/web")
 
@@ -63,6 +83,66 @@ func loadConfig() *config {
 		cfg.PgPort = port
 	}
 
+	tickRate, err := strconv.Atoi(os.Getenv("TICK_RATE"))
+	if err != nil || tickRate <= 0 {
+		log.Printf("Error parsing TICK_RATE, using %d", cfg.TickRate)
+	} else {
+		cfg.TickRate = tickRate
+	}
+
+	packetsPerTick, err := strconv.Atoi(os.Getenv("PACKETS_PER_TICK"))
+	if err != nil || packetsPerTick <= 0 {
+		log.Printf("Error parsing PACKETS_PER_TICK, using %d", cfg.PacketsPerTick)
+	} else {
+		cfg.PacketsPerTick = packetsPerTick
+	}
+
+	sessionGrace, err := strconv.Atoi(os.Getenv("SESSION_GRACE_SECONDS"))
+	if err != nil || sessionGrace < 0 {
+		log.Printf("Error parsing SESSION_GRACE_SECONDS, using %d", cfg.SessionGrace)
+	} else {
+		cfg.SessionGrace = sessionGrace
+	}
+
+	switch sqlConsole := os.Getenv("SQL_CONSOLE"); central.SqlConsoleMode(sqlConsole) {
+	case central.SqlConsoleOff, central.SqlConsoleReadOnly, central.SqlConsoleReadWrite:
+		cfg.SqlConsole = sqlConsole
+	default:
+		log.Printf("Error parsing SQL_CONSOLE, using %s", cfg.SqlConsole)
+	}
+
+	sqlTimeout, err := strconv.Atoi(os.Getenv("SQL_CONSOLE_TIMEOUT_SECONDS"))
+	if err != nil || sqlTimeout <= 0 {
+		log.Printf("Error parsing SQL_CONSOLE_TIMEOUT_SECONDS, using %d", cfg.SqlTimeout)
+	} else {
+		cfg.SqlTimeout = sqlTimeout
+	}
+
+	sqlMaxRows, err := strconv.Atoi(os.Getenv("SQL_CONSOLE_MAX_ROWS"))
+	if err != nil || sqlMaxRows <= 0 {
+		log.Printf("Error parsing SQL_CONSOLE_MAX_ROWS, using %d", cfg.SqlMaxRows)
+	} else {
+		cfg.SqlMaxRows = sqlMaxRows
+	}
+
+	chatLogRetention, err := strconv.Atoi(os.Getenv("CHAT_LOG_RETENTION_DAYS"))
+	if err != nil || chatLogRetention < 0 {
+		log.Printf("Error parsing CHAT_LOG_RETENTION_DAYS, using %d", cfg.ChatLogRetention)
+	} else {
+		cfg.ChatLogRetention = chatLogRetention
+	}
+
+	dayLength, err := strconv.Atoi(os.Getenv("DAY_LENGTH_MINUTES"))
+	if err != nil || dayLength <= 0 {
+		log.Printf("Error parsing DAY_LENGTH_MINUTES, using %d", cfg.DayLength)
+	} else {
+		cfg.DayLength = dayLength
+	}
+
+	if metricsAddr, exists := os.LookupEnv("METRICS_ADDR"); exists {
+		cfg.MetricsAddr = metricsAddr
+	}
+
 	port, err = strconv.Atoi(os.Getenv("PORT"))
 	if err != nil {
 		log.Printf("Error parsing PORT, using %d", cfg.Port)
@@ -117,7 +197,18 @@ func main() {
 		cfg.PgHost, cfg.PgPort, cfg.PgUser, cfg.PgPassword, cfg.PgDatabase,
 	)
 
-	hub := central.NewHub(cfg.DataPath, pgConnString)
+	hub := central.NewHub(cfg.DataPath, pgConnString, central.SimulationConfig{
+		TickRate:       cfg.TickRate,
+		PacketsPerTick: cfg.PacketsPerTick,
+	})
+	hub.SessionGracePeriod = time.Duration(cfg.SessionGrace) * time.Second
+	hub.SqlConsole = central.SqlConsoleConfig{
+		Mode:    central.SqlConsoleMode(cfg.SqlConsole),
+		Timeout: time.Duration(cfg.SqlTimeout) * time.Second,
+		MaxRows: cfg.SqlMaxRows,
+	}
+	hub.ChatArchive.Retention = time.Duration(cfg.ChatLogRetention) * 24 * time.Hour
+	hub.WorldClock.DayLength = time.Duration(cfg.DayLength) * time.Minute
 
 	// Create dummy clients for NPCs
 	npcClients := make(map[int]central.ClientInterfacer)
@@ -144,8 +235,12 @@ func main() {
 
 	hub.SetNpcClients(npcClients)
 
+	// The game gets its own mux, since packages like expvar register handlers on the default one that players
+	// shouldn't see
+	mux := http.NewServeMux()
+
 	// Define handler for WebSocket connections
-	http.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
 		hub.Serve(conn.NewWebSocketClient, w, r)
 	})
 
@@ -156,12 +251,16 @@ func main() {
 		}
 	} else {
 		log.Printf("Serving HTML5 export from %s", cfg.ClientExportPath)
-		http.Handle("/", addHeaders(http.StripPrefix("/", http.FileServer(http.Dir(cfg.ClientExportPath)))))
+		mux.Handle("/", addHeaders(http.StripPrefix("/", http.FileServer(http.Dir(cfg.ClientExportPath)))))
 	}
 
 	// Start the server
 	go hub.Run(cfg.AdminPassword)
 
+	if cfg.MetricsAddr != "" {
+		go serveMetrics(cfg.MetricsAddr)
+	}
+
 	addr := fmt.Sprintf(":%d", cfg.Port)
 
 	log.Printf("Starting server on %s", addr)
@@ -171,17 +270,29 @@ func main() {
 
 	// Actually start the server
 	log.Printf("Using cert at %s and key at %s", cfg.CertPath, cfg.KeyPath)
-	err = http.ListenAndServeTLS(addr, cfg.CertPath, cfg.KeyPath, nil)
+	err = http.ListenAndServeTLS(addr, cfg.CertPath, cfg.KeyPath, mux)
 
 	if err != nil {
 		log.Printf("No certificate found (%v), starting server without TLS", err)
-		err = http.ListenAndServe(addr, nil)
+		err = http.ListenAndServe(addr, mux)
 		if err != nil {
 			log.Fatalf("Failed to start server: %v", err)
 		}
 	}
 }
 
+// Serves the counters from expvar at /debug/vars. They give away things like the server's command line and memory use,
+// so they're served on their own address, which should only be reachable by whoever runs the server.
+func serveMetrics(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	log.Printf("Serving metrics on %s", addr)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		log.Printf("Failed to serve metrics: %v", err)
+	}
+}
+
 // Add headers required for the HTML5 export to work with shared array buffers
 func addHeaders(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
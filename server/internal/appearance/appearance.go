@@ -0,0 +1,66 @@
+package appearance
+
+import (
+	"errors"
+	"fmt"
+)
+
+// How an actor looks. Each part is an index into the matching list of options below.
+type Appearance struct {
+	Body    int32
+	Hair    int32
+	Outfit  int32
+	Palette int32
+}
+
+// The options players can choose from, in the order the client lists them
+var Bodies = []string{"Peasant", "Adventurer", "Noble", "Barbarian", "Psychic", "Reverent", "Undead", "Giant", "Seafarer", "Raider"}
+var Hairs = []string{"None", "Short", "Long", "Braided", "Mohawk"}
+var Outfits = []string{"Plain", "Leather", "Robes", "Chainmail"}
+var Palettes = []string{"Natural", "Ashen", "Golden", "Crimson", "Verdant", "Twilight"}
+
+// Each body is an 8x8 tile in a row of the tilemap, which is all older clients know how to draw
+const (
+	bodySpriteRegionX = 32
+	bodySpriteRegionY = 0
+	spriteSize        = 8
+)
+
+// Checks every part of the appearance is one players are allowed to choose
+func Validate(a Appearance) error {
+	if err := validatePart("body", a.Body, Bodies); err != nil {
+		return err
+	}
+	if err := validatePart("hair", a.Hair, Hairs); err != nil {
+		return err
+	}
+	if err := validatePart("outfit", a.Outfit, Outfits); err != nil {
+		return err
+	}
+	return validatePart("palette", a.Palette, Palettes)
+}
+
+func validatePart(name string, index int32, options []string) error {
+	if index < 0 || int(index) >= len(options) {
+		return fmt.Errorf("unknown %s %d", name, index)
+	}
+	return nil
+}
+
+// The region of the tilemap to draw the appearance's body from
+func (a Appearance) SpriteRegion() (int32, int32) {
+	return bodySpriteRegionX + a.Body*spriteSize, bodySpriteRegionY
+}
+
+// Works out the appearance from just a sprite region, as chosen by clients which don't know about appearances
+func FromSpriteRegion(x, y int32) (Appearance, error) {
+	if y != bodySpriteRegionY || x < bodySpriteRegionX || (x-bodySpriteRegionX)%spriteSize != 0 {
+		return Appearance{}, errors.New("unknown sprite")
+	}
+
+	a := Appearance{Body: (x - bodySpriteRegionX) / spriteSize}
+	if err := Validate(a); err != nil {
+		return Appearance{}, errors.New("unknown sprite")
+	}
+	return a, nil
+}
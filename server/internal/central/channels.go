@@ -0,0 +1,308 @@
+package central
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// How many messages each channel remembers to replay to players who join it
+const channelHistoryLength = 50
+
+type ChannelKind string
+
+const (
+	ChannelSystem ChannelKind = "system" // Made by the server, and open to everyone
+	ChannelCustom ChannelKind = "custom" // Made by a player, who can moderate it
+	ChannelParty  ChannelKind = "party"  // Only for members of a party, who are added and removed by the server
+	ChannelGuild  ChannelKind = "guild"  // Likewise for a guild
+)
+
+// Everyone in the game is in the global channel, which is where yells go
+const GlobalChannel = "global"
+
+// The name of the guild's chat channel
+func GuildChannel(guildId int32) string {
+	return fmt.Sprintf("guild:%d", guildId)
+}
+
+// The channels every server has
+var SystemChannels = []string{GlobalChannel, "trade", "help"}
+
+type ChannelMember struct {
+	ActorId int32
+	Name    string
+	Muted   bool
+}
+
+type ChannelMessage struct {
+	SenderName string
+	Msg        string
+	SentAt     time.Time
+}
+
+type channel struct {
+	name         string
+	kind         ChannelKind
+	ownerActorId int32                     // 0 if nobody owns it
+	members      map[uint32]*ChannelMember // Keyed by client ID
+	history      []ChannelMessage          // Oldest first
+	banned       map[int32]struct{}        // Actor IDs
+	muted        map[int32]struct{}        // Actor IDs, kept when they leave so rejoining doesn't lift the mute
+}
+
+// A summary of a channel, for listing them
+type ChannelInfo struct {
+	Name    string
+	Kind    ChannelKind
+	Members int
+}
+
+// Every chat channel on the server, and who's in them. Channels deliver their messages through the hub, so members
+// can be in any level. Safe for concurrent use.
+type Channels struct {
+	channels map[string]*channel
+	mux      sync.Mutex
+}
+
+func NewChannels() *Channels {
+	return &Channels{channels: make(map[string]*channel)}
+}
+
+// Adds the channel if it doesn't exist yet. Does nothing if it does.
+func (c *Channels) Ensure(name string, kind ChannelKind, ownerActorId int32) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if _, exists := c.channels[name]; exists {
+		return
+	}
+	c.channels[name] = &channel{
+		name:         name,
+		kind:         kind,
+		ownerActorId: ownerActorId,
+		members:      make(map[uint32]*ChannelMember),
+		banned:       make(map[int32]struct{}),
+		muted:        make(map[int32]struct{}),
+	}
+}
+
+// Removes the channel and everyone from it, returning who was in it
+func (c *Channels) Remove(name string) []uint32 {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	ch, exists := c.channels[name]
+	if !exists {
+		return nil
+	}
+	delete(c.channels, name)
+	return ch.memberIds()
+}
+
+// The channel's kind and owner, if it exists
+func (c *Channels) Info(name string) (ChannelKind, int32, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	ch, exists := c.channels[name]
+	if !exists {
+		return "", 0, false
+	}
+	return ch.kind, ch.ownerActorId, true
+}
+
+func (c *Channels) List() []ChannelInfo {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	infos := make([]ChannelInfo, 0, len(c.channels))
+	for _, ch := range c.channels {
+		infos = append(infos, ChannelInfo{Name: ch.name, Kind: ch.kind, Members: len(ch.members)})
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// Adds the client's player to the channel. Returns the names of everyone in it (including them), its history, and the
+// IDs of the clients who were already in it, so they can be told.
+func (c *Channels) Join(name string, clientId uint32, member ChannelMember) ([]string, []ChannelMessage, []uint32, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	ch, exists := c.channels[name]
+	if !exists {
+		return nil, nil, nil, fmt.Errorf("no channel named %s", name)
+	}
+	if _, banned := ch.banned[member.ActorId]; banned {
+		return nil, nil, nil, fmt.Errorf("you're banned from %s", name)
+	}
+
+	_, member.Muted = ch.muted[member.ActorId]
+	others := ch.memberIds()
+	ch.members[clientId] = &member
+
+	names := make([]string, 0, len(ch.members))
+	for _, m := range ch.members {
+		names = append(names, m.Name)
+	}
+	sort.Strings(names)
+
+	return names, append([]ChannelMessage(nil), ch.history...), others, nil
+}
+
+// Takes the client's player out of the channel, returning who's left in it, or false if they weren't in it
+func (c *Channels) Leave(name string, clientId uint32) ([]uint32, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	ch, exists := c.channels[name]
+	if !exists {
+		return nil, false
+	}
+	if _, isMember := ch.members[clientId]; !isMember {
+		return nil, false
+	}
+	delete(ch.members, clientId)
+	return ch.memberIds(), true
+}
+
+// Takes the client's player out of every channel, returning who's left in each one they were in, keyed by channel
+func (c *Channels) LeaveAll(clientId uint32) map[string][]uint32 {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	left := make(map[string][]uint32)
+	for name, ch := range c.channels {
+		if _, isMember := ch.members[clientId]; isMember {
+			delete(ch.members, clientId)
+			left[name] = ch.memberIds()
+		}
+	}
+	return left
+}
+
+// The channels the client's player is in
+func (c *Channels) Joined(clientId uint32) []string {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	joined := make([]string, 0)
+	for name, ch := range c.channels {
+		if _, isMember := ch.members[clientId]; isMember {
+			joined = append(joined, name)
+		}
+	}
+	sort.Strings(joined)
+	return joined
+}
+
+// The clients in the channel
+func (c *Channels) Members(name string) []uint32 {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	ch, exists := c.channels[name]
+	if !exists {
+		return nil
+	}
+	return ch.memberIds()
+}
+
+// Records a message from the client's player in the channel's history, and returns who else should get it
+func (c *Channels) Post(name string, clientId uint32, msg string) ([]uint32, ChannelMessage, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	ch, exists := c.channels[name]
+	if !exists {
+		return nil, ChannelMessage{}, fmt.Errorf("no channel named %s", name)
+	}
+	member, isMember := ch.members[clientId]
+	if !isMember {
+		return nil, ChannelMessage{}, fmt.Errorf("you aren't in %s", name)
+	}
+	if member.Muted {
+		return nil, ChannelMessage{}, fmt.Errorf("you're muted in %s", name)
+	}
+
+	message := ChannelMessage{SenderName: member.Name, Msg: msg, SentAt: time.Now()}
+	ch.history = append(ch.history, message)
+	if len(ch.history) > channelHistoryLength {
+		ch.history = ch.history[len(ch.history)-channelHistoryLength:]
+	}
+
+	others := ch.memberIds()
+	for i, id := range others {
+		if id == clientId {
+			others = append(others[:i], others[i+1:]...)
+			break
+		}
+	}
+	return others, message, nil
+}
+
+// Finds the client of the member with the given name, if they're in the channel
+func (c *Channels) FindMember(name string, memberName string) (uint32, ChannelMember, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	ch, exists := c.channels[name]
+	if !exists {
+		return 0, ChannelMember{}, false
+	}
+	for clientId, member := range ch.members {
+		if member.Name == memberName {
+			return clientId, *member, true
+		}
+	}
+	return 0, ChannelMember{}, false
+}
+
+// Stops the actor talking in the channel, or lets them again, whether or not they're in it right now
+func (c *Channels) SetMuted(name string, actorId int32, muted bool) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	ch, exists := c.channels[name]
+	if !exists {
+		return fmt.Errorf("no channel named %s", name)
+	}
+	if muted {
+		ch.muted[actorId] = struct{}{}
+	} else {
+		delete(ch.muted, actorId)
+	}
+	for _, member := range ch.members {
+		if member.ActorId == actorId {
+			member.Muted = muted
+		}
+	}
+	return nil
+}
+
+// Stops the actor joining the channel, or lets them again
+func (c *Channels) SetBanned(name string, actorId int32, banned bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	ch, exists := c.channels[name]
+	if !exists {
+		return
+	}
+	if banned {
+		ch.banned[actorId] = struct{}{}
+	} else {
+		delete(ch.banned, actorId)
+	}
+}
+
+// Must be called with the lock held
+func (ch *channel) memberIds() []uint32 {
+	ids := make([]uint32, 0, len(ch.members))
+	for id := range ch.members {
+		ids = append(ids, id)
+	}
+	return ids
+}
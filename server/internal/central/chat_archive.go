@@ -0,0 +1,104 @@
+package central
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/db"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/ds"
+)
+
+const (
+	// How often messages waiting to be archived are written to the database, all at once
+	chatArchiveFlushInterval = time.Second
+
+	// How often messages older than the retention period are deleted
+	chatArchivePruneInterval = time.Hour
+)
+
+// Keeps everything players say in the database, so moderators can search it later. Messages are written in batches
+// in the background, so chatting never waits on the database.
+type ChatArchive struct {
+	// How long messages are kept, or 0 to keep them forever
+	Retention time.Duration
+
+	queries *db.Queries
+	pending *ds.Mailbox[db.CreateChatLogEntriesParams]
+	logger  *log.Logger
+}
+
+func NewChatArchive(queries *db.Queries, retention time.Duration) *ChatArchive {
+	return &ChatArchive{
+		Retention: retention,
+		queries:   queries,
+		pending:   ds.NewMailbox[db.CreateChatLogEntriesParams](),
+		logger:    log.New(log.Writer(), "Chat archive: ", log.LstdFlags),
+	}
+}
+
+// Queues a message to be archived, along with what the sender actually typed before it was censored. Never blocks.
+func (a *ChatArchive) Write(levelId int32, line ChatLine, rawMsg string) {
+	a.pending.Push(db.CreateChatLogEntriesParams{
+		SenderActorID: pgtype.Int4{Int32: line.SenderActorId, Valid: true},
+		SenderName:    line.SenderName,
+		LevelID:       levelId,
+		Kind:          line.Kind,
+		Recipient:     line.To,
+		RawMsg:        rawMsg,
+		CensoredMsg:   line.Msg,
+		SentAt:        pgtype.Timestamp{Time: line.SentAt.UTC(), Valid: true},
+	})
+}
+
+// Writes queued messages and deletes old ones forever
+func (a *ChatArchive) Run() {
+	flushTicker := time.NewTicker(chatArchiveFlushInterval)
+	defer flushTicker.Stop()
+	pruneTicker := time.NewTicker(chatArchivePruneInterval)
+	defer pruneTicker.Stop()
+
+	a.prune()
+	for {
+		select {
+		case <-flushTicker.C:
+			a.flush()
+		case <-pruneTicker.C:
+			a.prune()
+		}
+	}
+}
+
+func (a *ChatArchive) flush() {
+	entries := a.pending.Drain()
+	if len(entries) <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := a.queries.CreateChatLogEntries(ctx, entries); err != nil {
+		a.logger.Printf("Failed to archive %d messages: %v", len(entries), err)
+	}
+}
+
+func (a *ChatArchive) prune() {
+	if a.Retention <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cutoff := time.Now().UTC().Add(-a.Retention)
+	deleted, err := a.queries.DeleteChatLogBefore(ctx, pgtype.Timestamp{Time: cutoff, Valid: true})
+	if err != nil {
+		a.logger.Printf("Failed to delete messages from before %s: %v", cutoff.Format(time.DateTime), err)
+		return
+	}
+	if deleted > 0 {
+		a.logger.Printf("Deleted %d messages from before %s", deleted, cutoff.Format(time.DateTime))
+	}
+}
@@ -1,3 +1,8 @@
+-- name: GetUserById :one
+SELECT * FROM users
+WHERE id = $1
+LIMIT 1;
+
 -- name: GetUserByUsername :one
 SELECT * FROM users
 WHERE username = $1
@@ -14,18 +19,38 @@ RETURNING *;
 
 -- name: CreateActor :one
 INSERT INTO actors (
-    user_id, name, level_id, x, y, sprite_region_x, sprite_region_y
+    user_id, name, level_id, x, y, sprite_region_x, sprite_region_y, body, hair, outfit, palette
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 )
 RETURNING *;
 
--- name: GetActorByUserId :one
+-- name: GetActorsByUserId :many
 SELECT * FROM actors
 WHERE user_id = $1
-ORDER BY id DESC
+ORDER BY id;
+
+-- name: GetActorByName :one
+SELECT * FROM actors
+WHERE LOWER(name) = LOWER(sqlc.arg(name))
 LIMIT 1;
 
+-- name: RenameActor :exec
+UPDATE actors
+SET name = $3
+WHERE id = $1
+AND user_id = $2;
+
+-- name: DeleteActor :exec
+DELETE FROM actors
+WHERE id = $1
+AND user_id = $2;
+
+-- name: UpdateActorAppearance :exec
+UPDATE actors
+SET sprite_region_x = $2, sprite_region_y = $3, body = $4, hair = $5, outfit = $6, palette = $7
+WHERE id = $1;
+
 -- name: UpdateActorLocation :exec
 UPDATE actors
 SET level_id = $2, x = $3, y = $4
@@ -45,14 +70,17 @@ INSERT INTO users (
 ON CONFLICT (username) DO NOTHING
 RETURNING *;
 
--- name: CreateAdminIfNotExists :one
-INSERT INTO admins (
-    user_id
+-- name: GrantRole :execrows
+INSERT INTO user_roles (
+    user_id, role, granted_by_user_id
 ) VALUES (
-    $1
+    $1, $2, $3
 )
-ON CONFLICT (user_id) DO NOTHING
-RETURNING *;
+ON CONFLICT (user_id, role) DO NOTHING;
+
+-- name: RevokeRole :execrows
+DELETE FROM user_roles
+WHERE user_id = $1 AND role = $2;
 
 -- name: CreateActorIfNotExists :one
 INSERT INTO actors (
@@ -60,12 +88,13 @@ INSERT INTO actors (
 ) VALUES (
     $1, $2, $3, $4, $5, $6
 )
-ON CONFLICT (user_id) DO NOTHING
+ON CONFLICT DO NOTHING
 RETURNING *;
 
--- name: GetAdminByUserId :one
-SELECT * FROM admins
-WHERE user_id = $1 LIMIT 1;
+-- name: GetRolesByUserId :many
+SELECT role FROM user_roles
+WHERE user_id = $1
+ORDER BY role;
 
 -- name: CreateLevel :one
 INSERT INTO levels (
@@ -217,6 +246,12 @@ SELECT * FROM items
 WHERE name = $1 AND description = $2 AND value = $3 AND sprite_region_x = $4 AND sprite_region_y = $5 and grants_vip = $6 and tradeable = $7
 LIMIT 1;
 
+-- name: GetItemByName :one
+SELECT * FROM items
+WHERE LOWER(name) = LOWER(sqlc.arg(name))
+ORDER BY id
+LIMIT 1;
+
 -- name: GetItemById :one
 SELECT * FROM items
 WHERE id = $1 LIMIT 1;
@@ -255,11 +290,11 @@ WHERE level_id = $1;
 SELECT * FROM levels_ground_items
 WHERE level_id = $1;
 
--- name: IsActorAdmin :one
-SELECT 1 FROM users u
-JOIN admins ad ON u.id = ad.user_id
-JOIN actors ac ON u.id = ac.user_id
-WHERE ac.id = $1 LIMIT 1;
+-- name: GetRolesByActorId :many
+SELECT ur.role FROM user_roles ur
+JOIN actors ac ON ur.user_id = ac.user_id
+WHERE ac.id = $1
+ORDER BY ur.role;
 
 -- name: GetUserIdByActorId :one
 SELECT user_id FROM actors
@@ -383,4 +418,411 @@ WHERE actor_id = $1;
 -- name: GetActorSkillXp :one
 SELECT ISNULL(xp, 0) FROM actors_skills
 WHERE actor_id = $1
-AND skill = $2;
\ No newline at end of file
+AND skill = $2;
+-- name: CreateAuthLockout :exec
+INSERT INTO auth_lockouts (
+    kind, subject, locked_until
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: GetRecentAuthLockouts :many
+SELECT * FROM auth_lockouts
+ORDER BY created_at DESC
+LIMIT $1;
+
+-- name: GetUserByActorId :one
+SELECT u.* FROM users u
+JOIN actors a ON a.user_id = u.id
+WHERE a.id = $1;
+
+-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = $2, must_change_password = $3
+WHERE id = $1;
+
+-- name: CreateBan :one
+INSERT INTO bans (
+    user_id, reason, expires_at, created_by_user_id
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: GetActiveBan :one
+SELECT * FROM bans
+WHERE user_id = $1
+AND lifted_at IS NULL
+AND (expires_at IS NULL OR expires_at > (NOW() AT TIME ZONE 'UTC'))
+ORDER BY expires_at DESC NULLS FIRST
+LIMIT 1;
+
+-- name: LiftBans :execrows
+UPDATE bans
+SET lifted_at = (NOW() AT TIME ZONE 'UTC')
+WHERE user_id = $1
+AND lifted_at IS NULL
+AND (expires_at IS NULL OR expires_at > (NOW() AT TIME ZONE 'UTC'));
+
+-- name: CreateMute :one
+INSERT INTO mutes (
+    user_id, reason, expires_at, created_by_user_id
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: GetActiveMute :one
+SELECT * FROM mutes
+WHERE user_id = $1
+AND lifted_at IS NULL
+AND (expires_at IS NULL OR expires_at > (NOW() AT TIME ZONE 'UTC'))
+ORDER BY expires_at DESC NULLS FIRST
+LIMIT 1;
+
+-- name: LiftMutes :execrows
+UPDATE mutes
+SET lifted_at = (NOW() AT TIME ZONE 'UTC')
+WHERE user_id = $1
+AND lifted_at IS NULL
+AND (expires_at IS NULL OR expires_at > (NOW() AT TIME ZONE 'UTC'));
+
+-- name: CreateAdminAuditLogEntry :exec
+INSERT INTO admin_audit_log (
+    admin_user_id, action, target, details, report_id
+) VALUES (
+    $1, $2, $3, $4, $5
+);
+
+-- name: GetFriendship :one
+SELECT * FROM friends
+WHERE (actor_id = $1 AND friend_actor_id = $2)
+OR (actor_id = $2 AND friend_actor_id = $1)
+LIMIT 1;
+
+-- name: CreateFriendRequest :exec
+INSERT INTO friends (
+    actor_id, friend_actor_id
+) VALUES (
+    $1, $2
+)
+ON CONFLICT DO NOTHING;
+
+-- name: AcceptFriendRequest :execrows
+UPDATE friends
+SET accepted = TRUE
+WHERE actor_id = $1 AND friend_actor_id = $2 AND NOT accepted;
+
+-- name: DeleteFriendship :execrows
+DELETE FROM friends
+WHERE (actor_id = $1 AND friend_actor_id = $2)
+OR (actor_id = $2 AND friend_actor_id = $1);
+
+-- name: GetFriends :many
+SELECT a.id, a.name, f.accepted, f.friend_actor_id = sqlc.arg(actor_id) AS incoming
+FROM friends f
+JOIN actors a ON a.id = CASE WHEN f.actor_id = sqlc.arg(actor_id) THEN f.friend_actor_id ELSE f.actor_id END
+WHERE f.actor_id = sqlc.arg(actor_id) OR f.friend_actor_id = sqlc.arg(actor_id)
+ORDER BY a.name;
+
+-- name: AddIgnore :execrows
+INSERT INTO ignores (
+    actor_id, ignored_actor_id
+) VALUES (
+    $1, $2
+)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveIgnore :execrows
+DELETE FROM ignores
+WHERE actor_id = $1 AND ignored_actor_id = $2;
+
+-- name: GetIgnores :many
+SELECT a.id, a.name
+FROM ignores i
+JOIN actors a ON a.id = i.ignored_actor_id
+WHERE i.actor_id = $1
+ORDER BY a.name;
+
+-- name: CreateChatChannelIfNotExists :exec
+INSERT INTO chat_channels (
+    name, kind, owner_actor_id
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT DO NOTHING;
+
+-- name: GetChatChannels :many
+SELECT * FROM chat_channels;
+
+-- name: DeleteChatChannel :exec
+DELETE FROM chat_channels
+WHERE name = $1;
+
+-- name: GetChatChannelBans :many
+SELECT channel_name, actor_id FROM chat_channel_bans;
+
+-- name: AddChatChannelBan :exec
+INSERT INTO chat_channel_bans (
+    channel_name, actor_id, banned_by_actor_id
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveChatChannelBan :execrows
+DELETE FROM chat_channel_bans
+WHERE channel_name = $1 AND actor_id = $2;
+
+-- name: AddChatChannelMember :exec
+INSERT INTO chat_channel_members (
+    channel_name, actor_id
+) VALUES (
+    $1, $2
+)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveChatChannelMember :exec
+DELETE FROM chat_channel_members
+WHERE channel_name = $1 AND actor_id = $2;
+
+-- name: GetChatChannelMutes :many
+SELECT channel_name, actor_id FROM chat_channel_mutes;
+
+-- name: AddChatChannelMute :exec
+INSERT INTO chat_channel_mutes (
+    channel_name, actor_id
+) VALUES (
+    $1, $2
+)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveChatChannelMute :exec
+DELETE FROM chat_channel_mutes
+WHERE channel_name = $1 AND actor_id = $2;
+
+-- name: GetChatChannelMembershipsByActorId :many
+SELECT channel_name FROM chat_channel_members
+WHERE actor_id = $1
+ORDER BY channel_name;
+
+-- name: CreateGuild :one
+INSERT INTO guilds (
+    name, tag, created_by_actor_id
+) VALUES (
+    $1, $2, $3
+)
+RETURNING *;
+
+-- name: GetGuildByName :one
+SELECT * FROM guilds
+WHERE LOWER(name) = LOWER($1)
+LIMIT 1;
+
+-- name: DeleteGuild :exec
+DELETE FROM guilds
+WHERE id = $1;
+
+-- name: AddGuildMember :exec
+INSERT INTO guild_members (
+    actor_id, guild_id, rank
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: RemoveGuildMember :execrows
+DELETE FROM guild_members
+WHERE actor_id = $1 AND guild_id = $2;
+
+-- name: SetGuildMemberRank :execrows
+UPDATE guild_members
+SET rank = $3
+WHERE actor_id = $1 AND guild_id = $2;
+
+-- name: GetGuildMembership :one
+SELECT g.id, g.name, g.tag, m.rank
+FROM guild_members m
+JOIN guilds g ON g.id = m.guild_id
+WHERE m.actor_id = $1
+LIMIT 1;
+
+-- name: GetGuildMembers :many
+SELECT a.id, a.name, m.rank
+FROM guild_members m
+JOIN actors a ON a.id = m.actor_id
+WHERE m.guild_id = $1
+ORDER BY a.name;
+
+-- name: CreateGuildInvite :exec
+INSERT INTO guild_invites (
+    guild_id, actor_id, invited_by_actor_id
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteGuildInvite :execrows
+DELETE FROM guild_invites
+WHERE guild_id = $1 AND actor_id = $2;
+
+-- name: DeleteGuildInvitesByActorId :exec
+DELETE FROM guild_invites
+WHERE actor_id = $1;
+
+-- name: GetGuildInvitesByActorId :many
+SELECT g.name
+FROM guild_invites i
+JOIN guilds g ON g.id = i.guild_id
+WHERE i.actor_id = $1
+ORDER BY i.created_at;
+
+-- name: AddGuildBankItem :exec
+INSERT INTO guild_bank_items (
+    guild_id, item_id, quantity
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT(guild_id, item_id) DO UPDATE
+SET quantity = guild_bank_items.quantity + excluded.quantity;
+
+-- name: TakeGuildBankItem :execrows
+UPDATE guild_bank_items
+SET quantity = quantity - sqlc.arg(quantity)
+WHERE guild_id = sqlc.arg(guild_id) AND item_id = sqlc.arg(item_id) AND sqlc.arg(quantity) > 0 AND quantity >= sqlc.arg(quantity);
+
+-- name: DeleteEmptyGuildBankItems :exec
+DELETE FROM guild_bank_items
+WHERE guild_id = $1 AND quantity = 0;
+
+-- name: GetGuildBankItems :many
+SELECT
+    i.id as item_id,
+    i.name,
+    i.description,
+    i.value,
+    i.sprite_region_x,
+    i.sprite_region_y,
+    i.tool_properties_id,
+    i.grants_vip,
+    i.tradeable,
+    b.quantity
+FROM items i
+JOIN guild_bank_items b ON i.id = b.item_id
+WHERE b.guild_id = $1
+ORDER BY i.name;
+
+-- name: CreateGuildBankLogEntry :exec
+INSERT INTO guild_bank_log (
+    guild_id, actor_id, item_id, quantity
+) VALUES (
+    $1, $2, $3, $4
+);
+
+-- name: GetGuildBankLog :many
+SELECT
+    COALESCE(a.name, '') AS actor_name,
+    i.id as item_id,
+    i.name,
+    i.description,
+    i.value,
+    i.sprite_region_x,
+    i.sprite_region_y,
+    i.tool_properties_id,
+    i.grants_vip,
+    i.tradeable,
+    l.quantity,
+    l.created_at
+FROM guild_bank_log l
+JOIN items i ON i.id = l.item_id
+LEFT JOIN actors a ON a.id = l.actor_id
+WHERE l.guild_id = $1
+ORDER BY l.created_at DESC, l.id DESC
+LIMIT $2;
+
+-- name: CreateReport :one
+INSERT INTO reports (
+    reporter_actor_id, reported_actor_id, reported_name, reported_user_id, level_id, reason
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+RETURNING id;
+
+-- name: CreateReportChatLine :exec
+INSERT INTO report_chat_lines (
+    report_id, sender_name, kind, recipient, msg, sent_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
+
+-- name: GetReports :many
+SELECT
+    r.id,
+    COALESCE(reporter.name, '') AS reporter_name,
+    r.reported_name,
+    COALESCE(u.username, '') AS reported_username,
+    r.reason,
+    r.level_id,
+    r.status,
+    COALESCE(claimer.username, '') AS claimed_by,
+    r.resolution,
+    r.created_at
+FROM reports r
+LEFT JOIN users u ON u.id = r.reported_user_id
+LEFT JOIN actors reporter ON reporter.id = r.reporter_actor_id
+LEFT JOIN users claimer ON claimer.id = r.claimed_by_user_id
+WHERE sqlc.arg(include_resolved)::boolean OR r.status <> 'resolved'
+ORDER BY r.created_at DESC
+LIMIT sqlc.arg(max_reports);
+
+-- name: GetReport :one
+SELECT r.*, COALESCE(u.username, '') AS reported_username
+FROM reports r
+LEFT JOIN users u ON u.id = r.reported_user_id
+WHERE r.id = $1;
+
+-- name: GetReportChatLines :many
+SELECT * FROM report_chat_lines
+WHERE report_id = ANY(sqlc.arg(report_ids)::int[])
+ORDER BY report_id, sent_at, id;
+
+-- name: GetReportActions :many
+SELECT report_id, action, details
+FROM admin_audit_log
+WHERE report_id = ANY(sqlc.arg(report_ids)::int[])
+ORDER BY created_at, id;
+
+-- name: ClaimReport :execrows
+UPDATE reports
+SET status = 'claimed', claimed_by_user_id = sqlc.arg(user_id)
+WHERE id = sqlc.arg(id) AND status = 'open';
+
+-- name: ResolveReport :execrows
+UPDATE reports
+SET status = 'resolved', claimed_by_user_id = sqlc.arg(user_id), resolution = sqlc.arg(resolution), resolved_at = (NOW() AT TIME ZONE 'UTC')
+WHERE id = sqlc.arg(id)
+AND status <> 'resolved'
+AND (claimed_by_user_id IS NULL OR claimed_by_user_id = sqlc.arg(user_id));
+
+-- name: CreateChatLogEntries :copyfrom
+INSERT INTO chat_log (
+    sender_actor_id, sender_name, level_id, kind, recipient, raw_msg, censored_msg, sent_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+);
+
+-- name: DeleteChatLogBefore :execrows
+DELETE FROM chat_log
+WHERE sent_at < $1;
+
+-- name: SearchChatLog :many
+SELECT * FROM chat_log
+WHERE (
+    sqlc.narg(actor_id)::integer IS NULL
+    OR sender_actor_id = sqlc.narg(actor_id)
+    OR (kind = 'whisper' AND LOWER(recipient) = LOWER(sqlc.arg(actor_name)))
+)
+AND sent_at >= sqlc.arg(since)
+AND sent_at < sqlc.arg(until)
+AND (sqlc.arg(keyword)::text = '' OR raw_msg ILIKE '%' || sqlc.arg(keyword) || '%')
+ORDER BY sent_at DESC, id DESC
+LIMIT sqlc.arg(max_entries);
@@ -4,11 +4,29 @@ CREATE TABLE IF NOT EXISTS users (
     password_hash TEXT NOT NULL
 );
 
-CREATE TABLE IF NOT EXISTS admins (
-    id SERIAL PRIMARY KEY,
-    user_id INTEGER NOT NULL UNIQUE REFERENCES users(id)
+-- Set when an admin resets the password to a temporary one, which can only be used to choose a new password
+ALTER TABLE users ADD COLUMN IF NOT EXISTS must_change_password BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- What a user is allowed to do beyond playing the game. See internal/roles for the permissions each role grants.
+CREATE TABLE IF NOT EXISTS user_roles (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role TEXT NOT NULL,
+    granted_by_user_id INTEGER REFERENCES users(id) ON DELETE SET NULL, -- NULL if granted by the server itself
+    granted_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, role)
 );
 
+-- Everyone in the old admins table could do everything, so they become superadmins
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.tables WHERE table_name = 'admins') THEN
+        INSERT INTO user_roles (user_id, role)
+        SELECT user_id, 'superadmin' FROM admins
+        ON CONFLICT DO NOTHING;
+        DROP TABLE admins;
+    END IF;
+END $$;
+
 CREATE TABLE IF NOT EXISTS levels (
     id SERIAL PRIMARY KEY,
     gd_res_path TEXT NOT NULL UNIQUE,
@@ -20,7 +38,7 @@ CREATE TABLE IF NOT EXISTS levels (
 
 CREATE TABLE IF NOT EXISTS actors (
     id SERIAL PRIMARY KEY,
-    user_id INTEGER NOT NULL UNIQUE REFERENCES users(id),
+    user_id INTEGER NOT NULL REFERENCES users(id),
     name TEXT NOT NULL,
     level_id INTEGER REFERENCES levels(id),
     x INTEGER NOT NULL,
@@ -29,6 +47,28 @@ CREATE TABLE IF NOT EXISTS actors (
     sprite_region_y INTEGER NOT NULL
 );
 
+-- Accounts used to be limited to a single actor
+ALTER TABLE actors DROP CONSTRAINT IF EXISTS actors_user_id_key;
+CREATE INDEX IF NOT EXISTS actors_user_id_idx ON actors (user_id);
+
+-- Indices into the lists in the appearance package. The body is also kept as the sprite region for older clients.
+ALTER TABLE actors ADD COLUMN IF NOT EXISTS body INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE actors ADD COLUMN IF NOT EXISTS hair INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE actors ADD COLUMN IF NOT EXISTS outfit INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE actors ADD COLUMN IF NOT EXISTS palette INTEGER NOT NULL DEFAULT 0;
+
+-- Actors from before appearances existed only had a sprite region, so work out their body from that. Once they match,
+-- this leaves them alone.
+UPDATE actors SET body = (sprite_region_x - 32) / 8
+WHERE body = 0
+AND sprite_region_y = 0
+AND sprite_region_x > 32
+AND sprite_region_x <= 104
+AND (sprite_region_x - 32) % 8 = 0;
+
+-- No two characters can share a name, whatever the case
+CREATE UNIQUE INDEX IF NOT EXISTS actors_name_unique_idx ON actors (LOWER(name));
+
 CREATE TABLE IF NOT EXISTS levels_tscn_data (
     level_id INTEGER NOT NULL UNIQUE PRIMARY KEY REFERENCES levels(id) ON DELETE CASCADE,
     tscn_data BYTEA NOT NULL
@@ -130,4 +170,219 @@ CREATE TABLE IF NOT EXISTS actors_quests (
     quest_id INTEGER NOT NULL REFERENCES quests(id) ON DELETE CASCADE,
     completed BOOLEAN NOT NULL DEFAULT FALSE,
     PRIMARY KEY (actor_id, quest_id)
-);
\ No newline at end of file
+);
+CREATE TABLE IF NOT EXISTS auth_lockouts (
+    id SERIAL PRIMARY KEY,
+    kind TEXT NOT NULL, -- 'login_ip', 'login_username' or 'register_ip'
+    subject TEXT NOT NULL, -- the IP address or username that was locked out
+    locked_until TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS bans (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP, -- NULL means never
+    created_by_user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    lifted_at TIMESTAMP -- set if an admin lifts the ban before it expires
+);
+
+CREATE TABLE IF NOT EXISTS mutes (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP, -- NULL means never
+    created_by_user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    lifted_at TIMESTAMP -- set if an admin lifts the mute before it expires
+);
+
+CREATE TABLE IF NOT EXISTS admin_audit_log (
+    id SERIAL PRIMARY KEY,
+    admin_user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    action TEXT NOT NULL, -- e.g. 'kick', 'ban', 'unban', 'mute', 'unmute'
+    target TEXT NOT NULL DEFAULT '', -- usually the username acted on
+    details TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- A friend request from actor_id to friend_actor_id, which makes them friends once it's accepted
+CREATE TABLE IF NOT EXISTS friends (
+    actor_id INTEGER NOT NULL REFERENCES actors(id) ON DELETE CASCADE,
+    friend_actor_id INTEGER NOT NULL REFERENCES actors(id) ON DELETE CASCADE,
+    accepted BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (actor_id, friend_actor_id),
+    CHECK (actor_id <> friend_actor_id)
+);
+
+CREATE INDEX IF NOT EXISTS friends_friend_actor_id_idx ON friends (friend_actor_id);
+
+-- Players whose chat actor_id doesn't want to see
+CREATE TABLE IF NOT EXISTS ignores (
+    actor_id INTEGER NOT NULL REFERENCES actors(id) ON DELETE CASCADE,
+    ignored_actor_id INTEGER NOT NULL REFERENCES actors(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (actor_id, ignored_actor_id),
+    CHECK (actor_id <> ignored_actor_id)
+);
+
+-- Named chat channels. System channels are made by the server, custom ones by players, and party and guild channels
+-- by the parties and guilds they belong to.
+CREATE TABLE IF NOT EXISTS chat_channels (
+    name TEXT PRIMARY KEY,
+    kind TEXT NOT NULL, -- 'system', 'custom', 'party' or 'guild'
+    owner_actor_id INTEGER REFERENCES actors(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- The channels each character is in, so they can be put back in them when they next enter the game
+CREATE TABLE IF NOT EXISTS chat_channel_members (
+    channel_name TEXT NOT NULL REFERENCES chat_channels(name) ON DELETE CASCADE,
+    actor_id INTEGER NOT NULL REFERENCES actors(id) ON DELETE CASCADE,
+    joined_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (channel_name, actor_id)
+);
+
+CREATE INDEX IF NOT EXISTS chat_channel_members_actor_id_idx ON chat_channel_members (actor_id);
+
+-- Characters who can't talk in a channel. Kept apart from memberships, so leaving and rejoining doesn't lift the mute.
+CREATE TABLE IF NOT EXISTS chat_channel_mutes (
+    channel_name TEXT NOT NULL REFERENCES chat_channels(name) ON DELETE CASCADE,
+    actor_id INTEGER NOT NULL REFERENCES actors(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (channel_name, actor_id)
+);
+
+-- Mutes used to be a flag on the membership, and were lost when the member left
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'chat_channel_members' AND column_name = 'muted'
+    ) THEN
+        INSERT INTO chat_channel_mutes (channel_name, actor_id)
+        SELECT channel_name, actor_id FROM chat_channel_members WHERE muted
+        ON CONFLICT DO NOTHING;
+        ALTER TABLE chat_channel_members DROP COLUMN muted;
+    END IF;
+END $$;
+
+-- Characters who aren't allowed to join a channel
+CREATE TABLE IF NOT EXISTS chat_channel_bans (
+    channel_name TEXT NOT NULL REFERENCES chat_channels(name) ON DELETE CASCADE,
+    actor_id INTEGER NOT NULL REFERENCES actors(id) ON DELETE CASCADE,
+    banned_by_actor_id INTEGER REFERENCES actors(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (channel_name, actor_id)
+);
+
+CREATE TABLE IF NOT EXISTS guilds (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    tag TEXT NOT NULL UNIQUE, -- a few capital letters shown next to members' names
+    created_by_actor_id INTEGER REFERENCES actors(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS guilds_name_lower_idx ON guilds (LOWER(name));
+
+-- Each character can be in at most one guild
+CREATE TABLE IF NOT EXISTS guild_members (
+    actor_id INTEGER PRIMARY KEY REFERENCES actors(id) ON DELETE CASCADE,
+    guild_id INTEGER NOT NULL REFERENCES guilds(id) ON DELETE CASCADE,
+    rank TEXT NOT NULL, -- 'leader', 'officer', 'member' or 'recruit'
+    joined_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS guild_members_guild_id_idx ON guild_members (guild_id);
+
+CREATE TABLE IF NOT EXISTS guild_invites (
+    guild_id INTEGER NOT NULL REFERENCES guilds(id) ON DELETE CASCADE,
+    actor_id INTEGER NOT NULL REFERENCES actors(id) ON DELETE CASCADE,
+    invited_by_actor_id INTEGER REFERENCES actors(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (guild_id, actor_id)
+);
+
+CREATE TABLE IF NOT EXISTS guild_bank_items (
+    guild_id INTEGER NOT NULL REFERENCES guilds(id) ON DELETE CASCADE,
+    item_id INTEGER NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL CHECK (quantity >= 0),
+    PRIMARY KEY (guild_id, item_id)
+);
+
+-- Every deposit (positive quantity) and withdrawal (negative quantity) from a guild's bank
+CREATE TABLE IF NOT EXISTS guild_bank_log (
+    id SERIAL PRIMARY KEY,
+    guild_id INTEGER NOT NULL REFERENCES guilds(id) ON DELETE CASCADE,
+    actor_id INTEGER REFERENCES actors(id) ON DELETE SET NULL,
+    item_id INTEGER NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS guild_bank_log_guild_id_idx ON guild_bank_log (guild_id, created_at);
+
+-- A player's report of another for breaking the rules, which moderators claim and then resolve
+CREATE TABLE IF NOT EXISTS reports (
+    id SERIAL PRIMARY KEY,
+    reporter_actor_id INTEGER REFERENCES actors(id) ON DELETE SET NULL,
+    reported_actor_id INTEGER REFERENCES actors(id) ON DELETE SET NULL,
+    reported_name TEXT NOT NULL DEFAULT '', -- kept in case their character is deleted
+    reported_user_id INTEGER REFERENCES users(id) ON DELETE SET NULL, -- the account to act against
+    level_id INTEGER NOT NULL, -- where the reporter was
+    reason TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'open', -- 'open', 'claimed' or 'resolved'
+    claimed_by_user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    resolution TEXT NOT NULL DEFAULT '', -- the moderator's notes on what they did about it
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS reports_status_idx ON reports (status, created_at);
+
+-- Reports used to be deleted along with the reported character, so players could wipe them by deleting it
+ALTER TABLE reports ADD COLUMN IF NOT EXISTS reported_name TEXT NOT NULL DEFAULT '';
+ALTER TABLE reports ADD COLUMN IF NOT EXISTS reported_user_id INTEGER REFERENCES users(id) ON DELETE SET NULL;
+UPDATE reports r SET reported_name = a.name, reported_user_id = a.user_id
+FROM actors a
+WHERE a.id = r.reported_actor_id AND r.reported_name = '';
+ALTER TABLE reports ALTER COLUMN reported_actor_id DROP NOT NULL;
+ALTER TABLE reports DROP CONSTRAINT IF EXISTS reports_reported_actor_id_fkey;
+ALTER TABLE reports ADD CONSTRAINT reports_reported_actor_id_fkey
+    FOREIGN KEY (reported_actor_id) REFERENCES actors(id) ON DELETE SET NULL;
+
+-- What was said around the reporter shortly before they made the report
+CREATE TABLE IF NOT EXISTS report_chat_lines (
+    id SERIAL PRIMARY KEY,
+    report_id INTEGER NOT NULL REFERENCES reports(id) ON DELETE CASCADE,
+    sender_name TEXT NOT NULL,
+    kind TEXT NOT NULL, -- 'chat', 'yell', 'whisper' or 'channel'
+    recipient TEXT NOT NULL DEFAULT '', -- who a whisper was to, or which channel a message was in
+    msg TEXT NOT NULL,
+    sent_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS report_chat_lines_report_id_idx ON report_chat_lines (report_id);
+
+-- Moderation actions taken because of a report
+ALTER TABLE admin_audit_log ADD COLUMN IF NOT EXISTS report_id INTEGER REFERENCES reports(id) ON DELETE SET NULL;
+
+-- Everything players have said, kept for moderators to search for as long as the retention policy allows
+CREATE TABLE IF NOT EXISTS chat_log (
+    id BIGSERIAL PRIMARY KEY,
+    sender_actor_id INTEGER REFERENCES actors(id) ON DELETE SET NULL,
+    sender_name TEXT NOT NULL, -- what the sender was called at the time
+    level_id INTEGER NOT NULL,
+    kind TEXT NOT NULL, -- 'chat', 'yell', 'whisper' or 'channel'
+    recipient TEXT NOT NULL DEFAULT '', -- who a whisper was to, or which channel a message was in
+    raw_msg TEXT NOT NULL, -- what the sender typed
+    censored_msg TEXT NOT NULL, -- what everyone else saw
+    sent_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS chat_log_sent_at_idx ON chat_log (sent_at);
+CREATE INDEX IF NOT EXISTS chat_log_sender_actor_id_idx ON chat_log (sender_actor_id, sent_at);
@@ -0,0 +1,49 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: copyfrom.go
+
+package db
+
+import (
+	"context"
+)
+
+// iteratorForCreateChatLogEntries implements pgx.CopyFromSource.
+type iteratorForCreateChatLogEntries struct {
+	rows                 []CreateChatLogEntriesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateChatLogEntries) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateChatLogEntries) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].SenderActorID,
+		r.rows[0].SenderName,
+		r.rows[0].LevelID,
+		r.rows[0].Kind,
+		r.rows[0].Recipient,
+		r.rows[0].RawMsg,
+		r.rows[0].CensoredMsg,
+		r.rows[0].SentAt,
+	}, nil
+}
+
+func (r iteratorForCreateChatLogEntries) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateChatLogEntries(ctx context.Context, arg []CreateChatLogEntriesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"chat_log"}, []string{"sender_actor_id", "sender_name", "level_id", "kind", "recipient", "raw_msg", "censored_msg", "sent_at"}, &iteratorForCreateChatLogEntries{rows: arg})
+}
@@ -17,6 +17,10 @@ type Actor struct {
 	Y             int32
 	SpriteRegionX int32
 	SpriteRegionY int32
+	Body          int32
+	Hair          int32
+	Outfit        int32
+	Palette       int32
 }
 
 type ActorsInventory struct {
@@ -38,9 +42,120 @@ type ActorsSkill struct {
 	Xp      int32
 }
 
-type Admin struct {
-	ID     int32
-	UserID int32
+type AdminAuditLog struct {
+	ID          int32
+	AdminUserID pgtype.Int4
+	Action      string
+	Target      string
+	Details     string
+	CreatedAt   pgtype.Timestamp
+	ReportID    pgtype.Int4
+}
+
+type AuthLockout struct {
+	ID          int32
+	Kind        string
+	Subject     string
+	LockedUntil pgtype.Timestamp
+	CreatedAt   pgtype.Timestamp
+}
+
+type Ban struct {
+	ID              int32
+	UserID          int32
+	Reason          string
+	ExpiresAt       pgtype.Timestamp
+	CreatedByUserID pgtype.Int4
+	CreatedAt       pgtype.Timestamp
+	LiftedAt        pgtype.Timestamp
+}
+
+type ChatChannel struct {
+	Name         string
+	Kind         string
+	OwnerActorID pgtype.Int4
+	CreatedAt    pgtype.Timestamp
+}
+
+type ChatChannelBan struct {
+	ChannelName     string
+	ActorID         int32
+	BannedByActorID pgtype.Int4
+	CreatedAt       pgtype.Timestamp
+}
+
+type ChatChannelMember struct {
+	ChannelName string
+	ActorID     int32
+	JoinedAt    pgtype.Timestamp
+}
+
+type ChatChannelMute struct {
+	ChannelName string
+	ActorID     int32
+	CreatedAt   pgtype.Timestamp
+}
+
+type ChatLog struct {
+	ID            int64
+	SenderActorID pgtype.Int4
+	SenderName    string
+	LevelID       int32
+	Kind          string
+	Recipient     string
+	RawMsg        string
+	CensoredMsg   string
+	SentAt        pgtype.Timestamp
+}
+
+type Friend struct {
+	ActorID       int32
+	FriendActorID int32
+	Accepted      bool
+	CreatedAt     pgtype.Timestamp
+}
+
+type Guild struct {
+	ID               int32
+	Name             string
+	Tag              string
+	CreatedByActorID pgtype.Int4
+	CreatedAt        pgtype.Timestamp
+}
+
+type GuildBankItem struct {
+	GuildID  int32
+	ItemID   int32
+	Quantity int32
+}
+
+type GuildBankLog struct {
+	ID        int32
+	GuildID   int32
+	ActorID   pgtype.Int4
+	ItemID    int32
+	Quantity  int32
+	CreatedAt pgtype.Timestamp
+}
+
+type GuildInvite struct {
+	GuildID          int32
+	ActorID          int32
+	InvitedByActorID pgtype.Int4
+	CreatedAt        pgtype.Timestamp
+}
+
+type GuildMember struct {
+	ActorID  int32
+	GuildID  int32
+	Rank     string
+	JoinedAt pgtype.Timestamp
+}
+
+type Ignore struct {
+	ActorID        int32
+	IgnoredActorID int32
+	CreatedAt      pgtype.Timestamp
 }
 
 type Item struct {
@@ -113,6 +228,16 @@ type LevelsTscnDatum struct {
 	TscnData []byte
 }
 
+type Mute struct {
+	ID              int32
+	UserID          int32
+	Reason          string
+	ExpiresAt       pgtype.Timestamp
+	CreatedByUserID pgtype.Int4
+	CreatedAt       pgtype.Timestamp
+	LiftedAt        pgtype.Timestamp
+}
+
 type Quest struct {
 	ID                int32
 	Name              string
@@ -122,6 +247,31 @@ type Quest struct {
 	RewardItemID      int32
 }
 
+type Report struct {
+	ID              int32
+	ReporterActorID pgtype.Int4
+	ReportedActorID pgtype.Int4
+	ReportedName    string
+	ReportedUserID  pgtype.Int4
+	LevelID         int32
+	Reason          string
+	Status          string
+	ClaimedByUserID pgtype.Int4
+	Resolution      string
+	CreatedAt       pgtype.Timestamp
+	ResolvedAt      pgtype.Timestamp
+}
+
+type ReportChatLine struct {
+	ID         int32
+	ReportID   int32
+	SenderName string
+	Kind       string
+	Recipient  string
+	Msg        string
+	SentAt     pgtype.Timestamp
+}
+
 type ToolProperty struct {
 	ID            int32
 	Strength      int32
@@ -131,7 +281,15 @@ type ToolProperty struct {
 }
 
 type User struct {
-	ID           int32
-	Username     string
-	PasswordHash string
+	ID                 int32
+	Username           string
+	PasswordHash       string
+	MustChangePassword bool
+}
+
+type UserRole struct {
+	UserID          int32
+	Role            string
+	GrantedByUserID pgtype.Int4
+	GrantedAt       pgtype.Timestamp
 }
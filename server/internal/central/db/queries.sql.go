@@ -11,6 +11,25 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const acceptFriendRequest = `-- name: AcceptFriendRequest :execrows
+UPDATE friends
+SET accepted = TRUE
+WHERE actor_id = $1 AND friend_actor_id = $2 AND NOT accepted
+`
+
+type AcceptFriendRequestParams struct {
+	ActorID       int32
+	FriendActorID int32
+}
+
+func (q *Queries) AcceptFriendRequest(ctx context.Context, arg AcceptFriendRequestParams) (int64, error) {
+	result, err := q.db.Exec(ctx, acceptFriendRequest, arg.ActorID, arg.FriendActorID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const addActorInventoryItem = `-- name: AddActorInventoryItem :exec
 INSERT INTO actors_inventory (
     actor_id, item_id, quantity
@@ -72,13 +91,152 @@ func (q *Queries) AddActorXp(ctx context.Context, arg AddActorXpParams) error {
 	return err
 }
 
+const addChatChannelBan = `-- name: AddChatChannelBan :exec
+INSERT INTO chat_channel_bans (
+    channel_name, actor_id, banned_by_actor_id
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT DO NOTHING
+`
+
+type AddChatChannelBanParams struct {
+	ChannelName     string
+	ActorID         int32
+	BannedByActorID pgtype.Int4
+}
+
+func (q *Queries) AddChatChannelBan(ctx context.Context, arg AddChatChannelBanParams) error {
+	_, err := q.db.Exec(ctx, addChatChannelBan, arg.ChannelName, arg.ActorID, arg.BannedByActorID)
+	return err
+}
+
+const addChatChannelMember = `-- name: AddChatChannelMember :exec
+INSERT INTO chat_channel_members (
+    channel_name, actor_id
+) VALUES (
+    $1, $2
+)
+ON CONFLICT DO NOTHING
+`
+
+type AddChatChannelMemberParams struct {
+	ChannelName string
+	ActorID     int32
+}
+
+func (q *Queries) AddChatChannelMember(ctx context.Context, arg AddChatChannelMemberParams) error {
+	_, err := q.db.Exec(ctx, addChatChannelMember, arg.ChannelName, arg.ActorID)
+	return err
+}
+
+const addChatChannelMute = `-- name: AddChatChannelMute :exec
+INSERT INTO chat_channel_mutes (
+    channel_name, actor_id
+) VALUES (
+    $1, $2
+)
+ON CONFLICT DO NOTHING
+`
+
+type AddChatChannelMuteParams struct {
+	ChannelName string
+	ActorID     int32
+}
+
+func (q *Queries) AddChatChannelMute(ctx context.Context, arg AddChatChannelMuteParams) error {
+	_, err := q.db.Exec(ctx, addChatChannelMute, arg.ChannelName, arg.ActorID)
+	return err
+}
+
+const addGuildBankItem = `-- name: AddGuildBankItem :exec
+INSERT INTO guild_bank_items (
+    guild_id, item_id, quantity
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT(guild_id, item_id) DO UPDATE
+SET quantity = guild_bank_items.quantity + excluded.quantity
+`
+
+type AddGuildBankItemParams struct {
+	GuildID  int32
+	ItemID   int32
+	Quantity int32
+}
+
+func (q *Queries) AddGuildBankItem(ctx context.Context, arg AddGuildBankItemParams) error {
+	_, err := q.db.Exec(ctx, addGuildBankItem, arg.GuildID, arg.ItemID, arg.Quantity)
+	return err
+}
+
+const addGuildMember = `-- name: AddGuildMember :exec
+INSERT INTO guild_members (
+    actor_id, guild_id, rank
+) VALUES (
+    $1, $2, $3
+)
+`
+
+type AddGuildMemberParams struct {
+	ActorID int32
+	GuildID int32
+	Rank    string
+}
+
+func (q *Queries) AddGuildMember(ctx context.Context, arg AddGuildMemberParams) error {
+	_, err := q.db.Exec(ctx, addGuildMember, arg.ActorID, arg.GuildID, arg.Rank)
+	return err
+}
+
+const addIgnore = `-- name: AddIgnore :execrows
+INSERT INTO ignores (
+    actor_id, ignored_actor_id
+) VALUES (
+    $1, $2
+)
+ON CONFLICT DO NOTHING
+`
+
+type AddIgnoreParams struct {
+	ActorID        int32
+	IgnoredActorID int32
+}
+
+func (q *Queries) AddIgnore(ctx context.Context, arg AddIgnoreParams) (int64, error) {
+	result, err := q.db.Exec(ctx, addIgnore, arg.ActorID, arg.IgnoredActorID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const claimReport = `-- name: ClaimReport :execrows
+UPDATE reports
+SET status = 'claimed', claimed_by_user_id = $1
+WHERE id = $2 AND status = 'open'
+`
+
+type ClaimReportParams struct {
+	UserID pgtype.Int4
+	ID     int32
+}
+
+func (q *Queries) ClaimReport(ctx context.Context, arg ClaimReportParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimReport, arg.UserID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const createActor = `-- name: CreateActor :one
 INSERT INTO actors (
-    user_id, name, level_id, x, y, sprite_region_x, sprite_region_y
+    user_id, name, level_id, x, y, sprite_region_x, sprite_region_y, body, hair, outfit, palette
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 )
-RETURNING id, user_id, name, level_id, x, y, sprite_region_x, sprite_region_y
+RETURNING id, user_id, name, level_id, x, y, sprite_region_x, sprite_region_y, body, hair, outfit, palette
 `
 
 type CreateActorParams struct {
@@ -89,6 +247,10 @@ type CreateActorParams struct {
 	Y             int32
 	SpriteRegionX int32
 	SpriteRegionY int32
+	Body          int32
+	Hair          int32
+	Outfit        int32
+	Palette       int32
 }
 
 func (q *Queries) CreateActor(ctx context.Context, arg CreateActorParams) (Actor, error) {
@@ -100,6 +262,10 @@ func (q *Queries) CreateActor(ctx context.Context, arg CreateActorParams) (Actor
 		arg.Y,
 		arg.SpriteRegionX,
 		arg.SpriteRegionY,
+		arg.Body,
+		arg.Hair,
+		arg.Outfit,
+		arg.Palette,
 	)
 	var i Actor
 	err := row.Scan(
@@ -111,6 +277,10 @@ func (q *Queries) CreateActor(ctx context.Context, arg CreateActorParams) (Actor
 		&i.Y,
 		&i.SpriteRegionX,
 		&i.SpriteRegionY,
+		&i.Body,
+		&i.Hair,
+		&i.Outfit,
+		&i.Palette,
 	)
 	return i, err
 }
@@ -121,8 +291,8 @@ INSERT INTO actors (
 ) VALUES (
     $1, $2, $3, $4, $5, $6
 )
-ON CONFLICT (user_id) DO NOTHING
-RETURNING id, user_id, name, level_id, x, y, sprite_region_x, sprite_region_y
+ON CONFLICT DO NOTHING
+RETURNING id, user_id, name, level_id, x, y, sprite_region_x, sprite_region_y, body, hair, outfit, palette
 `
 
 type CreateActorIfNotExistsParams struct {
@@ -153,27 +323,219 @@ func (q *Queries) CreateActorIfNotExists(ctx context.Context, arg CreateActorIfN
 		&i.Y,
 		&i.SpriteRegionX,
 		&i.SpriteRegionY,
+		&i.Body,
+		&i.Hair,
+		&i.Outfit,
+		&i.Palette,
+	)
+	return i, err
+}
+
+const createAdminAuditLogEntry = `-- name: CreateAdminAuditLogEntry :exec
+INSERT INTO admin_audit_log (
+    admin_user_id, action, target, details, report_id
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+`
+
+type CreateAdminAuditLogEntryParams struct {
+	AdminUserID pgtype.Int4
+	Action      string
+	Target      string
+	Details     string
+	ReportID    pgtype.Int4
+}
+
+func (q *Queries) CreateAdminAuditLogEntry(ctx context.Context, arg CreateAdminAuditLogEntryParams) error {
+	_, err := q.db.Exec(ctx, createAdminAuditLogEntry,
+		arg.AdminUserID,
+		arg.Action,
+		arg.Target,
+		arg.Details,
+		arg.ReportID,
+	)
+	return err
+}
+
+const createAuthLockout = `-- name: CreateAuthLockout :exec
+INSERT INTO auth_lockouts (
+    kind, subject, locked_until
+) VALUES (
+    $1, $2, $3
+)
+`
+
+type CreateAuthLockoutParams struct {
+	Kind        string
+	Subject     string
+	LockedUntil pgtype.Timestamp
+}
+
+func (q *Queries) CreateAuthLockout(ctx context.Context, arg CreateAuthLockoutParams) error {
+	_, err := q.db.Exec(ctx, createAuthLockout, arg.Kind, arg.Subject, arg.LockedUntil)
+	return err
+}
+
+const createBan = `-- name: CreateBan :one
+INSERT INTO bans (
+    user_id, reason, expires_at, created_by_user_id
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING id, user_id, reason, expires_at, created_by_user_id, created_at, lifted_at
+`
+
+type CreateBanParams struct {
+	UserID          int32
+	Reason          string
+	ExpiresAt       pgtype.Timestamp
+	CreatedByUserID pgtype.Int4
+}
+
+func (q *Queries) CreateBan(ctx context.Context, arg CreateBanParams) (Ban, error) {
+	row := q.db.QueryRow(ctx, createBan,
+		arg.UserID,
+		arg.Reason,
+		arg.ExpiresAt,
+		arg.CreatedByUserID,
+	)
+	var i Ban
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Reason,
+		&i.ExpiresAt,
+		&i.CreatedByUserID,
+		&i.CreatedAt,
+		&i.LiftedAt,
 	)
 	return i, err
 }
 
-const createAdminIfNotExists = `-- name: CreateAdminIfNotExists :one
-INSERT INTO admins (
-    user_id
+const createChatChannelIfNotExists = `-- name: CreateChatChannelIfNotExists :exec
+INSERT INTO chat_channels (
+    name, kind, owner_actor_id
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT DO NOTHING
+`
+
+type CreateChatChannelIfNotExistsParams struct {
+	Name         string
+	Kind         string
+	OwnerActorID pgtype.Int4
+}
+
+func (q *Queries) CreateChatChannelIfNotExists(ctx context.Context, arg CreateChatChannelIfNotExistsParams) error {
+	_, err := q.db.Exec(ctx, createChatChannelIfNotExists, arg.Name, arg.Kind, arg.OwnerActorID)
+	return err
+}
+
+type CreateChatLogEntriesParams struct {
+	SenderActorID pgtype.Int4
+	SenderName    string
+	LevelID       int32
+	Kind          string
+	Recipient     string
+	RawMsg        string
+	CensoredMsg   string
+	SentAt        pgtype.Timestamp
+}
+
+const createFriendRequest = `-- name: CreateFriendRequest :exec
+INSERT INTO friends (
+    actor_id, friend_actor_id
 ) VALUES (
-    $1
+    $1, $2
+)
+ON CONFLICT DO NOTHING
+`
+
+type CreateFriendRequestParams struct {
+	ActorID       int32
+	FriendActorID int32
+}
+
+func (q *Queries) CreateFriendRequest(ctx context.Context, arg CreateFriendRequestParams) error {
+	_, err := q.db.Exec(ctx, createFriendRequest, arg.ActorID, arg.FriendActorID)
+	return err
+}
+
+const createGuild = `-- name: CreateGuild :one
+INSERT INTO guilds (
+    name, tag, created_by_actor_id
+) VALUES (
+    $1, $2, $3
 )
-ON CONFLICT (user_id) DO NOTHING
-RETURNING id, user_id
+RETURNING id, name, tag, created_by_actor_id, created_at
 `
 
-func (q *Queries) CreateAdminIfNotExists(ctx context.Context, userID int32) (Admin, error) {
-	row := q.db.QueryRow(ctx, createAdminIfNotExists, userID)
-	var i Admin
-	err := row.Scan(&i.ID, &i.UserID)
+type CreateGuildParams struct {
+	Name             string
+	Tag              string
+	CreatedByActorID pgtype.Int4
+}
+
+func (q *Queries) CreateGuild(ctx context.Context, arg CreateGuildParams) (Guild, error) {
+	row := q.db.QueryRow(ctx, createGuild, arg.Name, arg.Tag, arg.CreatedByActorID)
+	var i Guild
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Tag,
+		&i.CreatedByActorID,
+		&i.CreatedAt,
+	)
 	return i, err
 }
 
+const createGuildBankLogEntry = `-- name: CreateGuildBankLogEntry :exec
+INSERT INTO guild_bank_log (
+    guild_id, actor_id, item_id, quantity
+) VALUES (
+    $1, $2, $3, $4
+)
+`
+
+type CreateGuildBankLogEntryParams struct {
+	GuildID  int32
+	ActorID  pgtype.Int4
+	ItemID   int32
+	Quantity int32
+}
+
+func (q *Queries) CreateGuildBankLogEntry(ctx context.Context, arg CreateGuildBankLogEntryParams) error {
+	_, err := q.db.Exec(ctx, createGuildBankLogEntry,
+		arg.GuildID,
+		arg.ActorID,
+		arg.ItemID,
+		arg.Quantity,
+	)
+	return err
+}
+
+const createGuildInvite = `-- name: CreateGuildInvite :exec
+INSERT INTO guild_invites (
+    guild_id, actor_id, invited_by_actor_id
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT DO NOTHING
+`
+
+type CreateGuildInviteParams struct {
+	GuildID          int32
+	ActorID          int32
+	InvitedByActorID pgtype.Int4
+}
+
+func (q *Queries) CreateGuildInvite(ctx context.Context, arg CreateGuildInviteParams) error {
+	_, err := q.db.Exec(ctx, createGuildInvite, arg.GuildID, arg.ActorID, arg.InvitedByActorID)
+	return err
+}
+
 const createItemIfNotExists = `-- name: CreateItemIfNotExists :one
 INSERT INTO items (
     name, description, value, sprite_region_x, sprite_region_y, tool_properties_id, grants_vip, tradeable
@@ -428,6 +790,42 @@ func (q *Queries) CreateLevelShrub(ctx context.Context, arg CreateLevelShrubPara
 	return i, err
 }
 
+const createMute = `-- name: CreateMute :one
+INSERT INTO mutes (
+    user_id, reason, expires_at, created_by_user_id
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING id, user_id, reason, expires_at, created_by_user_id, created_at, lifted_at
+`
+
+type CreateMuteParams struct {
+	UserID          int32
+	Reason          string
+	ExpiresAt       pgtype.Timestamp
+	CreatedByUserID pgtype.Int4
+}
+
+func (q *Queries) CreateMute(ctx context.Context, arg CreateMuteParams) (Mute, error) {
+	row := q.db.QueryRow(ctx, createMute,
+		arg.UserID,
+		arg.Reason,
+		arg.ExpiresAt,
+		arg.CreatedByUserID,
+	)
+	var i Mute
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Reason,
+		&i.ExpiresAt,
+		&i.CreatedByUserID,
+		&i.CreatedAt,
+		&i.LiftedAt,
+	)
+	return i, err
+}
+
 const createQuestIfNotExists = `-- name: CreateQuestIfNotExists :one
 INSERT INTO quests (
     name, start_dialogue, required_item_id, completed_dialogue, reward_item_id
@@ -466,6 +864,67 @@ func (q *Queries) CreateQuestIfNotExists(ctx context.Context, arg CreateQuestIfN
 	return i, err
 }
 
+const createReport = `-- name: CreateReport :one
+INSERT INTO reports (
+    reporter_actor_id, reported_actor_id, reported_name, reported_user_id, level_id, reason
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+RETURNING id
+`
+
+type CreateReportParams struct {
+	ReporterActorID pgtype.Int4
+	ReportedActorID pgtype.Int4
+	ReportedName    string
+	ReportedUserID  pgtype.Int4
+	LevelID         int32
+	Reason          string
+}
+
+func (q *Queries) CreateReport(ctx context.Context, arg CreateReportParams) (int32, error) {
+	row := q.db.QueryRow(ctx, createReport,
+		arg.ReporterActorID,
+		arg.ReportedActorID,
+		arg.ReportedName,
+		arg.ReportedUserID,
+		arg.LevelID,
+		arg.Reason,
+	)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createReportChatLine = `-- name: CreateReportChatLine :exec
+INSERT INTO report_chat_lines (
+    report_id, sender_name, kind, recipient, msg, sent_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+`
+
+type CreateReportChatLineParams struct {
+	ReportID   int32
+	SenderName string
+	Kind       string
+	Recipient  string
+	Msg        string
+	SentAt     pgtype.Timestamp
+}
+
+func (q *Queries) CreateReportChatLine(ctx context.Context, arg CreateReportChatLineParams) error {
+	_, err := q.db.Exec(ctx, createReportChatLine,
+		arg.ReportID,
+		arg.SenderName,
+		arg.Kind,
+		arg.Recipient,
+		arg.Msg,
+		arg.SentAt,
+	)
+	return err
+}
+
 const createToolPropertiesIfNotExists = `-- name: CreateToolPropertiesIfNotExists :one
 INSERT INTO tool_properties (
     strength, level_required, harvests, key_id
@@ -507,7 +966,7 @@ INSERT INTO users (
 ) VALUES (
     $1, $2
 )
-RETURNING id, username, password_hash
+RETURNING id, username, password_hash, must_change_password
 `
 
 type CreateUserParams struct {
@@ -518,7 +977,12 @@ type CreateUserParams struct {
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
 	row := q.db.QueryRow(ctx, createUser, arg.Username, arg.PasswordHash)
 	var i User
-	err := row.Scan(&i.ID, &i.Username, &i.PasswordHash)
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.MustChangePassword,
+	)
 	return i, err
 }
 
@@ -529,7 +993,7 @@ INSERT INTO users (
     $1, $2
 )
 ON CONFLICT (username) DO NOTHING
-RETURNING id, username, password_hash
+RETURNING id, username, password_hash, must_change_password
 `
 
 type CreateUserIfNotExistsParams struct {
@@ -540,70 +1004,181 @@ type CreateUserIfNotExistsParams struct {
 func (q *Queries) CreateUserIfNotExists(ctx context.Context, arg CreateUserIfNotExistsParams) (User, error) {
 	row := q.db.QueryRow(ctx, createUserIfNotExists, arg.Username, arg.PasswordHash)
 	var i User
-	err := row.Scan(&i.ID, &i.Username, &i.PasswordHash)
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.MustChangePassword,
+	)
 	return i, err
 }
 
-const deleteLevelCollisionPointsByLevelId = `-- name: DeleteLevelCollisionPointsByLevelId :exec
-DELETE FROM levels_collision_points
-WHERE level_id = $1
+const deleteActor = `-- name: DeleteActor :exec
+DELETE FROM actors
+WHERE id = $1
+AND user_id = $2
 `
 
-func (q *Queries) DeleteLevelCollisionPointsByLevelId(ctx context.Context, levelID int32) error {
-	_, err := q.db.Exec(ctx, deleteLevelCollisionPointsByLevelId, levelID)
+type DeleteActorParams struct {
+	ID     int32
+	UserID int32
+}
+
+func (q *Queries) DeleteActor(ctx context.Context, arg DeleteActorParams) error {
+	_, err := q.db.Exec(ctx, deleteActor, arg.ID, arg.UserID)
 	return err
 }
 
-const deleteLevelDoorsByLevelId = `-- name: DeleteLevelDoorsByLevelId :exec
-DELETE FROM levels_doors
-WHERE level_id = $1
+const deleteChatChannel = `-- name: DeleteChatChannel :exec
+DELETE FROM chat_channels
+WHERE name = $1
 `
 
-func (q *Queries) DeleteLevelDoorsByLevelId(ctx context.Context, levelID int32) error {
-	_, err := q.db.Exec(ctx, deleteLevelDoorsByLevelId, levelID)
+func (q *Queries) DeleteChatChannel(ctx context.Context, name string) error {
+	_, err := q.db.Exec(ctx, deleteChatChannel, name)
 	return err
 }
 
-const deleteLevelGroundItem = `-- name: DeleteLevelGroundItem :exec
-DELETE FROM levels_ground_items
-WHERE id IN (
-    SELECT lgi.id FROM levels_ground_items lgi
-    WHERE lgi.level_id = $1
-    AND lgi.item_id = $2
-    AND lgi.x = $3
-    AND lgi.y = $4
-    LIMIT 1
-)
+const deleteChatLogBefore = `-- name: DeleteChatLogBefore :execrows
+DELETE FROM chat_log
+WHERE sent_at < $1
 `
 
-type DeleteLevelGroundItemParams struct {
-	LevelID int32
-	ItemID  int32
-	X       int32
-	Y       int32
+func (q *Queries) DeleteChatLogBefore(ctx context.Context, sentAt pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteChatLogBefore, sentAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-func (q *Queries) DeleteLevelGroundItem(ctx context.Context, arg DeleteLevelGroundItemParams) error {
-	_, err := q.db.Exec(ctx, deleteLevelGroundItem,
-		arg.LevelID,
-		arg.ItemID,
-		arg.X,
-		arg.Y,
-	)
+const deleteEmptyGuildBankItems = `-- name: DeleteEmptyGuildBankItems :exec
+DELETE FROM guild_bank_items
+WHERE guild_id = $1 AND quantity = 0
+`
+
+func (q *Queries) DeleteEmptyGuildBankItems(ctx context.Context, guildID int32) error {
+	_, err := q.db.Exec(ctx, deleteEmptyGuildBankItems, guildID)
 	return err
 }
 
-const deleteLevelGroundItemsByLevelId = `-- name: DeleteLevelGroundItemsByLevelId :exec
-DELETE FROM levels_ground_items
-WHERE level_id = $1
+const deleteFriendship = `-- name: DeleteFriendship :execrows
+DELETE FROM friends
+WHERE (actor_id = $1 AND friend_actor_id = $2)
+OR (actor_id = $2 AND friend_actor_id = $1)
 `
 
-func (q *Queries) DeleteLevelGroundItemsByLevelId(ctx context.Context, levelID int32) error {
-	_, err := q.db.Exec(ctx, deleteLevelGroundItemsByLevelId, levelID)
-	return err
+type DeleteFriendshipParams struct {
+	ActorID       int32
+	FriendActorID int32
 }
 
-const deleteLevelOre = `-- name: DeleteLevelOre :exec
+func (q *Queries) DeleteFriendship(ctx context.Context, arg DeleteFriendshipParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteFriendship, arg.ActorID, arg.FriendActorID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteGuild = `-- name: DeleteGuild :exec
+DELETE FROM guilds
+WHERE id = $1
+`
+
+func (q *Queries) DeleteGuild(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deleteGuild, id)
+	return err
+}
+
+const deleteGuildInvite = `-- name: DeleteGuildInvite :execrows
+DELETE FROM guild_invites
+WHERE guild_id = $1 AND actor_id = $2
+`
+
+type DeleteGuildInviteParams struct {
+	GuildID int32
+	ActorID int32
+}
+
+func (q *Queries) DeleteGuildInvite(ctx context.Context, arg DeleteGuildInviteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteGuildInvite, arg.GuildID, arg.ActorID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteGuildInvitesByActorId = `-- name: DeleteGuildInvitesByActorId :exec
+DELETE FROM guild_invites
+WHERE actor_id = $1
+`
+
+func (q *Queries) DeleteGuildInvitesByActorId(ctx context.Context, actorID int32) error {
+	_, err := q.db.Exec(ctx, deleteGuildInvitesByActorId, actorID)
+	return err
+}
+
+const deleteLevelCollisionPointsByLevelId = `-- name: DeleteLevelCollisionPointsByLevelId :exec
+DELETE FROM levels_collision_points
+WHERE level_id = $1
+`
+
+func (q *Queries) DeleteLevelCollisionPointsByLevelId(ctx context.Context, levelID int32) error {
+	_, err := q.db.Exec(ctx, deleteLevelCollisionPointsByLevelId, levelID)
+	return err
+}
+
+const deleteLevelDoorsByLevelId = `-- name: DeleteLevelDoorsByLevelId :exec
+DELETE FROM levels_doors
+WHERE level_id = $1
+`
+
+func (q *Queries) DeleteLevelDoorsByLevelId(ctx context.Context, levelID int32) error {
+	_, err := q.db.Exec(ctx, deleteLevelDoorsByLevelId, levelID)
+	return err
+}
+
+const deleteLevelGroundItem = `-- name: DeleteLevelGroundItem :exec
+DELETE FROM levels_ground_items
+WHERE id IN (
+    SELECT lgi.id FROM levels_ground_items lgi
+    WHERE lgi.level_id = $1
+    AND lgi.item_id = $2
+    AND lgi.x = $3
+    AND lgi.y = $4
+    LIMIT 1
+)
+`
+
+type DeleteLevelGroundItemParams struct {
+	LevelID int32
+	ItemID  int32
+	X       int32
+	Y       int32
+}
+
+func (q *Queries) DeleteLevelGroundItem(ctx context.Context, arg DeleteLevelGroundItemParams) error {
+	_, err := q.db.Exec(ctx, deleteLevelGroundItem,
+		arg.LevelID,
+		arg.ItemID,
+		arg.X,
+		arg.Y,
+	)
+	return err
+}
+
+const deleteLevelGroundItemsByLevelId = `-- name: DeleteLevelGroundItemsByLevelId :exec
+DELETE FROM levels_ground_items
+WHERE level_id = $1
+`
+
+func (q *Queries) DeleteLevelGroundItemsByLevelId(ctx context.Context, levelID int32) error {
+	_, err := q.db.Exec(ctx, deleteLevelGroundItemsByLevelId, levelID)
+	return err
+}
+
+const deleteLevelOre = `-- name: DeleteLevelOre :exec
 DELETE FROM levels_ores
 WHERE id IN (
     SELECT lo.id FROM levels_ores lo
@@ -677,15 +1252,62 @@ func (q *Queries) DeleteLevelTscnDataByLevelId(ctx context.Context, levelID int3
 	return err
 }
 
-const getActorByUserId = `-- name: GetActorByUserId :one
-SELECT id, user_id, name, level_id, x, y, sprite_region_x, sprite_region_y FROM actors
+const getActiveBan = `-- name: GetActiveBan :one
+SELECT id, user_id, reason, expires_at, created_by_user_id, created_at, lifted_at FROM bans
 WHERE user_id = $1
-ORDER BY id DESC
+AND lifted_at IS NULL
+AND (expires_at IS NULL OR expires_at > (NOW() AT TIME ZONE 'UTC'))
+ORDER BY expires_at DESC NULLS FIRST
+LIMIT 1
+`
+
+func (q *Queries) GetActiveBan(ctx context.Context, userID int32) (Ban, error) {
+	row := q.db.QueryRow(ctx, getActiveBan, userID)
+	var i Ban
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Reason,
+		&i.ExpiresAt,
+		&i.CreatedByUserID,
+		&i.CreatedAt,
+		&i.LiftedAt,
+	)
+	return i, err
+}
+
+const getActiveMute = `-- name: GetActiveMute :one
+SELECT id, user_id, reason, expires_at, created_by_user_id, created_at, lifted_at FROM mutes
+WHERE user_id = $1
+AND lifted_at IS NULL
+AND (expires_at IS NULL OR expires_at > (NOW() AT TIME ZONE 'UTC'))
+ORDER BY expires_at DESC NULLS FIRST
+LIMIT 1
+`
+
+func (q *Queries) GetActiveMute(ctx context.Context, userID int32) (Mute, error) {
+	row := q.db.QueryRow(ctx, getActiveMute, userID)
+	var i Mute
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Reason,
+		&i.ExpiresAt,
+		&i.CreatedByUserID,
+		&i.CreatedAt,
+		&i.LiftedAt,
+	)
+	return i, err
+}
+
+const getActorByName = `-- name: GetActorByName :one
+SELECT id, user_id, name, level_id, x, y, sprite_region_x, sprite_region_y, body, hair, outfit, palette FROM actors
+WHERE LOWER(name) = LOWER($1)
 LIMIT 1
 `
 
-func (q *Queries) GetActorByUserId(ctx context.Context, userID int32) (Actor, error) {
-	row := q.db.QueryRow(ctx, getActorByUserId, userID)
+func (q *Queries) GetActorByName(ctx context.Context, name string) (Actor, error) {
+	row := q.db.QueryRow(ctx, getActorByName, name)
 	var i Actor
 	err := row.Scan(
 		&i.ID,
@@ -696,6 +1318,10 @@ func (q *Queries) GetActorByUserId(ctx context.Context, userID int32) (Actor, er
 		&i.Y,
 		&i.SpriteRegionX,
 		&i.SpriteRegionY,
+		&i.Body,
+		&i.Hair,
+		&i.Outfit,
+		&i.Palette,
 	)
 	return i, err
 }
@@ -879,138 +1505,34 @@ func (q *Queries) GetActorSkillsXp(ctx context.Context, actorID int32) ([]Actors
 	return items, nil
 }
 
-const getAdminByUserId = `-- name: GetAdminByUserId :one
-SELECT id, user_id FROM admins
-WHERE user_id = $1 LIMIT 1
-`
-
-func (q *Queries) GetAdminByUserId(ctx context.Context, userID int32) (Admin, error) {
-	row := q.db.QueryRow(ctx, getAdminByUserId, userID)
-	var i Admin
-	err := row.Scan(&i.ID, &i.UserID)
-	return i, err
-}
-
-const getItem = `-- name: GetItem :one
-SELECT id, name, description, value, sprite_region_x, sprite_region_y, tool_properties_id, grants_vip, tradeable FROM items
-WHERE name = $1 AND description = $2 AND value = $3 AND sprite_region_x = $4 AND sprite_region_y = $5 and grants_vip = $6 and tradeable = $7
-LIMIT 1
-`
-
-type GetItemParams struct {
-	Name          string
-	Description   string
-	Value         int32
-	SpriteRegionX int32
-	SpriteRegionY int32
-	GrantsVip     bool
-	Tradeable     bool
-}
-
-func (q *Queries) GetItem(ctx context.Context, arg GetItemParams) (Item, error) {
-	row := q.db.QueryRow(ctx, getItem,
-		arg.Name,
-		arg.Description,
-		arg.Value,
-		arg.SpriteRegionX,
-		arg.SpriteRegionY,
-		arg.GrantsVip,
-		arg.Tradeable,
-	)
-	var i Item
-	err := row.Scan(
-		&i.ID,
-		&i.Name,
-		&i.Description,
-		&i.Value,
-		&i.SpriteRegionX,
-		&i.SpriteRegionY,
-		&i.ToolPropertiesID,
-		&i.GrantsVip,
-		&i.Tradeable,
-	)
-	return i, err
-}
-
-const getItemById = `-- name: GetItemById :one
-SELECT id, name, description, value, sprite_region_x, sprite_region_y, tool_properties_id, grants_vip, tradeable FROM items
-WHERE id = $1 LIMIT 1
-`
-
-func (q *Queries) GetItemById(ctx context.Context, id int32) (Item, error) {
-	row := q.db.QueryRow(ctx, getItemById, id)
-	var i Item
-	err := row.Scan(
-		&i.ID,
-		&i.Name,
-		&i.Description,
-		&i.Value,
-		&i.SpriteRegionX,
-		&i.SpriteRegionY,
-		&i.ToolPropertiesID,
-		&i.GrantsVip,
-		&i.Tradeable,
-	)
-	return i, err
-}
-
-const getLevelByGdResPath = `-- name: GetLevelByGdResPath :one
-SELECT id, gd_res_path, added_by_user_id, added, last_updated_by_user_id, last_updated FROM levels
-WHERE gd_res_path = $1 LIMIT 1
-`
-
-func (q *Queries) GetLevelByGdResPath(ctx context.Context, gdResPath string) (Level, error) {
-	row := q.db.QueryRow(ctx, getLevelByGdResPath, gdResPath)
-	var i Level
-	err := row.Scan(
-		&i.ID,
-		&i.GdResPath,
-		&i.AddedByUserID,
-		&i.Added,
-		&i.LastUpdatedByUserID,
-		&i.LastUpdated,
-	)
-	return i, err
-}
-
-const getLevelById = `-- name: GetLevelById :one
-SELECT id, gd_res_path, added_by_user_id, added, last_updated_by_user_id, last_updated FROM levels
-WHERE id = $1 LIMIT 1
-`
-
-func (q *Queries) GetLevelById(ctx context.Context, id int32) (Level, error) {
-	row := q.db.QueryRow(ctx, getLevelById, id)
-	var i Level
-	err := row.Scan(
-		&i.ID,
-		&i.GdResPath,
-		&i.AddedByUserID,
-		&i.Added,
-		&i.LastUpdatedByUserID,
-		&i.LastUpdated,
-	)
-	return i, err
-}
-
-const getLevelCollisionPointsByLevelId = `-- name: GetLevelCollisionPointsByLevelId :many
-SELECT id, level_id, x, y FROM levels_collision_points
-WHERE level_id = $1
+const getActorsByUserId = `-- name: GetActorsByUserId :many
+SELECT id, user_id, name, level_id, x, y, sprite_region_x, sprite_region_y, body, hair, outfit, palette FROM actors
+WHERE user_id = $1
+ORDER BY id
 `
 
-func (q *Queries) GetLevelCollisionPointsByLevelId(ctx context.Context, levelID int32) ([]LevelsCollisionPoint, error) {
-	rows, err := q.db.Query(ctx, getLevelCollisionPointsByLevelId, levelID)
+func (q *Queries) GetActorsByUserId(ctx context.Context, userID int32) ([]Actor, error) {
+	rows, err := q.db.Query(ctx, getActorsByUserId, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []LevelsCollisionPoint
+	var items []Actor
 	for rows.Next() {
-		var i LevelsCollisionPoint
+		var i Actor
 		if err := rows.Scan(
 			&i.ID,
+			&i.UserID,
+			&i.Name,
 			&i.LevelID,
 			&i.X,
 			&i.Y,
+			&i.SpriteRegionX,
+			&i.SpriteRegionY,
+			&i.Body,
+			&i.Hair,
+			&i.Outfit,
+			&i.Palette,
 		); err != nil {
 			return nil, err
 		}
@@ -1022,30 +1544,25 @@ func (q *Queries) GetLevelCollisionPointsByLevelId(ctx context.Context, levelID
 	return items, nil
 }
 
-const getLevelDoorsByLevelId = `-- name: GetLevelDoorsByLevelId :many
-SELECT id, level_id, destination_level_id, destination_x, destination_y, x, y, key_id FROM levels_doors
-WHERE level_id = $1
+const getChatChannelBans = `-- name: GetChatChannelBans :many
+SELECT channel_name, actor_id FROM chat_channel_bans
 `
 
-func (q *Queries) GetLevelDoorsByLevelId(ctx context.Context, levelID int32) ([]LevelsDoor, error) {
-	rows, err := q.db.Query(ctx, getLevelDoorsByLevelId, levelID)
+type GetChatChannelBansRow struct {
+	ChannelName string
+	ActorID     int32
+}
+
+func (q *Queries) GetChatChannelBans(ctx context.Context) ([]GetChatChannelBansRow, error) {
+	rows, err := q.db.Query(ctx, getChatChannelBans)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []LevelsDoor
+	var items []GetChatChannelBansRow
 	for rows.Next() {
-		var i LevelsDoor
-		if err := rows.Scan(
-			&i.ID,
-			&i.LevelID,
-			&i.DestinationLevelID,
-			&i.DestinationX,
-			&i.DestinationY,
-			&i.X,
-			&i.Y,
-			&i.KeyID,
-		); err != nil {
+		var i GetChatChannelBansRow
+		if err := rows.Scan(&i.ChannelName, &i.ActorID); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1056,25 +1573,640 @@ func (q *Queries) GetLevelDoorsByLevelId(ctx context.Context, levelID int32) ([]
 	return items, nil
 }
 
-const getLevelGroundItemsByLevelId = `-- name: GetLevelGroundItemsByLevelId :many
-SELECT id, level_id, item_id, x, y, respawn_seconds, despawn_seconds FROM levels_ground_items
-WHERE level_id = $1
+const getChatChannelMembershipsByActorId = `-- name: GetChatChannelMembershipsByActorId :many
+SELECT channel_name FROM chat_channel_members
+WHERE actor_id = $1
+ORDER BY channel_name
 `
 
-func (q *Queries) GetLevelGroundItemsByLevelId(ctx context.Context, levelID int32) ([]LevelsGroundItem, error) {
-	rows, err := q.db.Query(ctx, getLevelGroundItemsByLevelId, levelID)
+func (q *Queries) GetChatChannelMembershipsByActorId(ctx context.Context, actorID int32) ([]string, error) {
+	rows, err := q.db.Query(ctx, getChatChannelMembershipsByActorId, actorID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []LevelsGroundItem
+	var items []string
 	for rows.Next() {
-		var i LevelsGroundItem
-		if err := rows.Scan(
-			&i.ID,
-			&i.LevelID,
-			&i.ItemID,
-			&i.X,
+		var channel_name string
+		if err := rows.Scan(&channel_name); err != nil {
+			return nil, err
+		}
+		items = append(items, channel_name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getChatChannelMutes = `-- name: GetChatChannelMutes :many
+SELECT channel_name, actor_id FROM chat_channel_mutes
+`
+
+type GetChatChannelMutesRow struct {
+	ChannelName string
+	ActorID     int32
+}
+
+func (q *Queries) GetChatChannelMutes(ctx context.Context) ([]GetChatChannelMutesRow, error) {
+	rows, err := q.db.Query(ctx, getChatChannelMutes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetChatChannelMutesRow
+	for rows.Next() {
+		var i GetChatChannelMutesRow
+		if err := rows.Scan(&i.ChannelName, &i.ActorID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getChatChannels = `-- name: GetChatChannels :many
+SELECT name, kind, owner_actor_id, created_at FROM chat_channels
+`
+
+func (q *Queries) GetChatChannels(ctx context.Context) ([]ChatChannel, error) {
+	rows, err := q.db.Query(ctx, getChatChannels)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatChannel
+	for rows.Next() {
+		var i ChatChannel
+		if err := rows.Scan(
+			&i.Name,
+			&i.Kind,
+			&i.OwnerActorID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFriends = `-- name: GetFriends :many
+SELECT a.id, a.name, f.accepted, f.friend_actor_id = $1 AS incoming
+FROM friends f
+JOIN actors a ON a.id = CASE WHEN f.actor_id = $1 THEN f.friend_actor_id ELSE f.actor_id END
+WHERE f.actor_id = $1 OR f.friend_actor_id = $1
+ORDER BY a.name
+`
+
+type GetFriendsRow struct {
+	ID       int32
+	Name     string
+	Accepted bool
+	Incoming bool
+}
+
+func (q *Queries) GetFriends(ctx context.Context, actorID int32) ([]GetFriendsRow, error) {
+	rows, err := q.db.Query(ctx, getFriends, actorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFriendsRow
+	for rows.Next() {
+		var i GetFriendsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Accepted,
+			&i.Incoming,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFriendship = `-- name: GetFriendship :one
+SELECT actor_id, friend_actor_id, accepted, created_at FROM friends
+WHERE (actor_id = $1 AND friend_actor_id = $2)
+OR (actor_id = $2 AND friend_actor_id = $1)
+LIMIT 1
+`
+
+type GetFriendshipParams struct {
+	ActorID       int32
+	FriendActorID int32
+}
+
+func (q *Queries) GetFriendship(ctx context.Context, arg GetFriendshipParams) (Friend, error) {
+	row := q.db.QueryRow(ctx, getFriendship, arg.ActorID, arg.FriendActorID)
+	var i Friend
+	err := row.Scan(
+		&i.ActorID,
+		&i.FriendActorID,
+		&i.Accepted,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getGuildBankItems = `-- name: GetGuildBankItems :many
+SELECT
+    i.id as item_id,
+    i.name,
+    i.description,
+    i.value,
+    i.sprite_region_x,
+    i.sprite_region_y,
+    i.tool_properties_id,
+    i.grants_vip,
+    i.tradeable,
+    b.quantity
+FROM items i
+JOIN guild_bank_items b ON i.id = b.item_id
+WHERE b.guild_id = $1
+ORDER BY i.name
+`
+
+type GetGuildBankItemsRow struct {
+	ItemID           int32
+	Name             string
+	Description      string
+	Value            int32
+	SpriteRegionX    int32
+	SpriteRegionY    int32
+	ToolPropertiesID pgtype.Int4
+	GrantsVip        bool
+	Tradeable        bool
+	Quantity         int32
+}
+
+func (q *Queries) GetGuildBankItems(ctx context.Context, guildID int32) ([]GetGuildBankItemsRow, error) {
+	rows, err := q.db.Query(ctx, getGuildBankItems, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGuildBankItemsRow
+	for rows.Next() {
+		var i GetGuildBankItemsRow
+		if err := rows.Scan(
+			&i.ItemID,
+			&i.Name,
+			&i.Description,
+			&i.Value,
+			&i.SpriteRegionX,
+			&i.SpriteRegionY,
+			&i.ToolPropertiesID,
+			&i.GrantsVip,
+			&i.Tradeable,
+			&i.Quantity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGuildBankLog = `-- name: GetGuildBankLog :many
+SELECT
+    COALESCE(a.name, '') AS actor_name,
+    i.id as item_id,
+    i.name,
+    i.description,
+    i.value,
+    i.sprite_region_x,
+    i.sprite_region_y,
+    i.tool_properties_id,
+    i.grants_vip,
+    i.tradeable,
+    l.quantity,
+    l.created_at
+FROM guild_bank_log l
+JOIN items i ON i.id = l.item_id
+LEFT JOIN actors a ON a.id = l.actor_id
+WHERE l.guild_id = $1
+ORDER BY l.created_at DESC, l.id DESC
+LIMIT $2
+`
+
+type GetGuildBankLogParams struct {
+	GuildID int32
+	Limit   int32
+}
+
+type GetGuildBankLogRow struct {
+	ActorName        string
+	ItemID           int32
+	Name             string
+	Description      string
+	Value            int32
+	SpriteRegionX    int32
+	SpriteRegionY    int32
+	ToolPropertiesID pgtype.Int4
+	GrantsVip        bool
+	Tradeable        bool
+	Quantity         int32
+	CreatedAt        pgtype.Timestamp
+}
+
+func (q *Queries) GetGuildBankLog(ctx context.Context, arg GetGuildBankLogParams) ([]GetGuildBankLogRow, error) {
+	rows, err := q.db.Query(ctx, getGuildBankLog, arg.GuildID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGuildBankLogRow
+	for rows.Next() {
+		var i GetGuildBankLogRow
+		if err := rows.Scan(
+			&i.ActorName,
+			&i.ItemID,
+			&i.Name,
+			&i.Description,
+			&i.Value,
+			&i.SpriteRegionX,
+			&i.SpriteRegionY,
+			&i.ToolPropertiesID,
+			&i.GrantsVip,
+			&i.Tradeable,
+			&i.Quantity,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGuildByName = `-- name: GetGuildByName :one
+SELECT id, name, tag, created_by_actor_id, created_at FROM guilds
+WHERE LOWER(name) = LOWER($1)
+LIMIT 1
+`
+
+func (q *Queries) GetGuildByName(ctx context.Context, lower string) (Guild, error) {
+	row := q.db.QueryRow(ctx, getGuildByName, lower)
+	var i Guild
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Tag,
+		&i.CreatedByActorID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getGuildInvitesByActorId = `-- name: GetGuildInvitesByActorId :many
+SELECT g.name
+FROM guild_invites i
+JOIN guilds g ON g.id = i.guild_id
+WHERE i.actor_id = $1
+ORDER BY i.created_at
+`
+
+func (q *Queries) GetGuildInvitesByActorId(ctx context.Context, actorID int32) ([]string, error) {
+	rows, err := q.db.Query(ctx, getGuildInvitesByActorId, actorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGuildMembers = `-- name: GetGuildMembers :many
+SELECT a.id, a.name, m.rank
+FROM guild_members m
+JOIN actors a ON a.id = m.actor_id
+WHERE m.guild_id = $1
+ORDER BY a.name
+`
+
+type GetGuildMembersRow struct {
+	ID   int32
+	Name string
+	Rank string
+}
+
+func (q *Queries) GetGuildMembers(ctx context.Context, guildID int32) ([]GetGuildMembersRow, error) {
+	rows, err := q.db.Query(ctx, getGuildMembers, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGuildMembersRow
+	for rows.Next() {
+		var i GetGuildMembersRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.Rank); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGuildMembership = `-- name: GetGuildMembership :one
+SELECT g.id, g.name, g.tag, m.rank
+FROM guild_members m
+JOIN guilds g ON g.id = m.guild_id
+WHERE m.actor_id = $1
+LIMIT 1
+`
+
+type GetGuildMembershipRow struct {
+	ID   int32
+	Name string
+	Tag  string
+	Rank string
+}
+
+func (q *Queries) GetGuildMembership(ctx context.Context, actorID int32) (GetGuildMembershipRow, error) {
+	row := q.db.QueryRow(ctx, getGuildMembership, actorID)
+	var i GetGuildMembershipRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Tag,
+		&i.Rank,
+	)
+	return i, err
+}
+
+const getIgnores = `-- name: GetIgnores :many
+SELECT a.id, a.name
+FROM ignores i
+JOIN actors a ON a.id = i.ignored_actor_id
+WHERE i.actor_id = $1
+ORDER BY a.name
+`
+
+type GetIgnoresRow struct {
+	ID   int32
+	Name string
+}
+
+func (q *Queries) GetIgnores(ctx context.Context, actorID int32) ([]GetIgnoresRow, error) {
+	rows, err := q.db.Query(ctx, getIgnores, actorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetIgnoresRow
+	for rows.Next() {
+		var i GetIgnoresRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getItem = `-- name: GetItem :one
+SELECT id, name, description, value, sprite_region_x, sprite_region_y, tool_properties_id, grants_vip, tradeable FROM items
+WHERE name = $1 AND description = $2 AND value = $3 AND sprite_region_x = $4 AND sprite_region_y = $5 and grants_vip = $6 and tradeable = $7
+LIMIT 1
+`
+
+type GetItemParams struct {
+	Name          string
+	Description   string
+	Value         int32
+	SpriteRegionX int32
+	SpriteRegionY int32
+	GrantsVip     bool
+	Tradeable     bool
+}
+
+func (q *Queries) GetItem(ctx context.Context, arg GetItemParams) (Item, error) {
+	row := q.db.QueryRow(ctx, getItem,
+		arg.Name,
+		arg.Description,
+		arg.Value,
+		arg.SpriteRegionX,
+		arg.SpriteRegionY,
+		arg.GrantsVip,
+		arg.Tradeable,
+	)
+	var i Item
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Value,
+		&i.SpriteRegionX,
+		&i.SpriteRegionY,
+		&i.ToolPropertiesID,
+		&i.GrantsVip,
+		&i.Tradeable,
+	)
+	return i, err
+}
+
+const getItemById = `-- name: GetItemById :one
+SELECT id, name, description, value, sprite_region_x, sprite_region_y, tool_properties_id, grants_vip, tradeable FROM items
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetItemById(ctx context.Context, id int32) (Item, error) {
+	row := q.db.QueryRow(ctx, getItemById, id)
+	var i Item
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Value,
+		&i.SpriteRegionX,
+		&i.SpriteRegionY,
+		&i.ToolPropertiesID,
+		&i.GrantsVip,
+		&i.Tradeable,
+	)
+	return i, err
+}
+
+const getItemByName = `-- name: GetItemByName :one
+SELECT id, name, description, value, sprite_region_x, sprite_region_y, tool_properties_id, grants_vip, tradeable FROM items
+WHERE LOWER(name) = LOWER($1)
+ORDER BY id
+LIMIT 1
+`
+
+func (q *Queries) GetItemByName(ctx context.Context, name string) (Item, error) {
+	row := q.db.QueryRow(ctx, getItemByName, name)
+	var i Item
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Value,
+		&i.SpriteRegionX,
+		&i.SpriteRegionY,
+		&i.ToolPropertiesID,
+		&i.GrantsVip,
+		&i.Tradeable,
+	)
+	return i, err
+}
+
+const getLevelByGdResPath = `-- name: GetLevelByGdResPath :one
+SELECT id, gd_res_path, added_by_user_id, added, last_updated_by_user_id, last_updated FROM levels
+WHERE gd_res_path = $1 LIMIT 1
+`
+
+func (q *Queries) GetLevelByGdResPath(ctx context.Context, gdResPath string) (Level, error) {
+	row := q.db.QueryRow(ctx, getLevelByGdResPath, gdResPath)
+	var i Level
+	err := row.Scan(
+		&i.ID,
+		&i.GdResPath,
+		&i.AddedByUserID,
+		&i.Added,
+		&i.LastUpdatedByUserID,
+		&i.LastUpdated,
+	)
+	return i, err
+}
+
+const getLevelById = `-- name: GetLevelById :one
+SELECT id, gd_res_path, added_by_user_id, added, last_updated_by_user_id, last_updated FROM levels
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetLevelById(ctx context.Context, id int32) (Level, error) {
+	row := q.db.QueryRow(ctx, getLevelById, id)
+	var i Level
+	err := row.Scan(
+		&i.ID,
+		&i.GdResPath,
+		&i.AddedByUserID,
+		&i.Added,
+		&i.LastUpdatedByUserID,
+		&i.LastUpdated,
+	)
+	return i, err
+}
+
+const getLevelCollisionPointsByLevelId = `-- name: GetLevelCollisionPointsByLevelId :many
+SELECT id, level_id, x, y FROM levels_collision_points
+WHERE level_id = $1
+`
+
+func (q *Queries) GetLevelCollisionPointsByLevelId(ctx context.Context, levelID int32) ([]LevelsCollisionPoint, error) {
+	rows, err := q.db.Query(ctx, getLevelCollisionPointsByLevelId, levelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LevelsCollisionPoint
+	for rows.Next() {
+		var i LevelsCollisionPoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.LevelID,
+			&i.X,
+			&i.Y,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLevelDoorsByLevelId = `-- name: GetLevelDoorsByLevelId :many
+SELECT id, level_id, destination_level_id, destination_x, destination_y, x, y, key_id FROM levels_doors
+WHERE level_id = $1
+`
+
+func (q *Queries) GetLevelDoorsByLevelId(ctx context.Context, levelID int32) ([]LevelsDoor, error) {
+	rows, err := q.db.Query(ctx, getLevelDoorsByLevelId, levelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LevelsDoor
+	for rows.Next() {
+		var i LevelsDoor
+		if err := rows.Scan(
+			&i.ID,
+			&i.LevelID,
+			&i.DestinationLevelID,
+			&i.DestinationX,
+			&i.DestinationY,
+			&i.X,
+			&i.Y,
+			&i.KeyID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLevelGroundItemsByLevelId = `-- name: GetLevelGroundItemsByLevelId :many
+SELECT id, level_id, item_id, x, y, respawn_seconds, despawn_seconds FROM levels_ground_items
+WHERE level_id = $1
+`
+
+func (q *Queries) GetLevelGroundItemsByLevelId(ctx context.Context, levelID int32) ([]LevelsGroundItem, error) {
+	rows, err := q.db.Query(ctx, getLevelGroundItemsByLevelId, levelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LevelsGroundItem
+	for rows.Next() {
+		var i LevelsGroundItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.LevelID,
+			&i.ItemID,
+			&i.X,
 			&i.Y,
 			&i.RespawnSeconds,
 			&i.DespawnSeconds,
@@ -1227,22 +2359,288 @@ const getLevels = `-- name: GetLevels :many
 SELECT id, gd_res_path, added_by_user_id, added, last_updated_by_user_id, last_updated FROM levels
 `
 
-func (q *Queries) GetLevels(ctx context.Context) ([]Level, error) {
-	rows, err := q.db.Query(ctx, getLevels)
+func (q *Queries) GetLevels(ctx context.Context) ([]Level, error) {
+	rows, err := q.db.Query(ctx, getLevels)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Level
+	for rows.Next() {
+		var i Level
+		if err := rows.Scan(
+			&i.ID,
+			&i.GdResPath,
+			&i.AddedByUserID,
+			&i.Added,
+			&i.LastUpdatedByUserID,
+			&i.LastUpdated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getQuest = `-- name: GetQuest :one
+SELECT id, name, start_dialogue, required_item_id, completed_dialogue, reward_item_id FROM quests
+WHERE name = $1 AND start_dialogue = $2 AND required_item_id = $3 AND completed_dialogue = $4 AND reward_item_id = $5
+LIMIT 1
+`
+
+type GetQuestParams struct {
+	Name              string
+	StartDialogue     string
+	RequiredItemID    int32
+	CompletedDialogue string
+	RewardItemID      int32
+}
+
+func (q *Queries) GetQuest(ctx context.Context, arg GetQuestParams) (Quest, error) {
+	row := q.db.QueryRow(ctx, getQuest,
+		arg.Name,
+		arg.StartDialogue,
+		arg.RequiredItemID,
+		arg.CompletedDialogue,
+		arg.RewardItemID,
+	)
+	var i Quest
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.StartDialogue,
+		&i.RequiredItemID,
+		&i.CompletedDialogue,
+		&i.RewardItemID,
+	)
+	return i, err
+}
+
+const getQuestById = `-- name: GetQuestById :one
+SELECT id, name, start_dialogue, required_item_id, completed_dialogue, reward_item_id FROM quests
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetQuestById(ctx context.Context, id int32) (Quest, error) {
+	row := q.db.QueryRow(ctx, getQuestById, id)
+	var i Quest
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.StartDialogue,
+		&i.RequiredItemID,
+		&i.CompletedDialogue,
+		&i.RewardItemID,
+	)
+	return i, err
+}
+
+const getRecentAuthLockouts = `-- name: GetRecentAuthLockouts :many
+SELECT id, kind, subject, locked_until, created_at FROM auth_lockouts
+ORDER BY created_at DESC
+LIMIT $1
+`
+
+func (q *Queries) GetRecentAuthLockouts(ctx context.Context, limit int32) ([]AuthLockout, error) {
+	rows, err := q.db.Query(ctx, getRecentAuthLockouts, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthLockout
+	for rows.Next() {
+		var i AuthLockout
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Subject,
+			&i.LockedUntil,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReport = `-- name: GetReport :one
+SELECT r.id, r.reporter_actor_id, r.reported_actor_id, r.reported_name, r.reported_user_id, r.level_id, r.reason, r.status, r.claimed_by_user_id, r.resolution, r.created_at, r.resolved_at, COALESCE(u.username, '') AS reported_username
+FROM reports r
+LEFT JOIN users u ON u.id = r.reported_user_id
+WHERE r.id = $1
+`
+
+type GetReportRow struct {
+	ID               int32
+	ReporterActorID  pgtype.Int4
+	ReportedActorID  pgtype.Int4
+	ReportedName     string
+	ReportedUserID   pgtype.Int4
+	LevelID          int32
+	Reason           string
+	Status           string
+	ClaimedByUserID  pgtype.Int4
+	Resolution       string
+	CreatedAt        pgtype.Timestamp
+	ResolvedAt       pgtype.Timestamp
+	ReportedUsername string
+}
+
+func (q *Queries) GetReport(ctx context.Context, id int32) (GetReportRow, error) {
+	row := q.db.QueryRow(ctx, getReport, id)
+	var i GetReportRow
+	err := row.Scan(
+		&i.ID,
+		&i.ReporterActorID,
+		&i.ReportedActorID,
+		&i.ReportedName,
+		&i.ReportedUserID,
+		&i.LevelID,
+		&i.Reason,
+		&i.Status,
+		&i.ClaimedByUserID,
+		&i.Resolution,
+		&i.CreatedAt,
+		&i.ResolvedAt,
+		&i.ReportedUsername,
+	)
+	return i, err
+}
+
+const getReportActions = `-- name: GetReportActions :many
+SELECT report_id, action, details
+FROM admin_audit_log
+WHERE report_id = ANY($1::int[])
+ORDER BY created_at, id
+`
+
+type GetReportActionsRow struct {
+	ReportID pgtype.Int4
+	Action   string
+	Details  string
+}
+
+func (q *Queries) GetReportActions(ctx context.Context, reportIds []int32) ([]GetReportActionsRow, error) {
+	rows, err := q.db.Query(ctx, getReportActions, reportIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReportActionsRow
+	for rows.Next() {
+		var i GetReportActionsRow
+		if err := rows.Scan(&i.ReportID, &i.Action, &i.Details); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReportChatLines = `-- name: GetReportChatLines :many
+SELECT id, report_id, sender_name, kind, recipient, msg, sent_at FROM report_chat_lines
+WHERE report_id = ANY($1::int[])
+ORDER BY report_id, sent_at, id
+`
+
+func (q *Queries) GetReportChatLines(ctx context.Context, reportIds []int32) ([]ReportChatLine, error) {
+	rows, err := q.db.Query(ctx, getReportChatLines, reportIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReportChatLine
+	for rows.Next() {
+		var i ReportChatLine
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReportID,
+			&i.SenderName,
+			&i.Kind,
+			&i.Recipient,
+			&i.Msg,
+			&i.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReports = `-- name: GetReports :many
+SELECT
+    r.id,
+    COALESCE(reporter.name, '') AS reporter_name,
+    r.reported_name,
+    COALESCE(u.username, '') AS reported_username,
+    r.reason,
+    r.level_id,
+    r.status,
+    COALESCE(claimer.username, '') AS claimed_by,
+    r.resolution,
+    r.created_at
+FROM reports r
+LEFT JOIN users u ON u.id = r.reported_user_id
+LEFT JOIN actors reporter ON reporter.id = r.reporter_actor_id
+LEFT JOIN users claimer ON claimer.id = r.claimed_by_user_id
+WHERE $1::boolean OR r.status <> 'resolved'
+ORDER BY r.created_at DESC
+LIMIT $2
+`
+
+type GetReportsParams struct {
+	IncludeResolved bool
+	MaxReports      int32
+}
+
+type GetReportsRow struct {
+	ID               int32
+	ReporterName     string
+	ReportedName     string
+	ReportedUsername string
+	Reason           string
+	LevelID          int32
+	Status           string
+	ClaimedBy        string
+	Resolution       string
+	CreatedAt        pgtype.Timestamp
+}
+
+func (q *Queries) GetReports(ctx context.Context, arg GetReportsParams) ([]GetReportsRow, error) {
+	rows, err := q.db.Query(ctx, getReports, arg.IncludeResolved, arg.MaxReports)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Level
+	var items []GetReportsRow
 	for rows.Next() {
-		var i Level
+		var i GetReportsRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.GdResPath,
-			&i.AddedByUserID,
-			&i.Added,
-			&i.LastUpdatedByUserID,
-			&i.LastUpdated,
+			&i.ReporterName,
+			&i.ReportedName,
+			&i.ReportedUsername,
+			&i.Reason,
+			&i.LevelID,
+			&i.Status,
+			&i.ClaimedBy,
+			&i.Resolution,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1254,57 +2652,57 @@ func (q *Queries) GetLevels(ctx context.Context) ([]Level, error) {
 	return items, nil
 }
 
-const getQuest = `-- name: GetQuest :one
-SELECT id, name, start_dialogue, required_item_id, completed_dialogue, reward_item_id FROM quests
-WHERE name = $1 AND start_dialogue = $2 AND required_item_id = $3 AND completed_dialogue = $4 AND reward_item_id = $5
-LIMIT 1
+const getRolesByActorId = `-- name: GetRolesByActorId :many
+SELECT ur.role FROM user_roles ur
+JOIN actors ac ON ur.user_id = ac.user_id
+WHERE ac.id = $1
+ORDER BY ur.role
 `
 
-type GetQuestParams struct {
-	Name              string
-	StartDialogue     string
-	RequiredItemID    int32
-	CompletedDialogue string
-	RewardItemID      int32
-}
-
-func (q *Queries) GetQuest(ctx context.Context, arg GetQuestParams) (Quest, error) {
-	row := q.db.QueryRow(ctx, getQuest,
-		arg.Name,
-		arg.StartDialogue,
-		arg.RequiredItemID,
-		arg.CompletedDialogue,
-		arg.RewardItemID,
-	)
-	var i Quest
-	err := row.Scan(
-		&i.ID,
-		&i.Name,
-		&i.StartDialogue,
-		&i.RequiredItemID,
-		&i.CompletedDialogue,
-		&i.RewardItemID,
-	)
-	return i, err
+func (q *Queries) GetRolesByActorId(ctx context.Context, id int32) ([]string, error) {
+	rows, err := q.db.Query(ctx, getRolesByActorId, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, err
+		}
+		items = append(items, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getQuestById = `-- name: GetQuestById :one
-SELECT id, name, start_dialogue, required_item_id, completed_dialogue, reward_item_id FROM quests
-WHERE id = $1 LIMIT 1
+const getRolesByUserId = `-- name: GetRolesByUserId :many
+SELECT role FROM user_roles
+WHERE user_id = $1
+ORDER BY role
 `
 
-func (q *Queries) GetQuestById(ctx context.Context, id int32) (Quest, error) {
-	row := q.db.QueryRow(ctx, getQuestById, id)
-	var i Quest
-	err := row.Scan(
-		&i.ID,
-		&i.Name,
-		&i.StartDialogue,
-		&i.RequiredItemID,
-		&i.CompletedDialogue,
-		&i.RewardItemID,
-	)
-	return i, err
+func (q *Queries) GetRolesByUserId(ctx context.Context, userID int32) ([]string, error) {
+	rows, err := q.db.Query(ctx, getRolesByUserId, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, err
+		}
+		items = append(items, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const getToolProperties = `-- name: GetToolProperties :one
@@ -1357,8 +2755,44 @@ func (q *Queries) GetToolPropertiesById(ctx context.Context, id int32) (ToolProp
 	return i, err
 }
 
+const getUserByActorId = `-- name: GetUserByActorId :one
+SELECT u.id, u.username, u.password_hash, u.must_change_password FROM users u
+JOIN actors a ON a.user_id = u.id
+WHERE a.id = $1
+`
+
+func (q *Queries) GetUserByActorId(ctx context.Context, id int32) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByActorId, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.MustChangePassword,
+	)
+	return i, err
+}
+
+const getUserById = `-- name: GetUserById :one
+SELECT id, username, password_hash, must_change_password FROM users
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetUserById(ctx context.Context, id int32) (User, error) {
+	row := q.db.QueryRow(ctx, getUserById, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.MustChangePassword,
+	)
+	return i, err
+}
+
 const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT id, username, password_hash FROM users
+SELECT id, username, password_hash, must_change_password FROM users
 WHERE username = $1
 ORDER BY id DESC
 LIMIT 1
@@ -1367,7 +2801,12 @@ LIMIT 1
 func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User, error) {
 	row := q.db.QueryRow(ctx, getUserByUsername, username)
 	var i User
-	err := row.Scan(&i.ID, &i.Username, &i.PasswordHash)
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.MustChangePassword,
+	)
 	return i, err
 }
 
@@ -1383,18 +2822,59 @@ func (q *Queries) GetUserIdByActorId(ctx context.Context, id int32) (int32, erro
 	return user_id, err
 }
 
-const isActorAdmin = `-- name: IsActorAdmin :one
-SELECT 1 FROM users u
-JOIN admins ad ON u.id = ad.user_id
-JOIN actors ac ON u.id = ac.user_id
-WHERE ac.id = $1 LIMIT 1
+const grantRole = `-- name: GrantRole :execrows
+INSERT INTO user_roles (
+    user_id, role, granted_by_user_id
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (user_id, role) DO NOTHING
+`
+
+type GrantRoleParams struct {
+	UserID          int32
+	Role            string
+	GrantedByUserID pgtype.Int4
+}
+
+func (q *Queries) GrantRole(ctx context.Context, arg GrantRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, grantRole, arg.UserID, arg.Role, arg.GrantedByUserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const liftBans = `-- name: LiftBans :execrows
+UPDATE bans
+SET lifted_at = (NOW() AT TIME ZONE 'UTC')
+WHERE user_id = $1
+AND lifted_at IS NULL
+AND (expires_at IS NULL OR expires_at > (NOW() AT TIME ZONE 'UTC'))
+`
+
+func (q *Queries) LiftBans(ctx context.Context, userID int32) (int64, error) {
+	result, err := q.db.Exec(ctx, liftBans, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const liftMutes = `-- name: LiftMutes :execrows
+UPDATE mutes
+SET lifted_at = (NOW() AT TIME ZONE 'UTC')
+WHERE user_id = $1
+AND lifted_at IS NULL
+AND (expires_at IS NULL OR expires_at > (NOW() AT TIME ZONE 'UTC'))
 `
 
-func (q *Queries) IsActorAdmin(ctx context.Context, id int32) (int32, error) {
-	row := q.db.QueryRow(ctx, isActorAdmin, id)
-	var column_1 int32
-	err := row.Scan(&column_1)
-	return column_1, err
+func (q *Queries) LiftMutes(ctx context.Context, userID int32) (int64, error) {
+	result, err := q.db.Exec(ctx, liftMutes, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const removeActorInventoryItem = `-- name: RemoveActorInventoryItem :exec
@@ -1413,6 +2893,277 @@ func (q *Queries) RemoveActorInventoryItem(ctx context.Context, arg RemoveActorI
 	return err
 }
 
+const removeChatChannelBan = `-- name: RemoveChatChannelBan :execrows
+DELETE FROM chat_channel_bans
+WHERE channel_name = $1 AND actor_id = $2
+`
+
+type RemoveChatChannelBanParams struct {
+	ChannelName string
+	ActorID     int32
+}
+
+func (q *Queries) RemoveChatChannelBan(ctx context.Context, arg RemoveChatChannelBanParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeChatChannelBan, arg.ChannelName, arg.ActorID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const removeChatChannelMember = `-- name: RemoveChatChannelMember :exec
+DELETE FROM chat_channel_members
+WHERE channel_name = $1 AND actor_id = $2
+`
+
+type RemoveChatChannelMemberParams struct {
+	ChannelName string
+	ActorID     int32
+}
+
+func (q *Queries) RemoveChatChannelMember(ctx context.Context, arg RemoveChatChannelMemberParams) error {
+	_, err := q.db.Exec(ctx, removeChatChannelMember, arg.ChannelName, arg.ActorID)
+	return err
+}
+
+const removeChatChannelMute = `-- name: RemoveChatChannelMute :exec
+DELETE FROM chat_channel_mutes
+WHERE channel_name = $1 AND actor_id = $2
+`
+
+type RemoveChatChannelMuteParams struct {
+	ChannelName string
+	ActorID     int32
+}
+
+func (q *Queries) RemoveChatChannelMute(ctx context.Context, arg RemoveChatChannelMuteParams) error {
+	_, err := q.db.Exec(ctx, removeChatChannelMute, arg.ChannelName, arg.ActorID)
+	return err
+}
+
+const removeGuildMember = `-- name: RemoveGuildMember :execrows
+DELETE FROM guild_members
+WHERE actor_id = $1 AND guild_id = $2
+`
+
+type RemoveGuildMemberParams struct {
+	ActorID int32
+	GuildID int32
+}
+
+func (q *Queries) RemoveGuildMember(ctx context.Context, arg RemoveGuildMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeGuildMember, arg.ActorID, arg.GuildID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const removeIgnore = `-- name: RemoveIgnore :execrows
+DELETE FROM ignores
+WHERE actor_id = $1 AND ignored_actor_id = $2
+`
+
+type RemoveIgnoreParams struct {
+	ActorID        int32
+	IgnoredActorID int32
+}
+
+func (q *Queries) RemoveIgnore(ctx context.Context, arg RemoveIgnoreParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeIgnore, arg.ActorID, arg.IgnoredActorID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const renameActor = `-- name: RenameActor :exec
+UPDATE actors
+SET name = $3
+WHERE id = $1
+AND user_id = $2
+`
+
+type RenameActorParams struct {
+	ID     int32
+	UserID int32
+	Name   string
+}
+
+func (q *Queries) RenameActor(ctx context.Context, arg RenameActorParams) error {
+	_, err := q.db.Exec(ctx, renameActor, arg.ID, arg.UserID, arg.Name)
+	return err
+}
+
+const resolveReport = `-- name: ResolveReport :execrows
+UPDATE reports
+SET status = 'resolved', claimed_by_user_id = $1, resolution = $2, resolved_at = (NOW() AT TIME ZONE 'UTC')
+WHERE id = $3
+AND status <> 'resolved'
+AND (claimed_by_user_id IS NULL OR claimed_by_user_id = $1)
+`
+
+type ResolveReportParams struct {
+	UserID     pgtype.Int4
+	Resolution string
+	ID         int32
+}
+
+func (q *Queries) ResolveReport(ctx context.Context, arg ResolveReportParams) (int64, error) {
+	result, err := q.db.Exec(ctx, resolveReport, arg.UserID, arg.Resolution, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokeRole = `-- name: RevokeRole :execrows
+DELETE FROM user_roles
+WHERE user_id = $1 AND role = $2
+`
+
+type RevokeRoleParams struct {
+	UserID int32
+	Role   string
+}
+
+func (q *Queries) RevokeRole(ctx context.Context, arg RevokeRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeRole, arg.UserID, arg.Role)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const searchChatLog = `-- name: SearchChatLog :many
+SELECT id, sender_actor_id, sender_name, level_id, kind, recipient, raw_msg, censored_msg, sent_at FROM chat_log
+WHERE (
+    $1::integer IS NULL
+    OR sender_actor_id = $1
+    OR (kind = 'whisper' AND LOWER(recipient) = LOWER($2))
+)
+AND sent_at >= $3
+AND sent_at < $4
+AND ($5::text = '' OR raw_msg ILIKE '%' || $5 || '%')
+ORDER BY sent_at DESC, id DESC
+LIMIT $6
+`
+
+type SearchChatLogParams struct {
+	ActorID    pgtype.Int4
+	ActorName  string
+	Since      pgtype.Timestamp
+	Until      pgtype.Timestamp
+	Keyword    string
+	MaxEntries int32
+}
+
+func (q *Queries) SearchChatLog(ctx context.Context, arg SearchChatLogParams) ([]ChatLog, error) {
+	rows, err := q.db.Query(ctx, searchChatLog,
+		arg.ActorID,
+		arg.ActorName,
+		arg.Since,
+		arg.Until,
+		arg.Keyword,
+		arg.MaxEntries,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatLog
+	for rows.Next() {
+		var i ChatLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.SenderActorID,
+			&i.SenderName,
+			&i.LevelID,
+			&i.Kind,
+			&i.Recipient,
+			&i.RawMsg,
+			&i.CensoredMsg,
+			&i.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setGuildMemberRank = `-- name: SetGuildMemberRank :execrows
+UPDATE guild_members
+SET rank = $3
+WHERE actor_id = $1 AND guild_id = $2
+`
+
+type SetGuildMemberRankParams struct {
+	ActorID int32
+	GuildID int32
+	Rank    string
+}
+
+func (q *Queries) SetGuildMemberRank(ctx context.Context, arg SetGuildMemberRankParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setGuildMemberRank, arg.ActorID, arg.GuildID, arg.Rank)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const takeGuildBankItem = `-- name: TakeGuildBankItem :execrows
+UPDATE guild_bank_items
+SET quantity = quantity - $1
+WHERE guild_id = $2 AND item_id = $3 AND $1 > 0 AND quantity >= $1
+`
+
+type TakeGuildBankItemParams struct {
+	Quantity int32
+	GuildID  int32
+	ItemID   int32
+}
+
+func (q *Queries) TakeGuildBankItem(ctx context.Context, arg TakeGuildBankItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, takeGuildBankItem, arg.Quantity, arg.GuildID, arg.ItemID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateActorAppearance = `-- name: UpdateActorAppearance :exec
+UPDATE actors
+SET sprite_region_x = $2, sprite_region_y = $3, body = $4, hair = $5, outfit = $6, palette = $7
+WHERE id = $1
+`
+
+type UpdateActorAppearanceParams struct {
+	ID            int32
+	SpriteRegionX int32
+	SpriteRegionY int32
+	Body          int32
+	Hair          int32
+	Outfit        int32
+	Palette       int32
+}
+
+func (q *Queries) UpdateActorAppearance(ctx context.Context, arg UpdateActorAppearanceParams) error {
+	_, err := q.db.Exec(ctx, updateActorAppearance,
+		arg.ID,
+		arg.SpriteRegionX,
+		arg.SpriteRegionY,
+		arg.Body,
+		arg.Hair,
+		arg.Outfit,
+		arg.Palette,
+	)
+	return err
+}
+
 const updateActorLevel = `-- name: UpdateActorLevel :exec
 UPDATE actors
 SET level_id = $2
@@ -1469,6 +3220,23 @@ func (q *Queries) UpdateLevelLastUpdated(ctx context.Context, arg UpdateLevelLas
 	return err
 }
 
+const updateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = $2, must_change_password = $3
+WHERE id = $1
+`
+
+type UpdateUserPasswordParams struct {
+	ID                 int32
+	PasswordHash       string
+	MustChangePassword bool
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	_, err := q.db.Exec(ctx, updateUserPassword, arg.ID, arg.PasswordHash, arg.MustChangePassword)
+	return err
+}
+
 const upsertActorInventoryItem = `-- name: UpsertActorInventoryItem :exec
 INSERT INTO actors_inventory (
     actor_id, item_id, quantity
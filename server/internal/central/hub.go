@@ -2,13 +2,17 @@ package central
 
 import (
 	"context"
+	"crypto/rand"
 	_ "embed"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
-	"os"
 	"path"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -16,14 +20,17 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/db"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/levels"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/chatfilter"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/items"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/npcs"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/objs"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/props"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/quests"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/roles"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/ds"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/password"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/ratelimit"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -33,14 +40,31 @@ var schemaGenSql string
 // A structure for a database transaction
 type DbTx struct {
 	Queries *db.Queries
+	pool    *pgxpool.Pool
 }
 
 func (h *Hub) NewDbTx() *DbTx {
 	return &DbTx{
 		Queries: db.New(h.dbPool),
+		pool:    h.dbPool,
 	}
 }
 
+// Runs the queries in fn all together or not at all. The transaction is committed if fn returns nil, and rolled back
+// otherwise.
+func (t *DbTx) InTransaction(ctx context.Context, fn func(queries *db.Queries) error) error {
+	tx, err := t.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background()) // Does nothing if we've committed
+
+	if err := fn(t.Queries.WithTx(tx)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
 // A structure for a state machine to process the client's messages
 type ClientStateHandler interface {
 	Name() string
@@ -72,8 +96,59 @@ type SharedGameObjects struct {
 // A collection of static data for the game
 type GameData struct {
 	MotdPath  string
-	Profanity []string
-	Slurs     []string
+	Profanity *chatfilter.WordList // Words not allowed in names
+	Slurs     *chatfilter.WordList // Words not allowed in chat, unless the chat filter config says otherwise
+
+	// What every chat message goes through before it's sent
+	ChatFilter *chatfilter.Pipeline
+}
+
+// How often to check whether the word lists or chat filter config have changed
+const gameDataReloadInterval = 10 * time.Second
+
+// Loads the word lists and chat filter config again if any of their files have changed
+func (d *GameData) reloadIfChanged() {
+	d.Profanity.ReloadIfChanged()
+	d.Slurs.ReloadIfChanged()
+	d.ChatFilter.ReloadIfChanged()
+}
+
+// Brute-force protection for logging in and registering, shared by every connection
+type AuthLimits struct {
+	// Failed logins from each IP address, whichever account they're for
+	LoginsByIp *ratelimit.Lockout
+
+	// Failed logins to each account, wherever they come from
+	LoginsByUsername *ratelimit.Lockout
+
+	// Accounts registered from each IP address, successful or not
+	RegistrationsByIp *ratelimit.Lockout
+}
+
+// How much the raw SQL console in the admin tools is allowed to do
+type SqlConsoleMode string
+
+const (
+	SqlConsoleOff       SqlConsoleMode = "off"
+	SqlConsoleReadOnly  SqlConsoleMode = "read_only"
+	SqlConsoleReadWrite SqlConsoleMode = "read_write"
+)
+
+type SqlConsoleConfig struct {
+	Mode SqlConsoleMode
+
+	// How long a query may run before it's cancelled
+	Timeout time.Duration
+
+	// The most rows sent back, so a careless query can't flood the admin's connection
+	MaxRows int
+}
+
+// What a query from the SQL console returned, with every value formatted as a string
+type SqlResult struct {
+	Columns   []string
+	Rows      [][]string
+	Truncated bool // Whether there were more than the console's maximum number of rows
 }
 
 type LevelPointMaps struct {
@@ -85,8 +160,8 @@ type LevelPointMaps struct {
 type ClientInterfacer interface {
 	Id() uint32
 
-	// A channel for packets to be processed
-	PacketsForProcessingChan() chan *packets.Packet
+	// A channel for packets of the given priority to be processed
+	PacketsForProcessingChan(priority packets.Priority) chan *packets.Packet
 
 	// Processes a message from a particular sender
 	ProcessMessage(senderId uint32, message packets.Msg)
@@ -94,18 +169,78 @@ type ClientInterfacer interface {
 	// Sets the client's ID and anything else that needs to be initialized
 	Initialize(id uint32)
 
-	// Puts data from this client in the write pump
+	// The IP address the client is connecting from, or an empty string if it isn't a real connection
+	RemoteIp() string
+
+	// Puts data from this client in the write pump. Everything put in the write pump is delivered in the order it was
+	// put there, and nothing is dropped, so there is never any need to wait before sending the next packet.
 	SocketSend(message packets.Msg)
 
 	// Puts data from another client in the write pump
 	SocketSendAs(message packets.Msg, senderId uint32)
 
+	// Puts several packets in the write pump at once, so they are delivered back-to-back. If batching is enabled, they
+	// go out in a single frame.
+	SocketSendBatch(batch ...*packets.Packet)
+
+	// Whether the write pump may bundle everything it has queued up into a single PacketBatch frame. Off by default,
+	// since older clients don't understand batches.
+	SetBatching(enabled bool)
+
+	// Whether frames should be compressed, if the websocket negotiated compression. Off by default.
+	SetCompression(enabled bool)
+
+	// Records the protocol version and capabilities agreed on in the client's hello
+	SetProtocol(version uint32, capabilities []string)
+
+	// The protocol version the client said hello with, or packets.LegacyProtocolVersion if it never did
+	ProtocolVersion() uint32
+
+	// Whether the given capability was agreed on in the client's hello
+	HasCapability(capability string) bool
+
+	// Issues a token the player can use to resume the game on a new connection if this one drops, replacing any
+	// previous one
+	StartSession() string
+
+	// Revokes the client's session token, e.g. when the player logs out on purpose
+	EndSession()
+
+	// Hands this client's connection over to the dropped client holding the session token, to carry on the game there.
+	// Returns false if there is no such session.
+	ResumeSession(token string) bool
+
 	// Forward message to another client for processing
 	PassToPeer(message packets.Msg, peerId uint32)
 
+	// Hand the client off to the given level's simulation, then run onArrival on that level's goroutine
+	EnterLevel(levelId int32, onArrival func())
+
+	// Hand the client back to the lobby's simulation, then run onArrival on the lobby's goroutine
+	ExitLevel(onArrival func())
+
 	// Forward message to all other clients for processing
 	Broadcast(message packets.Msg, to ...[]uint32)
 
+	// Queue a job to run on the goroutine that owns this client's state, i.e. the simulation of the level it's in.
+	// Anything that touches the client's state from elsewhere (timers, other levels, etc.) must go through here.
+	Post(job func())
+
+	// Queue a job to run on the goroutine that owns another client's state, e.g. to find out where their actor is.
+	// Returns false if there's no such client.
+	PostToPeer(peerId uint32, job func()) bool
+
+	// Queue a job to run on the given level's goroutine after a delay, whether or not this client is still around by
+	// then. For things which belong to the level rather than the client, e.g. respawning shrubs.
+	PostToLevel(levelId int32, delay time.Duration, job func(level *Level))
+
+	// Queue a job to run on every level's goroutine, then run done once they've all finished it. For finding out
+	// about actors in other levels, whose state only their own level may read.
+	PostToEveryLevel(job func(level *Level), done func())
+
+	// The simulation currently processing this client, or nil if it isn't in one
+	Level() *Level
+
 	// Pump data from the connected socket directly to the client
 	ReadPump()
 
@@ -114,7 +249,7 @@ type ClientInterfacer interface {
 
 	// A reference to the database transaction for this client
 	DbTx() *DbTx
-	RunSql(sql string) (pgx.Rows, error)
+	RunSql(sql string) (*SqlResult, error)
 
 	SetState(newState ClientStateHandler)
 
@@ -122,11 +257,26 @@ type ClientInterfacer interface {
 	SharedGameObjects() *SharedGameObjects
 	GameData() *GameData
 	LevelPointMaps() *LevelPointMaps
+	AuthLimits() *AuthLimits
+	AdminSessions() *ds.SharedCollection[int32]
+	Channels() *Channels
+	Parties() *Parties
+	ChatArchive() *ChatArchive
+	WorldClock() *WorldClock
 
 	// Close the client's connections and cleanup
 	Close(reason string)
 }
 
+// How the level simulations are run
+type SimulationConfig struct {
+	// How many times per second each level processes its clients' packets
+	TickRate int
+
+	// The most packets processed per client per tick, higher priority packets first
+	PacketsPerTick int
+}
+
 type LevelDataImporters struct {
 	CollisionPointsImporter *levels.DbDataImporter[struct{}, db.LevelsCollisionPoint]
 	ShrubsImporter          *levels.DbDataImporter[objs.Shrub, db.LevelsShrub]
@@ -145,6 +295,43 @@ type Hub struct {
 	// Clients in this channel will be unregistered with the hub
 	UnregisterChan chan ClientInterfacer
 
+	SimulationConfig SimulationConfig
+
+	// When the server started, which was tick 0 for every level
+	startedAt time.Time
+
+	// The simulation for clients that aren't in a level, e.g. while logging in or using the admin tools
+	lobby *Level
+
+	// Independently ticking simulations for each level, keyed by level ID
+	levels    map[int32]*Level
+	levelsMux sync.Mutex
+
+	// The simulation each client is currently being processed by, keyed by client ID
+	clientLevels *ds.SharedCollection[*Level]
+
+	// How long to keep a player in the game after their connection drops, in case they reconnect
+	SessionGracePeriod time.Duration
+
+	// Limits on the raw SQL console in the admin tools
+	SqlConsole SqlConsoleConfig
+
+	// Chat channels, which players can be in from any level
+	Channels *Channels
+
+	// Groups of players, who share XP and quest credit and have their own chat channel
+	Parties *Parties
+
+	// Everything players have said, for moderators to search
+	ChatArchive *ChatArchive
+
+	// The time of day in the game world
+	WorldClock *WorldClock
+
+	// Clients whose players can resume the game on a new connection, keyed by session token
+	sessions    map[string]ClientInterfacer
+	sessionsMux sync.Mutex
+
 	// Database connection pool
 	dbPool *pgxpool.Pool
 
@@ -157,6 +344,13 @@ type Hub struct {
 	// Shared game objects
 	SharedGameObjects *SharedGameObjects
 
+	// Brute-force protection for logging in and registering
+	AuthLimits *AuthLimits
+
+	// The user each client in the admin tools is logged in as, keyed by client ID, so changes to their roles can reach
+	// them straight away
+	AdminSessions *ds.SharedCollection[int32]
+
 	// Static game data
 	GameData *GameData
 
@@ -167,7 +361,7 @@ type Hub struct {
 	LevelDataImporters *LevelDataImporters
 }
 
-func NewHub(dataDirPath, pgConnString string) *Hub {
+func NewHub(dataDirPath, pgConnString string, simulationConfig SimulationConfig) *Hub {
 	dbPool, err := pgxpool.New(context.Background(), pgConnString)
 	if err != nil {
 		log.Fatalf("Error opening PostgreSQL database: %v", err)
@@ -176,12 +370,18 @@ func NewHub(dataDirPath, pgConnString string) *Hub {
 	}
 
 	hub := &Hub{
-		Clients:        ds.NewSharedCollection[ClientInterfacer](),
-		RegisterChan:   make(chan ClientInterfacer),
-		UnregisterChan: make(chan ClientInterfacer),
-		dbPool:         dbPool,
-		npcClients:     make(map[int]ClientInterfacer),
-		UtilFunctions:  &UtilFunctions{},
+		Clients:            ds.NewSharedCollection[ClientInterfacer](),
+		RegisterChan:       make(chan ClientInterfacer),
+		UnregisterChan:     make(chan ClientInterfacer),
+		SimulationConfig:   simulationConfig,
+		startedAt:          time.Now(),
+		levels:             make(map[int32]*Level),
+		clientLevels:       ds.NewSharedCollection[*Level](),
+		SessionGracePeriod: 60 * time.Second,
+		sessions:           make(map[string]ClientInterfacer),
+		dbPool:             dbPool,
+		npcClients:         make(map[int]ClientInterfacer),
+		UtilFunctions:      &UtilFunctions{},
 		SharedGameObjects: &SharedGameObjects{
 			Actors:      ds.NewSharedCollection[*objs.Actor](),
 			Shrubs:      ds.NewSharedCollection[*objs.Shrub](),
@@ -189,18 +389,42 @@ func NewHub(dataDirPath, pgConnString string) *Hub {
 			Doors:       ds.NewSharedCollection[*objs.Door](),
 			GroundItems: ds.NewSharedCollection[*objs.GroundItem](),
 		},
-		GameData: &GameData{
-			MotdPath:  path.Join(dataDirPath, "motd.txt"),
-			Profanity: wordsFromFile(path.Join(dataDirPath, "profanity.txt")),
-			Slurs:     wordsFromFile(path.Join(dataDirPath, "slurs.txt")),
-		},
 		LevelPointMaps: &LevelPointMaps{
 			Collisions: ds.NewLevelPointMap[*struct{}](),
 			Doors:      ds.NewLevelPointMap[*objs.Door](),
 		},
 		LevelDataImporters: &LevelDataImporters{},
+		AdminSessions:      ds.NewSharedCollection[int32](),
+		AuthLimits: &AuthLimits{
+			LoginsByIp:        ratelimit.NewLockout(20, 10*time.Minute, 1*time.Minute, 1*time.Hour),
+			LoginsByUsername:  ratelimit.NewLockout(5, 10*time.Minute, 30*time.Second, 1*time.Hour),
+			RegistrationsByIp: ratelimit.NewLockout(3, 1*time.Hour, 1*time.Hour, 24*time.Hour),
+		},
+		SqlConsole: SqlConsoleConfig{
+			Mode:    SqlConsoleReadOnly,
+			Timeout: 5 * time.Second,
+			MaxRows: 500,
+		},
+		Channels: NewChannels(),
+	}
+	hub.Parties = NewParties(hub.Channels)
+	hub.ChatArchive = NewChatArchive(hub.NewDbTx().Queries, 30*24*time.Hour)
+	hub.WorldClock = NewWorldClock(time.Hour)
+
+	profanity := chatfilter.NewWordList(path.Join(dataDirPath, "profanity.txt"))
+	slurs := chatfilter.NewWordList(path.Join(dataDirPath, "slurs.txt"))
+	hub.GameData = &GameData{
+		MotdPath:  path.Join(dataDirPath, "motd.txt"),
+		Profanity: profanity,
+		Slurs:     slurs,
+		ChatFilter: chatfilter.NewPipeline(path.Join(dataDirPath, "chat_filter.json"), map[string]*chatfilter.WordList{
+			"profanity": profanity,
+			"slurs":     slurs,
+		}),
 	}
 
+	hub.lobby = newLevel(hub, lobbyLevelId)
+
 	hub.UtilFunctions.ItemMsgToObj = hub.itemMsgToObj
 	hub.UtilFunctions.ToolPropsFromInt4Id = func(toolPropertiesID pgtype.Int4) *props.ToolProps {
 		return getToolPropsFromInt4Id(hub.NewDbTx().Queries, toolPropertiesID)
@@ -216,6 +440,7 @@ func (h *Hub) Run(adminPassword string) {
 	}
 
 	h.addAdmin(adminPassword)
+	h.addChannels()
 
 	queries := h.NewDbTx().Queries
 
@@ -321,7 +546,12 @@ func (h *Hub) Run(adminPassword string) {
 		h.LevelDataImporters.GroundItemsImporter.NameOfObject:     h.LevelDataImporters.GroundItemsImporter.ImportObjects,
 	}
 
+	go h.watchGameData()
+	go h.ChatArchive.Run()
+	go h.broadcastWorldTime()
+
 	for _, levelId := range levelIds {
+		h.getOrCreateLevel(levelId)
 		for objName, importFunc := range importFuncs {
 			if objName == "ground items" {
 				log.Printf("Importing %s for level %d...", objName, levelId)
@@ -341,33 +571,47 @@ func (h *Hub) Run(adminPassword string) {
 
 	defer h.dbPool.Close()
 
-	log.Println("Awaiting client registrations...")
+	h.RunSimulation()
+}
 
-	tickRate := 10 // Max 10 packets per second
-	ticker := time.NewTicker(time.Second / time.Duration(tickRate))
-	defer ticker.Stop()
+// Runs the lobby, and registers clients as they connect and unregisters them as they leave, forever. Each level starts
+// running when the first client enters it. None of this needs the database, so Run only calls it once everything has
+// been loaded from there.
+func (h *Hub) RunSimulation() {
+	go h.lobby.Run()
+
+	log.Println("Awaiting client registrations...")
 
 	for {
 		select {
 		case client := <-h.RegisterChan:
-			h.registerClient(client)
+			h.registerClient(client, h.lobby)
 
 		case client := <-h.UnregisterChan:
-			h.Clients.Remove(client.Id())
-
-		case <-ticker.C:
-			// Process one packet from each client's PacketsForProcessingChan per tick
-			h.Clients.ForEach(func(clientId uint32, client ClientInterfacer) {
-				select {
-				case packet := <-client.PacketsForProcessingChan():
-					client.ProcessMessage(packet.SenderId, packet.Msg)
-				default:
-				}
-			})
+			h.unregisterClient(client)
 		}
 	}
 }
 
+// Picks up changes to the word lists and chat filter config without a restart
+func (h *Hub) watchGameData() {
+	ticker := time.NewTicker(gameDataReloadInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		h.GameData.reloadIfChanged()
+	}
+}
+
+// Tells everyone the time every so often, so their clocks keep up with the server's. Clients in the game pass it on to
+// their players.
+func (h *Hub) broadcastWorldTime() {
+	ticker := time.NewTicker(worldTimeBroadcastInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		h.Broadcast(0, h.WorldClock.Packet())
+	}
+}
+
 func (h *Hub) SetNpcClients(npcClients map[int]ClientInterfacer) {
 	h.npcClients = npcClients
 }
@@ -377,7 +621,7 @@ func (h *Hub) Broadcast(senderId uint32, message packets.Msg, to ...[]uint32) {
 	if len(to) <= 0 {
 		h.Clients.ForEach(func(clientId uint32, client ClientInterfacer) {
 			if clientId != senderId {
-				client.ProcessMessage(senderId, message)
+				h.deliver(senderId, client, message)
 			}
 		})
 		return
@@ -389,9 +633,201 @@ func (h *Hub) Broadcast(senderId uint32, message packets.Msg, to ...[]uint32) {
 		}
 
 		if client, exists := h.Clients.Get(recipient); exists {
-			client.ProcessMessage(senderId, message)
+			h.deliver(senderId, client, message)
+		}
+	}
+}
+
+// Forwards a message from the sender to a single peer for processing
+func (h *Hub) PassToPeer(senderId uint32, message packets.Msg, peerId uint32) {
+	if peer, exists := h.Clients.Get(peerId); exists {
+		h.deliver(senderId, peer, message)
+	}
+}
+
+// Hands a client off to the simulation of the given level, creating it if it isn't running yet
+func (h *Hub) EnterLevel(client ClientInterfacer, levelId int32, onArrival func()) {
+	h.moveClient(client.Id(), client, h.getOrCreateLevel(levelId), onArrival)
+}
+
+// Hands a client back to the lobby
+func (h *Hub) ExitLevel(client ClientInterfacer, onArrival func()) {
+	h.moveClient(client.Id(), client, h.lobby, onArrival)
+}
+
+// Queues a job on whichever level is simulating the client. If the client is handed off to another level before the
+// job gets to run, the job follows them there. The job is dropped if the client unregisters first.
+func (h *Hub) PostToClient(client ClientInterfacer, job func()) {
+	level, exists := h.clientLevels.Get(client.Id())
+	if !exists {
+		return
+	}
+
+	level.Post(func() {
+		current, exists := h.clientLevels.Get(client.Id())
+		if !exists {
+			return
+		}
+		if current != level {
+			h.PostToClient(client, job)
+			return
+		}
+		job()
+	})
+}
+
+// Queues a job on whichever level is simulating the peer, e.g. to read their actor. Returns false if there's no such
+// client.
+func (h *Hub) PostToPeer(peerId uint32, job func()) bool {
+	peer, exists := h.Clients.Get(peerId)
+	if !exists {
+		return false
+	}
+	h.PostToClient(peer, job)
+	return true
+}
+
+// Queues a job on the given level's goroutine after a delay, creating the level if it isn't running yet
+func (h *Hub) PostToLevel(levelId int32, delay time.Duration, job func(level *Level)) {
+	level := h.getOrCreateLevel(levelId)
+	time.AfterFunc(delay, func() {
+		level.Post(func() {
+			job(level)
+		})
+	})
+}
+
+// Queues a job on the goroutine of every level that's running, then runs done once they've all finished it. done runs
+// on whichever level's goroutine got there last, so anything it touches belonging to a client must be posted back to
+// that client.
+func (h *Hub) PostToEveryLevel(job func(level *Level), done func()) {
+	h.levelsMux.Lock()
+	levels := make([]*Level, 0, len(h.levels))
+	for _, level := range h.levels {
+		levels = append(levels, level)
+	}
+	h.levelsMux.Unlock()
+
+	if len(levels) <= 0 {
+		done()
+		return
+	}
+
+	var remaining atomic.Int32
+	remaining.Store(int32(len(levels)))
+	for _, level := range levels {
+		level.Post(func() {
+			job(level)
+			if remaining.Add(-1) == 0 {
+				done()
+			}
+		})
+	}
+}
+
+// How many ticks have passed since the server started. Every level ticks at the same rate, so ticks can be compared
+// across levels, e.g. by a client that's just walked through a door.
+func (h *Hub) Tick() uint64 {
+	return uint64(time.Since(h.startedAt) * time.Duration(h.SimulationConfig.TickRate) / time.Second)
+}
+
+// Gets the simulation currently processing the client, or nil if it isn't in one
+func (h *Hub) LevelOf(client ClientInterfacer) *Level {
+	level, exists := h.clientLevels.Get(client.Id())
+	if !exists {
+		return nil
+	}
+	return level
+}
+
+// Issues a new session token for the client
+func (h *Hub) CreateSession(client ClientInterfacer) string {
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		log.Printf("Error generating session token: %v", err)
+		return ""
+	}
+	token := hex.EncodeToString(tokenBytes)
+
+	h.sessionsMux.Lock()
+	defer h.sessionsMux.Unlock()
+	h.sessions[token] = client
+	return token
+}
+
+// Revokes a session token, if it exists
+func (h *Hub) EndSession(token string) {
+	h.sessionsMux.Lock()
+	defer h.sessionsMux.Unlock()
+	delete(h.sessions, token)
+}
+
+// Gets the client a session token belongs to and revokes the token, so it can only be used once
+func (h *Hub) ClaimSession(token string) (ClientInterfacer, bool) {
+	h.sessionsMux.Lock()
+	defer h.sessionsMux.Unlock()
+
+	client, exists := h.sessions[token]
+	if exists {
+		delete(h.sessions, token)
+	}
+	return client, exists
+}
+
+// Has the recipient process a message from the sender. If they are being simulated by the same level, this happens
+// straight away since we are already on that level's goroutine. Otherwise, the message is handed off to the
+// recipient's level so it is processed on the right goroutine.
+func (h *Hub) deliver(senderId uint32, recipient ClientInterfacer, message packets.Msg) {
+	senderLevel, _ := h.clientLevels.Get(senderId)
+	recipientLevel, exists := h.clientLevels.Get(recipient.Id())
+	if !exists {
+		return
+	}
+
+	if senderLevel == recipientLevel {
+		recipient.ProcessMessage(senderId, message)
+		return
+	}
+
+	h.PostToClient(recipient, func() {
+		recipient.ProcessMessage(senderId, message)
+	})
+}
+
+// Removes the client from its current simulation and queues it to join the given level. The client is only added to
+// the level's simulation once the level's goroutine picks up the hand-off, so none of its packets will be processed
+// while it's in transit, and onArrival will run before anything else to do with the client in the new level.
+func (h *Hub) moveClient(clientId uint32, client ClientInterfacer, level *Level, onArrival func()) {
+	if current, exists := h.clientLevels.Get(clientId); exists {
+		current.Clients.Remove(clientId)
+	}
+	h.clientLevels.Add(level, clientId)
+
+	level.Post(func() {
+		// The client might have disconnected or moved on again before arriving
+		if current, exists := h.clientLevels.Get(clientId); !exists || current != level {
+			return
 		}
+
+		level.Clients.Add(client, clientId)
+		if onArrival != nil {
+			onArrival()
+		}
+	})
+}
+
+func (h *Hub) getOrCreateLevel(levelId int32) *Level {
+	h.levelsMux.Lock()
+	defer h.levelsMux.Unlock()
+
+	if level, exists := h.levels[levelId]; exists {
+		return level
 	}
+
+	level := newLevel(h, levelId)
+	h.levels[levelId] = level
+	go level.Run()
+	return level
 }
 
 // Creates a client for the new connection and begins the concurrent read and write pumps
@@ -410,6 +846,48 @@ func (h *Hub) Serve(getNewClient func(*Hub, http.ResponseWriter, *http.Request)
 	go client.ReadPump()
 }
 
+// Makes sure the system channels exist, then loads every channel and who's banned or muted in them
+func (h *Hub) addChannels() {
+	ctx := context.Background()
+	queries := h.NewDbTx().Queries
+
+	for _, name := range SystemChannels {
+		err := queries.CreateChatChannelIfNotExists(ctx, db.CreateChatChannelIfNotExistsParams{
+			Name: name,
+			Kind: string(ChannelSystem),
+		})
+		if err != nil {
+			log.Fatalf("Error creating system channel %s: %v", name, err)
+		}
+	}
+
+	channelModels, err := queries.GetChatChannels(ctx)
+	if err != nil {
+		log.Fatalf("Error getting chat channels: %v", err)
+	}
+	for _, model := range channelModels {
+		h.Channels.Ensure(model.Name, ChannelKind(model.Kind), model.OwnerActorID.Int32)
+	}
+
+	bans, err := queries.GetChatChannelBans(ctx)
+	if err != nil {
+		log.Fatalf("Error getting chat channel bans: %v", err)
+	}
+	for _, ban := range bans {
+		h.Channels.SetBanned(ban.ChannelName, ban.ActorID, true)
+	}
+
+	mutes, err := queries.GetChatChannelMutes(ctx)
+	if err != nil {
+		log.Fatalf("Error getting chat channel mutes: %v", err)
+	}
+	for _, mute := range mutes {
+		h.Channels.SetMuted(mute.ChannelName, mute.ActorID, true)
+	}
+
+	log.Printf("Loaded %d chat channels", len(channelModels))
+}
+
 // Adds an admin user to the database if one does not already exist
 func (h *Hub) addAdmin(defaultPassword string) {
 	ctx := context.Background()
@@ -442,11 +920,14 @@ func (h *Hub) addAdmin(defaultPassword string) {
 		}
 	}
 
-	_, err = h.NewDbTx().Queries.CreateAdminIfNotExists(ctx, user.ID)
-	if err == nil {
+	granted, err := h.NewDbTx().Queries.GrantRole(ctx, db.GrantRoleParams{
+		UserID: user.ID,
+		Role:   string(roles.Superadmin),
+	})
+	if err != nil {
+		log.Fatalf("Error granting admin the %s role: %v", roles.Superadmin, err)
+	} else if granted > 0 {
 		log.Printf("Admin created")
-	} else if err != pgx.ErrNoRows {
-		log.Fatalf("Error creating admin: %v", err)
 	} else {
 		log.Printf("Admin already exists")
 	}
@@ -469,11 +950,71 @@ func (h *Hub) addAdmin(defaultPassword string) {
 	}
 }
 
-func (h *Hub) RunSql(sql string) (pgx.Rows, error) {
-	result, err := h.dbPool.Query(context.Background(), sql)
+// Runs a query from the admin tools' SQL console, within the limits of the console's config. In read-only mode, the
+// query runs in a read-only transaction, so it can't change anything even if it tries.
+func (h *Hub) RunSql(sql string) (*SqlResult, error) {
+	config := h.SqlConsole
+	if config.Mode != SqlConsoleReadOnly && config.Mode != SqlConsoleReadWrite {
+		return nil, errors.New("the SQL console is disabled")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
+	defer cancel()
+
+	accessMode := pgx.ReadWrite
+	if config.Mode == SqlConsoleReadOnly {
+		accessMode = pgx.ReadOnly
+	}
+	tx, err := h.dbPool.BeginTx(ctx, pgx.TxOptions{AccessMode: accessMode})
 	if err != nil {
 		return nil, err
 	}
+	defer tx.Rollback(context.Background()) // Does nothing if we've committed
+
+	// Also stop the query on the database's side, in case it ignores the cancelled context
+	_, err = tx.Exec(ctx, fmt.Sprintf("SET LOCAL statement_timeout = %d", config.Timeout.Milliseconds()))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := &SqlResult{}
+	for _, field := range rows.FieldDescriptions() {
+		result.Columns = append(result.Columns, field.Name)
+	}
+
+	for rows.Next() {
+		if len(result.Rows) >= config.MaxRows {
+			result.Truncated = true
+			break
+		}
+
+		values, err := rows.Values()
+		if err != nil {
+			return nil, err
+		}
+
+		row := make([]string, len(values))
+		for i, value := range values {
+			row[i] = fmt.Sprintf("%v", value)
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if config.Mode == SqlConsoleReadWrite {
+		if err := tx.Commit(ctx); err != nil {
+			return nil, err
+		}
+	}
 	return result, nil
 }
 
@@ -562,8 +1103,20 @@ func (h *Hub) addQuestToDb(quest *quests.Quest) {
 	}
 }
 
-func (h *Hub) registerClient(client ClientInterfacer) {
-	client.Initialize(h.Clients.Add(client))
+// Registers the client with the hub and starts it off in the given level's simulation
+func (h *Hub) registerClient(client ClientInterfacer, level *Level) {
+	id := h.Clients.Add(client)
+	h.moveClient(id, client, level, func() {
+		client.Initialize(id)
+	})
+}
+
+func (h *Hub) unregisterClient(client ClientInterfacer) {
+	h.Clients.Remove(client.Id())
+	if level, exists := h.clientLevels.Get(client.Id()); exists {
+		level.Clients.Remove(client.Id())
+		h.clientLevels.Remove(client.Id())
+	}
 }
 
 func (h *Hub) addDefaultItems() {
@@ -671,24 +1224,7 @@ func (h *Hub) addDefaultNpcs() {
 			log.Fatalf("NPC %d has no quest or shop", id)
 		}
 
-		// Register the client
-		h.registerClient(h.npcClients[id])
+		// Register the client in the NPC's level so it's simulated alongside the players it interacts with
+		h.registerClient(h.npcClients[id], h.getOrCreateLevel(npc.LevelId))
 	}
 }
-
-func wordsFromFile(filePath string) []string {
-	words := make([]string, 0)
-	text, err := os.ReadFile(filePath)
-	if err != nil {
-		log.Printf("Error reading file %s: %v", filePath, err)
-	}
-
-	for _, word := range strings.Split(string(text), "\n") {
-		trimmedWord := strings.TrimSpace(word)
-		if trimmedWord != "" {
-			words = append(words, trimmedWord)
-		}
-	}
-
-	return words
-}
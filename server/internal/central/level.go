@@ -0,0 +1,203 @@
+package central
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/objs"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/ds"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+)
+
+// The ID of the lobby, i.e. the simulation for clients that aren't in a level yet. Level IDs come from a SERIAL column
+// in the database, so they will never be 0.
+const lobbyLevelId int32 = 0
+
+// How many of the most recent chat messages each level remembers, for players' reports
+const levelChatLogSize = 100
+
+// Something a player said in a level
+type ChatLine struct {
+	SenderActorId int32
+	SenderName    string
+	Kind          string // "chat", "yell", "whisper" or "channel"
+	To            string // Who a whisper was to, or which channel a message was in
+	Msg           string
+	SentAt        time.Time
+}
+
+// A level is an independently ticking simulation unit. It has its own goroutine, tick loop and message queue, and it
+// processes the packets of all the clients currently inside it. Clients are handed off between levels as they move
+// around the world, so a slow level can't hold up clients in the rest of the world.
+type Level struct {
+	Id int32
+
+	// The clients currently being simulated by this level, keyed by client ID
+	Clients *ds.SharedCollection[ClientInterfacer]
+
+	// Work to be done on this level's goroutine, e.g. handing off clients or processing messages from other levels
+	jobs *ds.Mailbox[func()]
+
+	// What's recently been said in the level. Safe to use from any goroutine, since whispers are logged by whoever
+	// receives them.
+	ChatLog *ds.RingBuffer[ChatLine]
+
+	// The clients whose actors have changed during this tick, to be sent to everyone else in the level at the end of it
+	changedActors map[uint32]struct{}
+
+	hub    *Hub
+	logger *log.Logger
+}
+
+func newLevel(hub *Hub, id int32) *Level {
+	prefix := fmt.Sprintf("Level %d: ", id)
+	if id == lobbyLevelId {
+		prefix = "Lobby: "
+	}
+
+	return &Level{
+		Id:      id,
+		Clients: ds.NewSharedCollection[ClientInterfacer](),
+		jobs:    ds.NewMailbox[func()](),
+		ChatLog: ds.NewRingBuffer[ChatLine](levelChatLogSize),
+
+		changedActors: make(map[uint32]struct{}),
+		hub:           hub,
+		logger:        log.New(log.Writer(), prefix, log.LstdFlags),
+	}
+}
+
+// Queues a job to be run on this level's goroutine. Never blocks.
+func (l *Level) Post(job func()) {
+	l.jobs.Push(job)
+}
+
+// How many times per second the level ticks
+func (l *Level) TickRate() int {
+	return l.hub.SimulationConfig.TickRate
+}
+
+// The server's current tick, the same in every level
+func (l *Level) Tick() uint64 {
+	return l.hub.Tick()
+}
+
+// Has every client in the level process a message from the sender. Must be called from the level's goroutine.
+func (l *Level) Broadcast(senderId uint32, message packets.Msg) {
+	l.Clients.ForEach(func(clientId uint32, client ClientInterfacer) {
+		if current, exists := l.hub.clientLevels.Get(clientId); exists && current == l {
+			client.ProcessMessage(senderId, message)
+		}
+	})
+}
+
+// Calls the callback for the actor of each client the level is simulating, including NPCs. Must be called from the
+// level's goroutine, which is what makes it safe for the callback to read the actors.
+func (l *Level) ForEachActor(callback func(clientId uint32, actor *objs.Actor)) {
+	l.Clients.ForEach(func(clientId uint32, _ ClientInterfacer) {
+		if current, exists := l.hub.clientLevels.Get(clientId); !exists || current != l {
+			return
+		}
+		if actor, exists := l.hub.SharedGameObjects.Actors.Get(clientId); exists && actor.LevelId == l.Id {
+			callback(clientId, actor)
+		}
+	})
+}
+
+// Marks the client's actor as changed, so everyone else in the level is sent its latest state at the end of the tick.
+// The actor is stamped with the current tick, so clients can tell which of its updates is the latest. Must be called
+// from the level's goroutine.
+func (l *Level) MarkActorChanged(clientId uint32) {
+	if actor, exists := l.hub.SharedGameObjects.Actors.Get(clientId); exists {
+		actor.Tick = l.hub.Tick()
+	}
+	l.changedActors[clientId] = struct{}{}
+}
+
+// Sends the latest state of each actor that changed this tick to everyone else in the level. However many times an
+// actor changed during the tick, only one update is sent for it.
+func (l *Level) flushChangedActors() {
+	if len(l.changedActors) <= 0 {
+		return
+	}
+
+	changed := l.changedActors
+	l.changedActors = make(map[uint32]struct{}, len(changed))
+
+	for clientId := range changed {
+		// The client might have left the level since it changed, in which case the new level is responsible for it
+		if current, exists := l.hub.clientLevels.Get(clientId); !exists || current != l {
+			continue
+		}
+		actor, exists := l.hub.SharedGameObjects.Actors.Get(clientId)
+		if !exists || actor.LevelId != l.Id {
+			continue
+		}
+
+		message := packets.NewActor(actor)
+		l.Clients.ForEach(func(recipientId uint32, recipient ClientInterfacer) {
+			if recipientId == clientId {
+				return
+			}
+			if current, exists := l.hub.clientLevels.Get(recipientId); !exists || current != l {
+				return
+			}
+
+			// NPCs don't care about each other
+			if actor.IsNpc {
+				if recipientActor, exists := l.hub.SharedGameObjects.Actors.Get(recipientId); exists && recipientActor.IsNpc {
+					return
+				}
+			}
+
+			recipient.ProcessMessage(clientId, message)
+		})
+	}
+}
+
+// Runs the level's simulation loop forever
+func (l *Level) Run() {
+	l.logger.Println("Starting simulation")
+
+	ticker := time.NewTicker(time.Second / time.Duration(l.hub.SimulationConfig.TickRate))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.jobs.Ready():
+			for _, job := range l.jobs.Drain() {
+				job()
+			}
+
+		case <-ticker.C:
+			l.Clients.ForEach(l.processPackets)
+			l.flushChangedActors()
+		}
+	}
+}
+
+// Processes up to the configured budget of packets from the client, highest priority first. Lower priorities only get
+// a look in once everything above them has been processed.
+func (l *Level) processPackets(clientId uint32, client ClientInterfacer) {
+	budget := l.hub.SimulationConfig.PacketsPerTick
+
+	for priority := packets.PriorityNormal; int(priority) < packets.NumPriorities; priority++ {
+	drain:
+		for budget > 0 {
+			// The client might have been handed off to another level earlier in this tick, e.g. by walking through a door
+			if current, exists := l.hub.clientLevels.Get(clientId); !exists || current != l {
+				return
+			}
+
+			select {
+			case packet := <-client.PacketsForProcessingChan(priority):
+				client.ProcessMessage(packet.SenderId, packet.Msg)
+				PacketsProcessed.Add(MetricsKey(packet.Msg), 1)
+				budget--
+			default:
+				break drain // Nothing left at this priority
+			}
+		}
+	}
+}
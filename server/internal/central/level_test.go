@@ -0,0 +1,226 @@
+package central
+
+import (
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/objs"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/ds"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+)
+
+// A client whose state is deliberately left unsynchronized, so the race detector will complain if the hub ever lets
+// two goroutines touch it at the same time.
+type soakClient struct {
+	id        atomic.Uint32
+	hub       *Hub
+	chans     [packets.NumPriorities]chan *packets.Packet
+	levelIds  []int32
+	received  int
+	xp        uint32
+	inventory *ds.Inventory
+	actor     *objs.Actor
+}
+
+func newSoakClient(hub *Hub, levelIds []int32) *soakClient {
+	c := &soakClient{
+		hub:       hub,
+		levelIds:  levelIds,
+		inventory: ds.NewInventory(),
+		actor:     objs.NewActor(0, 0, 0, "soak", 0, 0, 0),
+	}
+	for i := range c.chans {
+		c.chans[i] = make(chan *packets.Packet, 64)
+	}
+	return c
+}
+
+func (c *soakClient) Id() uint32 { return c.id.Load() }
+func (c *soakClient) PacketsForProcessingChan(priority packets.Priority) chan *packets.Packet {
+	return c.chans[priority]
+}
+func (c *soakClient) Initialize(id uint32) { c.id.Store(id) }
+func (c *soakClient) RemoteIp() string     { return "" }
+
+func (c *soakClient) ProcessMessage(senderId uint32, message packets.Msg) {
+	c.received++
+
+	if senderId != c.Id() {
+		return
+	}
+
+	switch message.(type) {
+	case *packets.Packet_ActorMove:
+		c.actor.X++
+		c.Level().MarkActorChanged(c.Id())
+		c.hub.Broadcast(c.Id(), packets.NewActor(c.actor))
+	case *packets.Packet_Chat:
+		c.EnterLevel(c.levelIds[rand.IntN(len(c.levelIds))], func() {
+			c.actor.LevelId = c.hub.LevelOf(c).Id
+			c.hub.SharedGameObjects.Actors.Add(c.actor, c.Id())
+		})
+	}
+}
+
+func (c *soakClient) SocketSend(message packets.Msg)                    {}
+func (c *soakClient) SocketSendAs(message packets.Msg, senderId uint32) {}
+func (c *soakClient) SocketSendBatch(batch ...*packets.Packet)          {}
+func (c *soakClient) SetBatching(enabled bool)                          {}
+func (c *soakClient) SetCompression(enabled bool)                       {}
+func (c *soakClient) SetProtocol(version uint32, capabilities []string) {}
+func (c *soakClient) ProtocolVersion() uint32                           { return packets.ProtocolVersion }
+func (c *soakClient) HasCapability(capability string) bool              { return false }
+func (c *soakClient) PassToPeer(message packets.Msg, peerId uint32) {
+	c.hub.PassToPeer(c.Id(), message, peerId)
+}
+func (c *soakClient) EnterLevel(levelId int32, onArrival func()) {
+	c.hub.EnterLevel(c, levelId, onArrival)
+}
+func (c *soakClient) ExitLevel(onArrival func()) { c.hub.ExitLevel(c, onArrival) }
+func (c *soakClient) Broadcast(message packets.Msg, to ...[]uint32) {
+	c.hub.Broadcast(c.Id(), message, to...)
+}
+func (c *soakClient) Post(job func()) { c.hub.PostToClient(c, job) }
+func (c *soakClient) PostToPeer(peerId uint32, job func()) bool {
+	return c.hub.PostToPeer(peerId, job)
+}
+func (c *soakClient) PostToLevel(levelId int32, delay time.Duration, job func(level *Level)) {
+	c.hub.PostToLevel(levelId, delay, job)
+}
+func (c *soakClient) PostToEveryLevel(job func(level *Level), done func()) {
+	c.hub.PostToEveryLevel(job, done)
+}
+func (c *soakClient) Level() *Level                         { return c.hub.LevelOf(c) }
+func (c *soakClient) ReadPump()                             {}
+func (c *soakClient) WritePump()                            {}
+func (c *soakClient) StartSession() string                  { return "" }
+func (c *soakClient) EndSession()                           {}
+func (c *soakClient) ResumeSession(token string) bool       { return false }
+func (c *soakClient) DbTx() *DbTx                           { return nil }
+func (c *soakClient) RunSql(sql string) (*SqlResult, error) { return nil, nil }
+func (c *soakClient) SetState(newState ClientStateHandler)  {}
+func (c *soakClient) UtilFunctions() *UtilFunctions         { return c.hub.UtilFunctions }
+func (c *soakClient) SharedGameObjects() *SharedGameObjects { return c.hub.SharedGameObjects }
+func (c *soakClient) GameData() *GameData                   { return c.hub.GameData }
+func (c *soakClient) LevelPointMaps() *LevelPointMaps       { return c.hub.LevelPointMaps }
+func (c *soakClient) AuthLimits() *AuthLimits               { return c.hub.AuthLimits }
+func (c *soakClient) Channels() *Channels                   { return c.hub.Channels }
+func (c *soakClient) Parties() *Parties                     { return c.hub.Parties }
+func (c *soakClient) ChatArchive() *ChatArchive             { return c.hub.ChatArchive }
+func (c *soakClient) WorldClock() *WorldClock               { return c.hub.WorldClock }
+func (c *soakClient) Close(reason string)                   { c.hub.UnregisterChan <- c }
+func (c *soakClient) AdminSessions() *ds.SharedCollection[int32] {
+	return c.hub.AdminSessions
+}
+
+// Hammers the hub with clients moving between levels, messaging each other and being poked by timers, all at once.
+// Only meaningful when run with -race.
+func TestLevelsSoak(t *testing.T) {
+	duration := 2 * time.Second
+	if testing.Short() {
+		duration = 200 * time.Millisecond
+	}
+
+	// The pool connects lazily, and nothing here touches the database
+	hub := NewHub(t.TempDir(), "host=127.0.0.1 port=1", SimulationConfig{TickRate: 200, PacketsPerTick: 2})
+	go hub.RunSimulation()
+
+	levelIds := []int32{1, 2, 3, 4}
+	clients := make([]*soakClient, 32)
+	for i := range clients {
+		clients[i] = newSoakClient(hub, levelIds)
+		hub.RegisterChan <- clients[i]
+	}
+
+	item := *objs.NewItem("Logs", "Soak test logs", 1, 0, 0, nil, false, true, 1)
+	stop := make(chan struct{})
+	var workers sync.WaitGroup
+
+	for _, client := range clients {
+		// Stand in for the client's read pump and any timers it has running
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+
+				var msg packets.Msg = &packets.Packet_ActorMove{ActorMove: &packets.ActorMove{Dx: 1}}
+				if rand.IntN(4) == 0 {
+					msg = packets.NewChat("hello")
+				}
+				select {
+				case client.chans[packets.PriorityOf(msg)] <- &packets.Packet{SenderId: client.Id(), Msg: msg}:
+				default:
+				}
+
+				client.Post(func() {
+					client.xp += 10
+					client.inventory.AddItem(item, 1)
+				})
+
+				client.PostToLevel(levelIds[rand.IntN(len(levelIds))], time.Millisecond, func(level *Level) {
+					level.Broadcast(client.Id(), packets.NewShrub(1, objs.NewShrub(1, level.Id, 0, 0, 0)))
+				})
+
+				// Someone else reading the inventory, e.g. to show it in a shop
+				client.inventory.GetItemQuantity(item)
+
+				time.Sleep(time.Millisecond)
+			}
+		}()
+	}
+
+	time.Sleep(duration)
+	close(stop)
+	workers.Wait()
+
+	// Wait for every client to settle in a level and check its state from there
+	var settled sync.WaitGroup
+	var totalReceived, totalXp atomic.Int64
+	for _, client := range clients {
+		settled.Add(1)
+		client.Post(func() {
+			defer settled.Done()
+			totalReceived.Add(int64(client.received))
+			totalXp.Add(int64(client.xp))
+
+			level := hub.LevelOf(client)
+			if level == nil {
+				t.Errorf("Client %d isn't in a level", client.Id())
+				return
+			}
+			if _, exists := level.Clients.Get(client.Id()); !exists {
+				t.Errorf("Client %d is running on level %d but isn't in its clients", client.Id(), level.Id)
+			}
+			if client.inventory.GetItemQuantity(item) != client.xp/10 {
+				t.Errorf("Client %d has %d logs but %d XP", client.Id(), client.inventory.GetItemQuantity(item), client.xp)
+			}
+		})
+	}
+
+	done := make(chan struct{})
+	go func() {
+		settled.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for clients to settle")
+	}
+
+	if totalReceived.Load() == 0 || totalXp.Load() == 0 {
+		t.Errorf("Expected some work to be done, got %d messages and %d XP", totalReceived.Load(), totalXp.Load())
+	}
+
+	for _, client := range clients {
+		client.Close("soak test over")
+	}
+}
@@ -0,0 +1,25 @@
+package central
+
+import (
+	"expvar"
+	"fmt"
+
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+)
+
+// Counters served at /debug/vars on the metrics address, keyed by message type, so we can see when and where the server is shedding load
+var (
+	// Packets received from clients that were dropped because the client's processing queue was full
+	InboundPacketsDropped = expvar.NewMap("inbound_packets_dropped")
+
+	// Clients that were disconnected because they couldn't keep up with the packets we were sending them
+	SlowClientsDisconnected = expvar.NewInt("slow_clients_disconnected")
+
+	// Packets received from clients that were processed by a level's simulation
+	PacketsProcessed = expvar.NewMap("packets_processed")
+)
+
+// The key to use for a message in the metrics maps
+func MetricsKey(message packets.Msg) string {
+	return fmt.Sprintf("%T", message)
+}
@@ -0,0 +1,190 @@
+package central
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	MaxPartySize = 5
+
+	// How long an invitation to a party can be accepted for
+	partyInviteTimeout = 60 * time.Second
+)
+
+type party struct {
+	leaderId  uint32
+	memberIds []uint32 // Client IDs, in the order they joined
+}
+
+type partyInvite struct {
+	inviterId uint32
+	expiresAt time.Time
+}
+
+// Groups of players, keyed by an ID that's only unique while the server is running. Parties don't outlive the
+// players' sessions, so they aren't saved. Each party has its own chat channel. Safe for concurrent use.
+type Parties struct {
+	channels *Channels
+	parties  map[uint32]*party
+	byClient map[uint32]uint32        // Party IDs keyed by client ID
+	invites  map[uint32][]partyInvite // Pending invitations keyed by the client invited
+	nextId   uint32
+	mux      sync.Mutex
+}
+
+func NewParties(channels *Channels) *Parties {
+	return &Parties{
+		channels: channels,
+		parties:  make(map[uint32]*party),
+		byClient: make(map[uint32]uint32),
+		invites:  make(map[uint32][]partyInvite),
+		nextId:   1,
+	}
+}
+
+// The name of the party's chat channel
+func PartyChannel(partyId uint32) string {
+	return fmt.Sprintf("party:%d", partyId)
+}
+
+// The party the client is in, its leader, and everyone in it (including the client), or false if they aren't in one
+func (p *Parties) Get(clientId uint32) (uint32, uint32, []uint32, bool) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	partyId, inParty := p.byClient[clientId]
+	if !inParty {
+		return 0, 0, nil, false
+	}
+	pt := p.parties[partyId]
+	return partyId, pt.leaderId, slices.Clone(pt.memberIds), true
+}
+
+// Records that the inviter has asked the invitee to join their party, or start one with them
+func (p *Parties) Invite(inviterId uint32, inviteeId uint32) error {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if _, inParty := p.byClient[inviteeId]; inParty {
+		return errors.New("they're already in a party")
+	}
+	if partyId, inParty := p.byClient[inviterId]; inParty {
+		pt := p.parties[partyId]
+		if pt.leaderId != inviterId {
+			return errors.New("only the party leader can invite people")
+		}
+		if len(pt.memberIds) >= MaxPartySize {
+			return errors.New("your party is full")
+		}
+	}
+
+	invites := p.liveInvites(inviteeId)
+	invites = slices.DeleteFunc(invites, func(invite partyInvite) bool { return invite.inviterId == inviterId })
+	p.invites[inviteeId] = append(invites, partyInvite{inviterId: inviterId, expiresAt: time.Now().Add(partyInviteTimeout)})
+	return nil
+}
+
+// Puts the invitee in the inviter's party, starting one if the inviter isn't in one yet. Returns the party's ID.
+func (p *Parties) Accept(inviteeId uint32, inviterId uint32) (uint32, error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	invites := p.liveInvites(inviteeId)
+	index := slices.IndexFunc(invites, func(invite partyInvite) bool { return invite.inviterId == inviterId })
+	if index < 0 {
+		return 0, errors.New("they haven't invited you to a party, or the invitation has expired")
+	}
+	if _, inParty := p.byClient[inviteeId]; inParty {
+		return 0, errors.New("you're already in a party")
+	}
+
+	partyId, inParty := p.byClient[inviterId]
+	if !inParty {
+		partyId = p.nextId
+		p.nextId++
+		p.parties[partyId] = &party{leaderId: inviterId, memberIds: []uint32{inviterId}}
+		p.byClient[inviterId] = partyId
+		p.channels.Ensure(PartyChannel(partyId), ChannelParty, 0)
+	}
+
+	pt := p.parties[partyId]
+	if pt.leaderId != inviterId {
+		return 0, errors.New("they're no longer the party leader")
+	}
+	if len(pt.memberIds) >= MaxPartySize {
+		return 0, errors.New("the party is full")
+	}
+
+	pt.memberIds = append(pt.memberIds, inviteeId)
+	p.byClient[inviteeId] = partyId
+	delete(p.invites, inviteeId)
+	return partyId, nil
+}
+
+// Takes the client out of their party. If they were the leader, the longest-standing member takes over, and if only
+// one member is left, the party is disbanded. Returns who was left in the party, so they can be told.
+func (p *Parties) Leave(clientId uint32) ([]uint32, bool) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	delete(p.invites, clientId)
+	return p.remove(clientId)
+}
+
+// Lets the leader take someone else out of the party. Returns who was left in the party.
+func (p *Parties) Kick(leaderId uint32, memberId uint32) ([]uint32, error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	partyId, inParty := p.byClient[leaderId]
+	if !inParty {
+		return nil, errors.New("you aren't in a party")
+	}
+	pt := p.parties[partyId]
+	if pt.leaderId != leaderId {
+		return nil, errors.New("only the party leader can kick people")
+	}
+	if !slices.Contains(pt.memberIds, memberId) {
+		return nil, errors.New("they aren't in your party")
+	}
+
+	remaining, _ := p.remove(memberId)
+	return remaining, nil
+}
+
+// Must be called with the lock held
+func (p *Parties) remove(clientId uint32) ([]uint32, bool) {
+	partyId, inParty := p.byClient[clientId]
+	if !inParty {
+		return nil, false
+	}
+	delete(p.byClient, clientId)
+
+	pt := p.parties[partyId]
+	pt.memberIds = slices.DeleteFunc(pt.memberIds, func(id uint32) bool { return id == clientId })
+	remaining := slices.Clone(pt.memberIds)
+
+	if len(pt.memberIds) < 2 {
+		for _, id := range pt.memberIds {
+			delete(p.byClient, id)
+		}
+		delete(p.parties, partyId)
+		p.channels.Remove(PartyChannel(partyId))
+		return remaining, true
+	}
+
+	if pt.leaderId == clientId {
+		pt.leaderId = pt.memberIds[0]
+	}
+	return remaining, true
+}
+
+// Must be called with the lock held
+func (p *Parties) liveInvites(inviteeId uint32) []partyInvite {
+	now := time.Now()
+	return slices.DeleteFunc(p.invites[inviteeId], func(invite partyInvite) bool { return now.After(invite.expiresAt) })
+}
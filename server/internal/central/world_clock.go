@@ -0,0 +1,78 @@
+package central
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+)
+
+const (
+	minutesPerGameDay = 24 * 60
+
+	// How often everyone in the game is told the time, so their clocks don't drift from the server's
+	worldTimeBroadcastInterval = time.Minute
+
+	// When night falls and when the sun comes up again, in game hours
+	nightfallHour = 20
+	sunriseHour   = 6
+)
+
+// The time of day in the game world, which runs faster than real time so a whole day and night pass in DayLength.
+// It's worked out from the real time, so every level agrees on it and it carries on where it left off after a restart.
+type WorldClock struct {
+	// How long a whole day in the game lasts in real time
+	DayLength time.Duration
+}
+
+func NewWorldClock(dayLength time.Duration) *WorldClock {
+	return &WorldClock{DayLength: dayLength}
+}
+
+// A moment in the game world
+type GameTime struct {
+	Day    int64 // How many whole days have passed in the game world
+	Minute int32 // Minutes since midnight, from 0 to 1439
+}
+
+func (t GameTime) Hour() int32 {
+	return t.Minute / 60
+}
+
+// Whether the time is from fromHour up to, but not including, toHour. The hours can wrap past midnight, e.g. from 22
+// to 4.
+func (t GameTime) Between(fromHour, toHour int32) bool {
+	hour := t.Hour()
+	if fromHour <= toHour {
+		return hour >= fromHour && hour < toHour
+	}
+	return hour >= fromHour || hour < toHour
+}
+
+func (t GameTime) IsNight() bool {
+	return t.Between(nightfallHour, sunriseHour)
+}
+
+// E.g. "day 12, 06:30"
+func (t GameTime) String() string {
+	return fmt.Sprintf("day %d, %02d:%02d", t.Day, t.Hour(), t.Minute%60)
+}
+
+func (c *WorldClock) Now() GameTime {
+	return c.At(time.Now())
+}
+
+// The time in the game world at a moment in real time
+func (c *WorldClock) At(realTime time.Time) GameTime {
+	gameMinutes := realTime.UnixMilli() * minutesPerGameDay / c.DayLength.Milliseconds()
+	return GameTime{
+		Day:    gameMinutes / minutesPerGameDay,
+		Minute: int32(gameMinutes % minutesPerGameDay),
+	}
+}
+
+// The time in a packet for the client, which it can keep running on its own between updates
+func (c *WorldClock) Packet() packets.Msg {
+	now := c.Now()
+	return packets.NewWorldTime(now.Day, now.Minute, uint32(c.DayLength.Seconds()))
+}
@@ -0,0 +1,186 @@
+package chatfilter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// The most recent messages kept per sender, for the flood and repeat rules
+const maxRecentMessages = 20
+
+// The rules every chat message is checked against, in order, as read from the config file
+type Config struct {
+	Rules []RuleConfig `json:"rules"`
+}
+
+// The rules used when there's no config file. Slurs are censored like they always have been.
+func DefaultConfig() Config {
+	return Config{Rules: []RuleConfig{
+		{Name: "slurs", Type: WordsRule, Action: Censor, List: "slurs"},
+		{Name: "flood", Type: FloodRule, Action: Drop, MaxMessages: 5, WindowSeconds: 5},
+		{Name: "repeat", Type: RepeatRule, Action: Drop, WindowSeconds: 30},
+		{Name: "links", Type: LinksRule, Action: Censor},
+		{Name: "caps", Type: CapsRule, Action: Censor, MaxRatio: 0.7, MinLength: 8},
+	}}
+}
+
+// What the pipeline decided to do with a message
+type Verdict struct {
+	Msg         string // The message to send, possibly censored
+	Action      Action // Empty if the message can be sent as Msg
+	Rule        string // The name of the rule that dropped the message or muted the sender
+	Reason      string // Why, for telling the sender
+	MuteMinutes uint32
+}
+
+// Whether the message can be sent, possibly censored
+func (v Verdict) Allowed() bool {
+	return v.Action == "" || v.Action == Censor
+}
+
+// Checks chat messages against a list of rules loaded from a JSON file, which is loaded again whenever it changes.
+// Safe for concurrent use.
+type Pipeline struct {
+	configPath string
+	modTime    time.Time
+	lists      map[string]*WordList
+	rules      []*rule
+	recent     map[int32][]sentMessage
+	mux        sync.Mutex
+}
+
+// Loads the rules from the config file, or uses the defaults if there isn't one. The word lists can be referred to
+// by name in words rules.
+func NewPipeline(configPath string, lists map[string]*WordList) *Pipeline {
+	p := &Pipeline{
+		configPath: configPath,
+		lists:      lists,
+		recent:     make(map[int32][]sentMessage),
+	}
+
+	rules, err := p.buildRules(DefaultConfig())
+	if err != nil {
+		log.Fatalf("Error building the default chat filter: %v", err)
+	}
+	p.rules = rules
+
+	if info, err := os.Stat(configPath); err == nil {
+		p.modTime = info.ModTime()
+		if err := p.load(); err != nil {
+			log.Printf("Error loading chat filter config %s, using the defaults: %v", configPath, err)
+		}
+	} else {
+		log.Printf("No chat filter config at %s, using the defaults", configPath)
+	}
+
+	return p
+}
+
+// Checks a message from the sender (an actor's database ID) against each rule in turn. Censoring rules change the
+// message and checking carries on; the first rule to drop the message or mute the sender decides. Every message is
+// remembered for the flood and repeat rules, whether it's allowed or not.
+func (p *Pipeline) Check(senderId int32, msg string, now time.Time) Verdict {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	recent := p.recent[senderId]
+	defer func() {
+		recent = append(recent, sentMessage{msg: msg, sentAt: now})
+		if len(recent) > maxRecentMessages {
+			recent = recent[len(recent)-maxRecentMessages:]
+		}
+		p.recent[senderId] = recent
+	}()
+
+	verdict := Verdict{Msg: msg}
+	for _, r := range p.rules {
+		if !r.breaks(verdict.Msg, recent, now) {
+			continue
+		}
+
+		if r.Action == Censor && r.censor != nil {
+			verdict.Msg = r.censor(verdict.Msg)
+			verdict.Action = Censor
+			continue
+		}
+
+		verdict.Action = r.Action
+		if verdict.Action == Censor {
+			// There's no way to censor e.g. flooding, so the message has to go
+			verdict.Action = Drop
+		}
+		verdict.Rule = r.Name
+		verdict.Reason = r.reason()
+		verdict.MuteMinutes = r.MuteMinutes
+		return verdict
+	}
+	return verdict
+}
+
+// Forgets what the sender has sent recently, e.g. when they leave the game
+func (p *Pipeline) Forget(senderId int32) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	delete(p.recent, senderId)
+}
+
+// Loads the config file again if it has changed since it was last loaded, returning whether it did. If the new
+// config is invalid, the old rules are kept.
+func (p *Pipeline) ReloadIfChanged() bool {
+	info, err := os.Stat(p.configPath)
+	if err != nil || !info.ModTime().After(p.modTime) {
+		return false
+	}
+	p.modTime = info.ModTime()
+
+	if err := p.load(); err != nil {
+		log.Printf("Error reloading chat filter config %s, keeping the old rules: %v", p.configPath, err)
+		return false
+	}
+	log.Printf("Reloaded chat filter config from %s", p.configPath)
+	return true
+}
+
+func (p *Pipeline) load() error {
+	data, err := os.ReadFile(p.configPath)
+	if err != nil {
+		return err
+	}
+
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return err
+	}
+
+	rules, err := p.buildRules(config)
+	if err != nil {
+		return err
+	}
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.rules = rules
+	return nil
+}
+
+func (p *Pipeline) buildRules(config Config) ([]*rule, error) {
+	rules := make([]*rule, 0, len(config.Rules))
+	errs := make([]error, 0)
+	for i, ruleConfig := range config.Rules {
+		if ruleConfig.Name == "" {
+			ruleConfig.Name = fmt.Sprintf("%s #%d", ruleConfig.Type, i+1)
+		}
+		r, err := newRule(ruleConfig, p.lists)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rule %s: %w", ruleConfig.Name, err))
+			continue
+		}
+		rules = append(rules, r)
+	}
+	return rules, errors.Join(errs...)
+}
@@ -0,0 +1,195 @@
+package chatfilter
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// What to do with a message that breaks a rule
+type Action string
+
+const (
+	Censor Action = "censor" // Change the message so it doesn't break the rule, and carry on checking it
+	Drop   Action = "drop"   // Don't send the message
+	Mute   Action = "mute"   // Don't send the message, and mute the sender for a while
+)
+
+// The kinds of rule, each configured by different fields of RuleConfig
+const (
+	WordsRule  = "words"  // Messages containing a word from a list
+	FloodRule  = "flood"  // Too many messages in too short a time
+	RepeatRule = "repeat" // The same message again
+	LinksRule  = "links"  // Links to websites, other than those allowed
+	CapsRule   = "caps"   // Messages that are mostly capital letters
+)
+
+// One rule as written in the config file
+type RuleConfig struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Action      Action `json:"action"`
+	MuteMinutes uint32 `json:"mute_minutes,omitempty"`
+
+	// Words: the name of the list to check against, i.e. "profanity" or "slurs"
+	List string `json:"list,omitempty"`
+
+	// Flood: how many messages are allowed in the window. Repeat: how long a message can't be sent again for.
+	MaxMessages   int `json:"max_messages,omitempty"`
+	WindowSeconds int `json:"window_seconds,omitempty"`
+
+	// Links: domains that can be linked to, including their subdomains
+	Allow []string `json:"allow,omitempty"`
+
+	// Caps: the most of a message's letters that can be capitals, in messages with at least min_length letters
+	MaxRatio  float64 `json:"max_ratio,omitempty"`
+	MinLength int     `json:"min_length,omitempty"`
+}
+
+// A message the sender sent, or tried to, recently
+type sentMessage struct {
+	msg    string
+	sentAt time.Time
+}
+
+type rule struct {
+	RuleConfig
+
+	// Whether the message breaks the rule, given what the sender has sent recently, oldest first
+	breaks func(msg string, recent []sentMessage, now time.Time) bool
+
+	// Changes the message so it doesn't break the rule, or nil if that isn't possible
+	censor func(msg string) string
+}
+
+// Matches things that look like links, with or without the scheme
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://\S+|www\.\S+|[a-z0-9-]+(?:\.[a-z0-9-]+)*\.(?:com|net|org|io|gg|co|me|tv|ly|xyz|ru|uk|de|info|biz)\b\S*)`)
+
+func newRule(config RuleConfig, lists map[string]*WordList) (*rule, error) {
+	switch config.Action {
+	case Censor, Drop:
+	case Mute:
+		if config.MuteMinutes == 0 {
+			return nil, errors.New("mute rules need mute_minutes")
+		}
+	default:
+		return nil, fmt.Errorf("unknown action %q", config.Action)
+	}
+
+	r := &rule{RuleConfig: config}
+	window := time.Duration(config.WindowSeconds) * time.Second
+
+	switch config.Type {
+	case WordsRule:
+		list, exists := lists[config.List]
+		if !exists {
+			return nil, fmt.Errorf("unknown list %q", config.List)
+		}
+		r.breaks = func(msg string, _ []sentMessage, _ time.Time) bool {
+			return list.Detector().IsProfane(msg)
+		}
+		r.censor = func(msg string) string {
+			return list.Detector().Censor(msg)
+		}
+
+	case FloodRule:
+		if config.MaxMessages <= 0 || config.WindowSeconds <= 0 {
+			return nil, errors.New("flood rules need max_messages and window_seconds")
+		}
+		r.breaks = func(_ string, recent []sentMessage, now time.Time) bool {
+			count := 0
+			for _, sent := range recent {
+				if now.Sub(sent.sentAt) < window {
+					count++
+				}
+			}
+			return count >= config.MaxMessages
+		}
+
+	case RepeatRule:
+		if config.WindowSeconds <= 0 {
+			return nil, errors.New("repeat rules need window_seconds")
+		}
+		r.breaks = func(msg string, recent []sentMessage, now time.Time) bool {
+			for _, sent := range recent {
+				if now.Sub(sent.sentAt) < window && strings.EqualFold(strings.TrimSpace(sent.msg), strings.TrimSpace(msg)) {
+					return true
+				}
+			}
+			return false
+		}
+
+	case LinksRule:
+		allowed := func(link string) bool {
+			host := strings.ToLower(link)
+			host = strings.TrimPrefix(strings.TrimPrefix(host, "http://"), "https://")
+			host, _, _ = strings.Cut(host, "/")
+			for _, domain := range config.Allow {
+				domain = strings.ToLower(domain)
+				if host == domain || strings.HasSuffix(host, "."+domain) {
+					return true
+				}
+			}
+			return false
+		}
+		r.breaks = func(msg string, _ []sentMessage, _ time.Time) bool {
+			for _, link := range linkPattern.FindAllString(msg, -1) {
+				if !allowed(link) {
+					return true
+				}
+			}
+			return false
+		}
+		r.censor = func(msg string) string {
+			return linkPattern.ReplaceAllStringFunc(msg, func(link string) string {
+				if allowed(link) {
+					return link
+				}
+				return "***"
+			})
+		}
+
+	case CapsRule:
+		if config.MaxRatio <= 0 || config.MaxRatio > 1 {
+			return nil, errors.New("caps rules need a max_ratio between 0 and 1")
+		}
+		r.breaks = func(msg string, _ []sentMessage, _ time.Time) bool {
+			letters, capitals := 0, 0
+			for _, c := range msg {
+				if unicode.IsLetter(c) {
+					letters++
+					if unicode.IsUpper(c) {
+						capitals++
+					}
+				}
+			}
+			return letters >= config.MinLength && letters > 0 && float64(capitals)/float64(letters) > config.MaxRatio
+		}
+		r.censor = strings.ToLower
+
+	default:
+		return nil, fmt.Errorf("unknown rule type %q", config.Type)
+	}
+
+	return r, nil
+}
+
+// The reason given to the sender when their message breaks the rule
+func (r *rule) reason() string {
+	switch r.Type {
+	case WordsRule:
+		return "it contains language that isn't allowed"
+	case FloodRule:
+		return "you're sending messages too quickly"
+	case RepeatRule:
+		return "you've just sent that"
+	case LinksRule:
+		return "links aren't allowed"
+	case CapsRule:
+		return "too many capital letters"
+	}
+	return "it breaks the " + r.Name + " rule"
+}
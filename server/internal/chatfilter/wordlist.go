@@ -0,0 +1,82 @@
+package chatfilter
+
+import (
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	goaway "github.com/TwiN/go-away"
+)
+
+// A list of words loaded from a file with one word per line, which is loaded again whenever the file changes. Safe
+// for concurrent use.
+type WordList struct {
+	path     string
+	modTime  time.Time
+	words    []string
+	detector *goaway.ProfanityDetector
+	mux      sync.RWMutex
+}
+
+// Loads the list from the file. A missing file is logged and treated as an empty list, so it can be added later.
+func NewWordList(path string) *WordList {
+	l := &WordList{path: path}
+	if info, err := os.Stat(path); err == nil {
+		l.modTime = info.ModTime()
+	}
+	l.set(readWords(path))
+	return l
+}
+
+func (l *WordList) Words() []string {
+	l.mux.RLock()
+	defer l.mux.RUnlock()
+	return l.words
+}
+
+// A detector for the words in the list as it is now
+func (l *WordList) Detector() *goaway.ProfanityDetector {
+	l.mux.RLock()
+	defer l.mux.RUnlock()
+	return l.detector
+}
+
+// Loads the list again if the file has changed since it was last loaded, returning whether it did
+func (l *WordList) ReloadIfChanged() bool {
+	info, err := os.Stat(l.path)
+	if err != nil || !info.ModTime().After(l.modTime) {
+		return false
+	}
+	l.modTime = info.ModTime()
+	l.set(readWords(l.path))
+	log.Printf("Reloaded %d words from %s", len(l.Words()), l.path)
+	return true
+}
+
+func (l *WordList) set(words []string) {
+	detector := goaway.NewProfanityDetector().WithCustomDictionary(words, []string{}, []string{})
+
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	l.words = words
+	l.detector = detector
+}
+
+func readWords(filePath string) []string {
+	words := make([]string, 0)
+	text, err := os.ReadFile(filePath)
+	if err != nil {
+		log.Printf("Error reading file %s: %v", filePath, err)
+	}
+
+	for _, word := range strings.Split(string(text), "\n") {
+		trimmedWord := strings.TrimSpace(word)
+		if trimmedWord != "" {
+			words = append(words, trimmedWord)
+		}
+	}
+
+	return words
+}
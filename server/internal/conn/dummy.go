@@ -3,68 +3,129 @@ package conn
 import (
 	"fmt"
 	"log"
+	"sync/atomic"
+	"time"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/ds"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
 )
 
 type DummyClient struct {
-	id                       uint32
-	initialState             central.ClientStateHandler
-	hub                      *central.Hub
-	packetsForProcessingChan chan *packets.Packet
-	dbTx                     *central.DbTx
-	state                    central.ClientStateHandler
-	logger                   *log.Logger
+	id                        atomic.Uint32 // Written by the owning level but read from the pumps and other levels
+	initialState              central.ClientStateHandler
+	hub                       *central.Hub
+	packetsForProcessingChans [packets.NumPriorities]chan *packets.Packet
+	dbTx                      *central.DbTx
+	state                     central.ClientStateHandler
+	logger                    *log.Logger
 }
 
 func NewDummyClient(hub *central.Hub, initialState central.ClientStateHandler) (central.ClientInterfacer, error) {
 	c := &DummyClient{
-		initialState:             initialState,
-		hub:                      hub,
-		packetsForProcessingChan: make(chan *packets.Packet, 64),
-		dbTx:                     hub.NewDbTx(),
-		logger:                   log.New(log.Writer(), "Client unknown: ", log.LstdFlags),
+		initialState: initialState,
+		hub:          hub,
+		dbTx:         hub.NewDbTx(),
+		logger:       log.New(log.Writer(), "Client unknown: ", log.LstdFlags),
+	}
+
+	for i := range c.packetsForProcessingChans {
+		c.packetsForProcessingChans[i] = make(chan *packets.Packet, 64)
 	}
 
 	return c, nil
 }
 
 func (c *DummyClient) Id() uint32 {
-	return c.id
+	return c.id.Load()
+}
+
+func (c *DummyClient) PacketsForProcessingChan(priority packets.Priority) chan *packets.Packet {
+	return c.packetsForProcessingChans[priority]
 }
 
-func (c *DummyClient) PacketsForProcessingChan() chan *packets.Packet {
-	return c.packetsForProcessingChan
+func (c *DummyClient) RemoteIp() string {
+	return ""
 }
 
 func (c *DummyClient) Initialize(id uint32) {
-	c.id = id
-	c.logger.SetPrefix(fmt.Sprintf("Client %d: ", c.id))
+	c.id.Store(id)
+	c.logger.SetPrefix(fmt.Sprintf("Client %d: ", id))
 	c.SetState(c.initialState)
 }
 
 func (c *DummyClient) ProcessMessage(senderId uint32, message packets.Msg) {
+	// We might have left the game but not been unregistered yet
+	if c.state == nil {
+		return
+	}
 	c.state.HandleMessage(senderId, message)
 }
 
 func (c *DummyClient) SocketSend(message packets.Msg) {
-	c.SocketSendAs(message, c.id)
+	c.SocketSendAs(message, c.Id())
 }
 
 func (c *DummyClient) SocketSendAs(message packets.Msg, senderId uint32) {
 	c.logger.Printf("Dummy client cannot send messages directly %T", message)
 }
 
+func (c *DummyClient) SocketSendBatch(batch ...*packets.Packet) {
+	c.logger.Printf("Dummy client cannot send messages directly (batch of %d)", len(batch))
+}
+
+func (c *DummyClient) SetBatching(enabled bool) {
+}
+
+func (c *DummyClient) SetCompression(enabled bool) {
+}
+
+func (c *DummyClient) SetProtocol(version uint32, capabilities []string) {
+}
+
+// Dummy clients live on the server, so they always speak the current protocol
+func (c *DummyClient) ProtocolVersion() uint32 {
+	return packets.ProtocolVersion
+}
+
+func (c *DummyClient) HasCapability(capability string) bool {
+	return false
+}
+
 func (c *DummyClient) PassToPeer(message packets.Msg, peerId uint32) {
-	if peer, exists := c.hub.Clients.Get(peerId); exists {
-		peer.ProcessMessage(c.id, message)
-	}
+	c.hub.PassToPeer(c.Id(), message, peerId)
+}
+
+func (c *DummyClient) EnterLevel(levelId int32, onArrival func()) {
+	c.hub.EnterLevel(c, levelId, onArrival)
+}
+
+func (c *DummyClient) ExitLevel(onArrival func()) {
+	c.hub.ExitLevel(c, onArrival)
 }
 
 func (c *DummyClient) Broadcast(message packets.Msg, to ...[]uint32) {
-	c.hub.Broadcast(c.id, message, to...)
+	c.hub.Broadcast(c.Id(), message, to...)
+}
+
+func (c *DummyClient) Post(job func()) {
+	c.hub.PostToClient(c, job)
+}
+
+func (c *DummyClient) PostToPeer(peerId uint32, job func()) bool {
+	return c.hub.PostToPeer(peerId, job)
+}
+
+func (c *DummyClient) PostToLevel(levelId int32, delay time.Duration, job func(level *central.Level)) {
+	c.hub.PostToLevel(levelId, delay, job)
+}
+
+func (c *DummyClient) PostToEveryLevel(job func(level *central.Level), done func()) {
+	c.hub.PostToEveryLevel(job, done)
+}
+
+func (c *DummyClient) Level() *central.Level {
+	return c.hub.LevelOf(c)
 }
 
 func (c *DummyClient) ReadPump() {
@@ -73,11 +134,23 @@ func (c *DummyClient) ReadPump() {
 func (c *DummyClient) WritePump() {
 }
 
+// Dummy clients have no connection to lose, so they never need to resume a session
+func (c *DummyClient) StartSession() string {
+	return ""
+}
+
+func (c *DummyClient) EndSession() {
+}
+
+func (c *DummyClient) ResumeSession(token string) bool {
+	return false
+}
+
 func (c *DummyClient) DbTx() *central.DbTx {
 	return c.dbTx
 }
 
-func (c *DummyClient) RunSql(sql string) (pgx.Rows, error) {
+func (c *DummyClient) RunSql(sql string) (*central.SqlResult, error) {
 	return c.hub.RunSql(sql)
 }
 
@@ -97,6 +170,30 @@ func (c *DummyClient) LevelPointMaps() *central.LevelPointMaps {
 	return c.hub.LevelPointMaps
 }
 
+func (c *DummyClient) AuthLimits() *central.AuthLimits {
+	return c.hub.AuthLimits
+}
+
+func (c *DummyClient) AdminSessions() *ds.SharedCollection[int32] {
+	return c.hub.AdminSessions
+}
+
+func (c *DummyClient) Channels() *central.Channels {
+	return c.hub.Channels
+}
+
+func (c *DummyClient) Parties() *central.Parties {
+	return c.hub.Parties
+}
+
+func (c *DummyClient) ChatArchive() *central.ChatArchive {
+	return c.hub.ChatArchive
+}
+
+func (c *DummyClient) WorldClock() *central.WorldClock {
+	return c.hub.WorldClock
+}
+
 func (c *DummyClient) SetState(state central.ClientStateHandler) {
 	prevStateName := "None"
 	if c.state != nil {
@@ -122,7 +219,9 @@ func (c *DummyClient) SetState(state central.ClientStateHandler) {
 func (c *DummyClient) Close(reason string) {
 	c.logger.Printf("Closing client connection because: %s", reason)
 
-	c.SetState(nil)
-
-	c.hub.UnregisterChan <- c
+	// Leave the game on the goroutine that owns our state before the hub forgets about us
+	c.Post(func() {
+		c.SetState(nil)
+		c.hub.UnregisterChan <- c
+	})
 }
@@ -0,0 +1,221 @@
+package conn
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+	"google.golang.org/protobuf/proto"
+)
+
+// A websocket connection and the pumps moving packets through it. A WebSocketClient keeps its ID and game state across
+// connections, so when a player's connection drops, the new one can be handed over to their old client.
+type socket struct {
+	conn *websocket.Conn
+
+	// The client the connection belongs to, which only changes when the connection is handed over to resume a session
+	client atomic.Pointer[WebSocketClient]
+
+	// Signalled when the connection is handed over, so the write pump starts sending the new client's packets
+	handedOver chan struct{}
+
+	closeOnce sync.Once
+	closed    chan struct{} // Closed once the socket is closed, to stop the write pump
+
+	// Only touched by the read pump
+	droppedSinceSignal     uint32
+	lastBackpressureSignal time.Time
+}
+
+func newSocket(conn *websocket.Conn, client *WebSocketClient) *socket {
+	s := &socket{
+		conn:       conn,
+		handedOver: make(chan struct{}, 1),
+		closed:     make(chan struct{}),
+	}
+	s.client.Store(client)
+	return s
+}
+
+// Safe to call from any goroutine, any number of times. The write pump sends anything still queued and then closes
+// the connection, which also stops the read pump.
+func (s *socket) close() {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+}
+
+func (s *socket) isClosed() bool {
+	select {
+	case <-s.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+// From now on, packets read from the connection are for the given client, and its packets are written to it
+func (s *socket) handOver(client *WebSocketClient) {
+	s.client.Store(client)
+	select {
+	case s.handedOver <- struct{}{}:
+	default:
+	}
+}
+
+func (s *socket) readPump() {
+	defer func() {
+		client := s.client.Load()
+		client.logger.Println("Closing read pump")
+		client.socketClosed(s, "read pump closed")
+	}()
+
+	for {
+		_, data, err := s.conn.ReadMessage()
+		client := s.client.Load()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				client.logger.Printf("unexpected closure: %v", err)
+			} else {
+				client.logger.Printf("normal disconnection: %v", err)
+			}
+			break
+		}
+
+		packet := &packets.Packet{}
+		err = proto.Unmarshal(data, packet)
+		if err != nil {
+			client.logger.Printf("error unmarshalling data: %v", err)
+			continue
+		}
+
+		// Anything that comes in over the socket is from the client itself, whatever it says. Otherwise it could pose as
+		// another client to its own state, e.g. to pass itself a notice only an admin should be able to send.
+		packet.SenderId = client.Id()
+
+		// Try putting this out to the hub for processing, but if the channel is full, drop it and tell the client to back off
+		select {
+		case client.packetsForProcessingChans[packets.PriorityOf(packet.Msg)] <- packet:
+		default:
+			client.logger.Printf("Client %d processing channel full, dropping message: %T", client.Id(), packet.Msg)
+			central.InboundPacketsDropped.Add(central.MetricsKey(packet.Msg), 1)
+			s.signalBackpressure(client)
+		}
+	}
+}
+
+// Lets the client know we've been dropping its packets, at most once per backpressureSignalInterval. Only to be called
+// from the read pump.
+func (s *socket) signalBackpressure(client *WebSocketClient) {
+	s.droppedSinceSignal++
+	if time.Since(s.lastBackpressureSignal) < backpressureSignalInterval {
+		return
+	}
+
+	// Roughly how long it will take the hub to work through a full processing channel
+	simConfig := client.hub.SimulationConfig
+	retryAfterMs := uint32(1000 * processingChanCapacity / (simConfig.PacketsPerTick * simConfig.TickRate))
+
+	// Clients from before the handshake might not know the packet. Their protocol version belongs to their level's
+	// goroutine, so check it there.
+	dropped := s.droppedSinceSignal
+	client.Post(func() {
+		if client.ProtocolVersion() >= packets.BackpressureProtocolVersion {
+			client.SocketSend(packets.NewBackpressure(dropped, retryAfterMs))
+		}
+	})
+	s.droppedSinceSignal = 0
+	s.lastBackpressureSignal = time.Now()
+}
+
+func (s *socket) writePump() {
+	defer func() {
+		client := s.client.Load()
+		client.logger.Println("Closing write pump")
+		client.socketClosed(s, "write pump closed")
+		s.conn.Close()
+	}()
+
+	for {
+		client := s.client.Load()
+
+		select {
+		case <-client.outbound.Ready():
+		case <-s.handedOver:
+			continue
+		case <-s.closed:
+			// Give the client one last chance to hear whatever we had to say, e.g. why it's being closed, unless a newer
+			// connection has taken over, in which case what's queued is for that one
+			if client.socket.Load() == s {
+				s.conn.SetWriteDeadline(time.Now().Add(closeFlushTimeout))
+				s.writePending(client)
+			}
+			return
+		}
+
+		if err := s.writePending(client); err != nil {
+			client.logger.Printf("error getting writer, closing client: %v", err)
+			return
+		}
+	}
+}
+
+// Writes everything waiting in the client's outbound queue to the connection, in order
+func (s *socket) writePending(client *WebSocketClient) error {
+	pending := client.outbound.Drain()
+	s.conn.EnableWriteCompression(client.compression.Load())
+
+	if !client.batching.Load() {
+		for _, packet := range pending {
+			if err := s.writePacket(client, packet); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for len(pending) > 0 {
+		n := min(len(pending), maxBatchSize)
+		packet := pending[0]
+		if n > 1 {
+			packet = &packets.Packet{SenderId: client.Id(), Msg: packets.NewPacketBatch(pending[:n])}
+		}
+		if err := s.writePacket(client, packet); err != nil {
+			return err
+		}
+		pending = pending[n:]
+	}
+	return nil
+}
+
+// Writes a single packet to the connection in its own frame. Only returns an error if the connection is unusable;
+// anything wrong with the packet itself is logged and the packet is dropped.
+func (s *socket) writePacket(client *WebSocketClient, packet *packets.Packet) error {
+	writer, err := s.conn.NextWriter(websocket.BinaryMessage)
+	if err != nil {
+		return err
+	}
+
+	data, err := proto.Marshal(packet)
+	if err != nil {
+		client.logger.Printf("error marshalling %T packet, dropping: %v", packet.Msg, err)
+		writer.Close()
+		return nil
+	}
+
+	if _, writeErr := writer.Write(data); writeErr != nil {
+		client.logger.Printf("error writing %T packet: %v", packet.Msg, writeErr)
+		writer.Close()
+		return nil
+	}
+
+	writer.Write([]byte{'\n'})
+
+	if closeErr := writer.Close(); closeErr != nil {
+		client.logger.Printf("error closing writer, dropping %T packet: %v", packet.Msg, closeErr)
+	}
+	return nil
+}
@@ -3,25 +3,52 @@ package conn
 import (
 	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/gorilla/websocket"
-	"github.com/jackc/pgx/v5"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/states"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/ds"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
-	"google.golang.org/protobuf/proto"
 )
 
+// How many packets of each priority can be waiting to be processed before we start dropping them
+const processingChanCapacity = 64
+
+// The least amount of time between telling the client to slow down, so we don't add to the flood ourselves
+const backpressureSignalInterval = 1 * time.Second
+
+// How many packets can be waiting to be sent before we give up on the client ever catching up
+const maxOutboundBacklog = 4096
+
+// The most packets to bundle into a single frame when batching is enabled
+const maxBatchSize = 64
+
+// How long to spend sending whatever is still queued up when the connection is closed, e.g. the reason it was kicked
+const closeFlushTimeout = 1 * time.Second
+
 type WebSocketClient struct {
-	id                       uint32
-	conn                     *websocket.Conn
-	hub                      *central.Hub
-	sendChan                 chan *packets.Packet // Packets to send to the client i.e. WS connection
-	packetsForProcessingChan chan *packets.Packet // Packets to send to the hub
-	dbTx                     *central.DbTx
-	state                    central.ClientStateHandler
-	logger                   *log.Logger
+	id                        atomic.Uint32          // Written by the owning level but read from the pumps and other levels
+	socket                    atomic.Pointer[socket] // The connection we're currently attached to, or nil if it dropped
+	hub                       *central.Hub
+	remoteIp                  string
+	outbound                  *ds.Mailbox[*packets.Packet] // Packets to send to the client i.e. WS connection, in order
+	batching                  atomic.Bool
+	compression               atomic.Bool
+	protocolVersion           uint32
+	capabilities              []string
+	packetsForProcessingChans [packets.NumPriorities]chan *packets.Packet // Packets to send to the hub, by priority
+	closeOnce                 sync.Once
+	sessionToken              string
+	detachments               int // How many times our connection has dropped, to tell whether a session has expired
+	dbTx                      *central.DbTx
+	state                     central.ClientStateHandler
+	logger                    *log.Logger
 }
 
 func NewWebSocketClient(hub *central.Hub, writer http.ResponseWriter, request *http.Request) (central.ClientInterfacer, error) {
@@ -29,6 +56,10 @@ func NewWebSocketClient(hub *central.Hub, writer http.ResponseWriter, request *h
 		ReadBufferSize:  1024,
 		WriteBufferSize: 1024,
 		CheckOrigin:     func(_ *http.Request) bool { return true },
+
+		// Only takes effect if the client offers it too, and even then frames are only compressed once the client
+		// asks for the compression capability
+		EnableCompression: true,
 	}
 
 	conn, err := upgrader.Upgrade(writer, request, nil)
@@ -38,135 +69,243 @@ func NewWebSocketClient(hub *central.Hub, writer http.ResponseWriter, request *h
 	}
 
 	c := &WebSocketClient{
-		hub:                      hub,
-		conn:                     conn,
-		sendChan:                 make(chan *packets.Packet, 256),
-		packetsForProcessingChan: make(chan *packets.Packet, 64),
-		dbTx:                     hub.NewDbTx(),
-		logger:                   log.New(log.Writer(), "Client unknown: ", log.LstdFlags),
+		hub:             hub,
+		remoteIp:        remoteIp(request),
+		outbound:        ds.NewMailbox[*packets.Packet](),
+		protocolVersion: packets.LegacyProtocolVersion,
+		dbTx:            hub.NewDbTx(),
+		logger:          log.New(log.Writer(), "Client unknown: ", log.LstdFlags),
 	}
 
+	for i := range c.packetsForProcessingChans {
+		c.packetsForProcessingChans[i] = make(chan *packets.Packet, processingChanCapacity)
+	}
+
+	c.socket.Store(newSocket(conn, c))
+
 	return c, nil
 }
 
 func (c *WebSocketClient) Id() uint32 {
-	return c.id
+	return c.id.Load()
 }
 
-func (c *WebSocketClient) PacketsForProcessingChan() chan *packets.Packet {
-	return c.packetsForProcessingChan
+func (c *WebSocketClient) PacketsForProcessingChan(priority packets.Priority) chan *packets.Packet {
+	return c.packetsForProcessingChans[priority]
+}
+
+func (c *WebSocketClient) RemoteIp() string {
+	return c.remoteIp
 }
 
 func (c *WebSocketClient) Initialize(id uint32) {
-	c.id = id
-	c.logger.SetPrefix(fmt.Sprintf("Client %d: ", c.id))
+	c.id.Store(id)
+	c.logger.SetPrefix(fmt.Sprintf("Client %d: ", id))
 	c.SetState(&states.Connected{})
 }
 
 func (c *WebSocketClient) ProcessMessage(senderId uint32, message packets.Msg) {
+	// We might have left the game but not been unregistered yet
+	if c.state == nil {
+		return
+	}
 	c.state.HandleMessage(senderId, message)
 }
 
 func (c *WebSocketClient) SocketSend(message packets.Msg) {
-	c.SocketSendAs(message, c.id)
+	c.SocketSendAs(message, c.Id())
 }
 
 func (c *WebSocketClient) SocketSendAs(message packets.Msg, senderId uint32) {
-	select {
-	case c.sendChan <- &packets.Packet{SenderId: senderId, Msg: message}:
-	default:
-		c.logger.Printf("Client %d send channel full, dropping message: %T", c.id, message)
+	c.SocketSendBatch(&packets.Packet{SenderId: senderId, Msg: message})
+}
+
+func (c *WebSocketClient) SocketSendBatch(batch ...*packets.Packet) {
+	if c.outbound.Len() >= maxOutboundBacklog {
+		c.logger.Printf("Client %d has %d packets waiting to be sent, dropping %d more", c.Id(), c.outbound.Len(), len(batch))
+		central.SlowClientsDisconnected.Add(1)
+		c.Close("too far behind on outbound packets")
+		return
 	}
+	c.outbound.Push(batch...)
+}
+
+func (c *WebSocketClient) SetBatching(enabled bool) {
+	c.batching.Store(enabled)
+}
+
+func (c *WebSocketClient) SetCompression(enabled bool) {
+	c.compression.Store(enabled)
+}
+
+func (c *WebSocketClient) SetProtocol(version uint32, capabilities []string) {
+	c.protocolVersion = version
+	c.capabilities = capabilities
+}
+
+func (c *WebSocketClient) ProtocolVersion() uint32 {
+	return c.protocolVersion
+}
+
+func (c *WebSocketClient) HasCapability(capability string) bool {
+	return slices.Contains(c.capabilities, capability)
 }
 
 func (c *WebSocketClient) PassToPeer(message packets.Msg, peerId uint32) {
-	if peer, exists := c.hub.Clients.Get(peerId); exists {
-		peer.ProcessMessage(c.id, message)
-	}
+	c.hub.PassToPeer(c.Id(), message, peerId)
+}
+
+func (c *WebSocketClient) EnterLevel(levelId int32, onArrival func()) {
+	c.hub.EnterLevel(c, levelId, onArrival)
+}
+
+func (c *WebSocketClient) ExitLevel(onArrival func()) {
+	c.hub.ExitLevel(c, onArrival)
 }
 
 func (c *WebSocketClient) Broadcast(message packets.Msg, to ...[]uint32) {
-	c.hub.Broadcast(c.id, message, to...)
+	c.hub.Broadcast(c.Id(), message, to...)
+}
+
+func (c *WebSocketClient) Post(job func()) {
+	c.hub.PostToClient(c, job)
+}
+
+func (c *WebSocketClient) PostToPeer(peerId uint32, job func()) bool {
+	return c.hub.PostToPeer(peerId, job)
+}
+
+func (c *WebSocketClient) PostToLevel(levelId int32, delay time.Duration, job func(level *central.Level)) {
+	c.hub.PostToLevel(levelId, delay, job)
+}
+
+func (c *WebSocketClient) PostToEveryLevel(job func(level *central.Level), done func()) {
+	c.hub.PostToEveryLevel(job, done)
+}
+
+func (c *WebSocketClient) Level() *central.Level {
+	return c.hub.LevelOf(c)
 }
 
 func (c *WebSocketClient) ReadPump() {
-	defer func() {
-		c.logger.Println("Closing read pump")
-		c.Close("read pump closed")
-	}()
-
-	for {
-		_, data, err := c.conn.ReadMessage()
-		if err != nil {
-			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-				c.logger.Printf("unexpected closure: %v", err)
-			} else {
-				c.logger.Printf("normal disconnection: %v", err)
-			}
-			break
-		}
+	c.socket.Load().readPump()
+}
 
-		packet := &packets.Packet{}
-		err = proto.Unmarshal(data, packet)
-		if err != nil {
-			c.logger.Printf("error unmarshalling data: %v", err)
-			continue
-		}
+func (c *WebSocketClient) WritePump() {
+	c.socket.Load().writePump()
+}
 
-		// To allow the client to lazily not set the sender ID, we'll assume they want to send it as themselves
-		if packet.SenderId == 0 {
-			packet.SenderId = c.id
-		}
+func (c *WebSocketClient) StartSession() string {
+	c.EndSession()
+	c.sessionToken = c.hub.CreateSession(c)
+	return c.sessionToken
+}
 
-		// Try putting this out to the hub for processing, but if the channel is full, just drop it
-		select {
-		case c.packetsForProcessingChan <- packet:
-		default:
-			c.logger.Printf("Client %d processing channel full, dropping message: %T", c.id, packet.Msg)
-		}
+func (c *WebSocketClient) EndSession() {
+	if c.sessionToken != "" {
+		c.hub.EndSession(c.sessionToken)
+		c.sessionToken = ""
 	}
 }
 
-func (c *WebSocketClient) WritePump() {
-	defer func() {
-		c.logger.Println("Closing write pump")
-		c.Close("write pump closed")
-	}()
-
-	for packet := range c.sendChan {
-		writer, err := c.conn.NextWriter(websocket.BinaryMessage)
-		if err != nil {
-			c.logger.Printf("error getting writer for %T packet, closing client: %v", packet.Msg, err)
-			return
-		}
+func (c *WebSocketClient) ResumeSession(token string) bool {
+	claimed, exists := c.hub.ClaimSession(token)
+	if !exists {
+		return false
+	}
 
-		data, err := proto.Marshal(packet)
-		if err != nil {
-			c.logger.Printf("error marshalling %T packet, dropping: %v", packet.Msg, err)
-			continue
-		}
+	old, ok := claimed.(*WebSocketClient)
+	if !ok || old == c {
+		return false
+	}
 
-		_, writeErr := writer.Write(data)
+	s := c.socket.Swap(nil)
+	if s == nil {
+		return false
+	}
 
-		if writeErr != nil {
-			c.logger.Printf("error writing %T packet: %v", packet.Msg, writeErr)
-			continue
-		}
+	// Claim the old client straight away so its session can't expire in the meantime. Any connection it still had
+	// (e.g. one we haven't noticed is dead yet) is done for.
+	if prev := old.socket.Swap(s); prev != nil {
+		prev.close()
+	}
 
-		writer.Write([]byte{'\n'})
+	// The old client takes over the connection on its own goroutine
+	version, capabilities := c.protocolVersion, c.capabilities
+	old.Post(func() {
+		old.attach(s, version, capabilities)
+	})
 
-		if closeErr := writer.Close(); closeErr != nil {
-			c.logger.Printf("error closing writer, dropping %T packet: %v", packet.Msg, closeErr)
-			continue
-		}
+	// We have nothing left to do, so quietly make way without closing the connection
+	c.closeOnce.Do(func() {})
+	c.SetState(nil)
+	c.hub.UnregisterChan <- c
+	return true
+}
+
+// Picks the game back up on a connection handed over from a new client. Must be run on our own goroutine.
+func (c *WebSocketClient) attach(s *socket, version uint32, capabilities []string) {
+	if c.socket.Load() != s {
+		// Another connection got in first
+		s.close()
+		return
+	}
+
+	c.detachments++
+
+	// Whatever piled up while we were away is stale, since we're about to resync
+	c.outbound.Drain()
+
+	c.SetProtocol(version, capabilities)
+	c.SetBatching(c.HasCapability(packets.CapabilityBatching))
+	c.SetCompression(c.HasCapability(packets.CapabilityCompression))
+
+	s.handOver(c)
+	c.logger.Println("Resumed session on a new connection")
+	c.ProcessMessage(c.Id(), packets.NewResumeSession(""))
+
+	// The connection might have dropped before it got to us
+	if s.isClosed() {
+		c.socketClosed(s, "connection dropped while resuming")
 	}
 }
 
+// Called by a socket's pumps when its connection drops. If the player is in the middle of a session, they stay in the
+// game for the grace period in case they reconnect. Safe to call from any goroutine.
+func (c *WebSocketClient) socketClosed(s *socket, reason string) {
+	s.close()
+
+	c.Post(func() {
+		// A newer connection might have taken over already
+		if c.socket.Load() != s {
+			return
+		}
+
+		if c.sessionToken == "" {
+			c.Close(reason)
+			return
+		}
+
+		c.socket.Store(nil)
+		c.detachments++
+		detachment := c.detachments
+		c.logger.Printf("Lost connection (%s), holding session for %v", reason, c.hub.SessionGracePeriod)
+
+		time.AfterFunc(c.hub.SessionGracePeriod, func() {
+			c.Post(func() {
+				if c.socket.Load() == nil && c.detachments == detachment {
+					c.Close("session expired")
+				}
+			})
+		})
+	})
+}
+
 func (c *WebSocketClient) DbTx() *central.DbTx {
 	return c.dbTx
 }
 
-func (c *WebSocketClient) RunSql(sql string) (pgx.Rows, error) {
+func (c *WebSocketClient) RunSql(sql string) (*central.SqlResult, error) {
 	return c.hub.RunSql(sql)
 }
 
@@ -186,6 +325,30 @@ func (c *WebSocketClient) LevelPointMaps() *central.LevelPointMaps {
 	return c.hub.LevelPointMaps
 }
 
+func (c *WebSocketClient) AuthLimits() *central.AuthLimits {
+	return c.hub.AuthLimits
+}
+
+func (c *WebSocketClient) AdminSessions() *ds.SharedCollection[int32] {
+	return c.hub.AdminSessions
+}
+
+func (c *WebSocketClient) Channels() *central.Channels {
+	return c.hub.Channels
+}
+
+func (c *WebSocketClient) Parties() *central.Parties {
+	return c.hub.Parties
+}
+
+func (c *WebSocketClient) ChatArchive() *central.ChatArchive {
+	return c.hub.ChatArchive
+}
+
+func (c *WebSocketClient) WorldClock() *central.WorldClock {
+	return c.hub.WorldClock
+}
+
 func (c *WebSocketClient) SetState(state central.ClientStateHandler) {
 	prevStateName := "None"
 	if c.state != nil {
@@ -208,14 +371,29 @@ func (c *WebSocketClient) SetState(state central.ClientStateHandler) {
 	}
 }
 
+// Safe to call from any goroutine, any number of times
 func (c *WebSocketClient) Close(reason string) {
-	c.logger.Printf("Closing client connection because: %s", reason)
-
-	c.SetState(nil)
+	c.closeOnce.Do(func() {
+		c.logger.Printf("Closing client connection because: %s", reason)
+
+		// Leave the game on the goroutine that owns our state before the hub forgets about us
+		c.Post(func() {
+			c.EndSession()
+			c.SetState(nil)
+			c.hub.UnregisterChan <- c
+		})
+
+		if s := c.socket.Load(); s != nil {
+			s.close()
+		}
+	})
+}
 
-	c.hub.UnregisterChan <- c
-	c.conn.Close()
-	if _, closed := <-c.sendChan; !closed {
-		close(c.sendChan)
+// The address the request came from, without the port
+func remoteIp(request *http.Request) string {
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		return request.RemoteAddr
 	}
+	return host
 }
@@ -0,0 +1,62 @@
+package emotes
+
+import (
+	"sort"
+	"strings"
+)
+
+// What an actor is doing, so everyone who can see them knows too
+type Animation uint32
+
+const (
+	Idle     Animation = iota
+	Chopping           // 1
+	Mining             // 2
+	Sitting            // 3
+	Dancing            // 4
+)
+
+var AnimationNames = map[Animation]string{
+	Idle:     "idle",
+	Chopping: "chopping",
+	Mining:   "mining",
+	Sitting:  "sitting",
+	Dancing:  "dancing",
+}
+
+// Whether the animation comes from an emote, rather than something the actor's busy with like harvesting
+func (a Animation) IsEmote() bool {
+	return a == Sitting || a == Dancing
+}
+
+type Emote struct {
+	Name string
+	Verb string // E.g. "waves", for clients that can't show emotes
+
+	// What the actor keeps doing after the emote until they move, or Idle if it's over straight away
+	Animation Animation
+}
+
+var emotes = map[string]*Emote{
+	"wave":  {Name: "wave", Verb: "waves"},
+	"bow":   {Name: "bow", Verb: "bows"},
+	"cheer": {Name: "cheer", Verb: "cheers"},
+	"laugh": {Name: "laugh", Verb: "laughs"},
+	"sit":   {Name: "sit", Verb: "sits down", Animation: Sitting},
+	"dance": {Name: "dance", Verb: "dances", Animation: Dancing},
+}
+
+func Get(name string) (*Emote, bool) {
+	emote, exists := emotes[strings.ToLower(strings.TrimSpace(name))]
+	return emote, exists
+}
+
+// The names of all the emotes, in alphabetical order
+func Names() []string {
+	names := make([]string, 0, len(emotes))
+	for name := range emotes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
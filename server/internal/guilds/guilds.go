@@ -0,0 +1,56 @@
+package guilds
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Something a guild member can be allowed to do
+type Permission string
+
+const (
+	Invite   Permission = "invite"    // Invite characters to join the guild
+	Kick     Permission = "kick"      // Remove members of a lower rank
+	SetRanks Permission = "set_ranks" // Promote and demote members, including handing over leadership
+	Withdraw Permission = "withdraw"  // Take items out of the guild bank
+	Deposit  Permission = "deposit"   // Put items in the guild bank
+)
+
+// A member's standing in their guild, as stored in the guild_members table
+type Rank string
+
+const (
+	Recruit Rank = "recruit"
+	Member  Rank = "member"
+	Officer Rank = "officer"
+	Leader  Rank = "leader"
+)
+
+// Lowest to highest
+var ranks = []Rank{Recruit, Member, Officer, Leader}
+
+// What each rank is allowed to do
+var permissions = map[Rank][]Permission{
+	Recruit: {Deposit},
+	Member:  {Deposit, Withdraw},
+	Officer: {Deposit, Withdraw, Invite, Kick},
+	Leader:  {Deposit, Withdraw, Invite, Kick, SetRanks},
+}
+
+// Checks the rank is one we know about
+func Validate(rank string) error {
+	if !slices.Contains(ranks, Rank(rank)) {
+		return fmt.Errorf("unknown rank %s", rank)
+	}
+	return nil
+}
+
+// Whether the rank grants the permission. Unknown ranks grant nothing.
+func Has(rank string, permission Permission) bool {
+	return slices.Contains(permissions[Rank(rank)], permission)
+}
+
+// Whether the first rank is higher than the second
+func Outranks(rank string, other string) bool {
+	return slices.Index(ranks, Rank(rank)) > slices.Index(ranks, Rank(other))
+}
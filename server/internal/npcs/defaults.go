@@ -14,6 +14,7 @@ const (
 	mudKey
 	dezzickKey
 	oldManKey
+	tillyKey
 )
 
 var rickertQuest = quests.NewQuest(
@@ -36,6 +37,8 @@ var rickertQuest = quests.NewQuest(
 var gusQuest = quests.NewFakeQuest([]string{"Woof!"})
 var oscarQuest = quests.NewFakeQuest([]string{"It's looking grim for me, friend. I was ambushed by bandits and left for dead."})
 
+var tillyDialogue = quests.NewFakeQuest([]string{"Fancy a new look? Stand still a moment and I'll see what I can do."})
+
 var mudShop = ds.NewInventoryWithItems([]*ds.InventoryRow{
 	ds.NewInventoryRow(*items.Logs, 100),
 	ds.NewInventoryRow(*items.BronzeHatchet, 10),
@@ -61,6 +64,7 @@ var Defaults = map[int]Npc{
 	mudKey:     NewNpcShopkeeper(mudKey, 1, objs.NewActor(1, 3, 13, "Mud", 96, 0, 0), mudShop, true),
 	dezzickKey: NewNpcShopkeeper(dezzickKey, 2, objs.NewActor(2, 2, 4, "Dezzick", 32, 0, 0), dezzickShop, true),
 	oldManKey:  NewNpcShopkeeper(oldManKey, 1, objs.NewActor(1, 34, 10, "Old man", 72, 0, 0), oldManShop, true),
+	tillyKey:   NewNpcWardrobe(tillyKey, 1, objs.NewActor(1, 17, 9, "Tilly", 56, 0, 0), tillyDialogue, false),
 }
 
 var Rickert = Defaults[rickertKey]
@@ -69,3 +73,4 @@ var Oscar = Defaults[oscarKey]
 var Mud = Defaults[mudKey]
 var Dezzick = Defaults[dezzickKey]
 var OldMan = Defaults[oldManKey]
+var Tilly = Defaults[tillyKey]
@@ -14,6 +14,9 @@ type Npc struct {
 	Quest   *quests.Quest
 	Shop    *ds.Inventory
 	Moves   bool
+
+	// Players standing next to a wardrobe can change how they look
+	Wardrobe bool
 }
 
 func NewNpcQuestGiver(id int, levelId int32, actor *objs.Actor, quest *quests.Quest, moves bool) Npc {
@@ -41,3 +44,20 @@ func NewNpcShopkeeper(id int, levelId int32, actor *objs.Actor, shop *ds.Invento
 		Moves:   moves,
 	}
 }
+
+// A wardrobe is an NPC who talks like a quest giver, but lets players change how they look instead of giving a quest
+func NewNpcWardrobe(id int, levelId int32, actor *objs.Actor, dialogue *quests.Quest, moves bool) Npc {
+	npc := NewNpcQuestGiver(id, levelId, actor, dialogue, moves)
+	npc.Wardrobe = true
+	return npc
+}
+
+// Whether the actor belongs to a wardrobe NPC
+func IsWardrobe(actor *objs.Actor) bool {
+	for _, npc := range Defaults {
+		if npc.Wardrobe && npc.Actor == actor {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,8 @@
 package objs
 
 import (
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/appearance"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/emotes"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/props"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/skills"
 )
@@ -10,10 +12,40 @@ type Actor struct {
 	X, Y                         int32
 	Name                         string
 	SpriteRegionX, SpriteRegionY int32
+	Appearance                   appearance.Appearance
 	SkillsXp                     map[skills.Skill]uint32
 	DbId                         int32
 	IsNpc                        bool
 	IsVip                        bool
+	GuildTag                     string
+	Animation                    emotes.Animation
+	Facing                       Direction
+	Tick                         uint64 // The server tick when the actor last changed
+}
+
+// Which way an actor is looking
+type Direction uint32
+
+const (
+	FacingDown  Direction = iota // The way actors face when they first appear
+	FacingUp                     // 1
+	FacingLeft                   // 2
+	FacingRight                  // 3
+)
+
+// Turns the actor to face the way they're moving by (dx, dy). Diagonal moves face sideways, and not moving at all
+// leaves them facing the way they were.
+func (a *Actor) Face(dx, dy int32) {
+	switch {
+	case dx < 0:
+		a.Facing = FacingLeft
+	case dx > 0:
+		a.Facing = FacingRight
+	case dy < 0:
+		a.Facing = FacingUp
+	case dy > 0:
+		a.Facing = FacingDown
+	}
 }
 
 func NewActor(levelId int32, x, y int32, name string, spriteRegionX int32, spriteRegionY int32, dbId int32) *Actor {
@@ -0,0 +1,72 @@
+package roles
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Something a user can be allowed to do beyond playing the game
+type Permission string
+
+const (
+	Moderate       Permission = "moderate"        // Kick, ban and mute players
+	ResetPasswords Permission = "reset_passwords" // Give players a temporary password
+	UploadLevels   Permission = "upload_levels"   // Replace a level and everything in it
+	Teleport       Permission = "teleport"        // Move between levels at will
+	EditContent    Permission = "edit_content"    // Change what players have, e.g. their items or XP
+	RunSql         Permission = "run_sql"         // Query the database directly
+	ManageRoles    Permission = "manage_roles"    // Grant and revoke roles
+)
+
+// A named set of permissions, as stored in the user_roles table
+type Role string
+
+const (
+	Moderator     Role = "moderator"
+	LevelDesigner Role = "level_designer"
+	ContentEditor Role = "content_editor"
+	Superadmin    Role = "superadmin"
+)
+
+// What each role is allowed to do
+var permissions = map[Role][]Permission{
+	Moderator:     {Moderate, ResetPasswords, Teleport},
+	LevelDesigner: {UploadLevels, Teleport},
+	ContentEditor: {EditContent, Teleport},
+	Superadmin:    {Moderate, ResetPasswords, UploadLevels, Teleport, EditContent, RunSql, ManageRoles},
+}
+
+// Checks the role is one we know about, so typos don't get granted
+func Validate(role string) error {
+	if _, exists := permissions[Role(role)]; !exists {
+		return fmt.Errorf("unknown role %s", role)
+	}
+	return nil
+}
+
+// Whether any of the roles grants the permission. Unknown roles grant nothing.
+func Has(roles []string, permission Permission) bool {
+	for _, role := range roles {
+		if slices.Contains(permissions[Role(role)], permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether someone with the actor's roles may do things to someone with the target's roles, e.g. ban them or reset
+// their password. Nobody may act on someone who can do anything they can't, and only those who can manage roles may
+// act on anyone with a role at all.
+func CanActOn(actorRoles, targetRoles []string) bool {
+	if len(targetRoles) > 0 && !Has(actorRoles, ManageRoles) {
+		return false
+	}
+	for _, role := range targetRoles {
+		for _, permission := range permissions[Role(role)] {
+			if !Has(actorRoles, permission) {
+				return false
+			}
+		}
+	}
+	return true
+}
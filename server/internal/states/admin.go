@@ -2,8 +2,10 @@ package states
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -13,8 +15,11 @@ import (
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/levels"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/objs"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/props"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/roles"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/ds"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/password"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type LevelDataImporters struct {
@@ -27,7 +32,8 @@ type LevelDataImporters struct {
 
 type Admin struct {
 	client             central.ClientInterfacer
-	adminModel         *db.Admin
+	user               db.User
+	roles              []string
 	queries            *db.Queries
 	levelDataImporters *LevelDataImporters
 	logger             *log.Logger
@@ -102,66 +108,487 @@ func (a *Admin) SetClient(client central.ClientInterfacer) {
 }
 
 func (a *Admin) OnEnter() {
+	a.client.AdminSessions().Add(a.user.ID, a.client.Id())
 }
 
 func (a *Admin) HandleMessage(senderId uint32, message packets.Msg) {
 	switch message := message.(type) {
 	case *packets.Packet_SqlQuery:
-		a.handleSqlQuery(senderId, message)
+		if a.allowed(roles.RunSql) {
+			a.handleSqlQuery(senderId, message)
+		}
 	case *packets.Packet_LevelUpload:
-		a.handleLevelUpload(senderId, message)
+		if a.allowed(roles.UploadLevels) {
+			a.handleLevelUpload(senderId, message)
+		}
 	case *packets.Packet_Logout:
 		a.client.SetState(&Connected{})
 	case *packets.Packet_AdminJoinGameRequest:
 		a.handleAdminJoinGameRequest(senderId, message)
+	case *packets.Packet_AdminResetPassword:
+		if a.allowed(roles.ResetPasswords) {
+			a.handleAdminResetPassword(senderId, message)
+		}
+	case *packets.Packet_AdminKick:
+		if a.allowed(roles.Moderate) {
+			a.handleAdminKick(senderId, message)
+		}
+	case *packets.Packet_AdminBan:
+		if a.allowed(roles.Moderate) {
+			a.handleAdminBan(senderId, message)
+		}
+	case *packets.Packet_AdminUnban:
+		if a.allowed(roles.Moderate) {
+			a.handleAdminUnban(senderId, message)
+		}
+	case *packets.Packet_AdminMute:
+		if a.allowed(roles.Moderate) {
+			a.handleAdminMute(senderId, message)
+		}
+	case *packets.Packet_AdminUnmute:
+		if a.allowed(roles.Moderate) {
+			a.handleAdminUnmute(senderId, message)
+		}
+	case *packets.Packet_AdminGrantRole:
+		if a.allowed(roles.ManageRoles) {
+			a.handleAdminGrantRole(senderId, message)
+		}
+	case *packets.Packet_AdminRevokeRole:
+		if a.allowed(roles.ManageRoles) {
+			a.handleAdminRevokeRole(senderId, message)
+		}
+	case *packets.Packet_AdminLookupPlayer:
+		if a.allowed(roles.Moderate) {
+			a.handleAdminLookupPlayer(senderId, message)
+		}
+	case *packets.Packet_AdminEditInventory:
+		if a.allowed(roles.EditContent) {
+			a.handleAdminEditInventory(senderId, message)
+		}
+	case *packets.Packet_AdminGrantXp:
+		if a.allowed(roles.EditContent) {
+			a.handleAdminGrantXp(senderId, message)
+		}
+	case *packets.Packet_AdminTeleport:
+		if a.allowed(roles.Teleport) {
+			a.handleAdminTeleport(senderId, message)
+		}
+	case *packets.Packet_AdminListOnlinePlayers:
+		if a.allowed(roles.Moderate) {
+			a.handleAdminListOnlinePlayers(senderId, message)
+		}
+	case *packets.Packet_AdminListLevels:
+		a.handleAdminListLevels(senderId, message)
+	case *packets.Packet_AdminListReports:
+		if a.allowed(roles.Moderate) {
+			a.handleAdminListReports(senderId, message)
+		}
+	case *packets.Packet_AdminClaimReport:
+		if a.allowed(roles.Moderate) {
+			a.handleAdminClaimReport(senderId, message)
+		}
+	case *packets.Packet_AdminResolveReport:
+		if a.allowed(roles.Moderate) {
+			a.handleAdminResolveReport(senderId, message)
+		}
+	case *packets.Packet_AdminSearchChatLog:
+		if a.allowed(roles.Moderate) {
+			a.handleAdminSearchChatLog(senderId, message)
+		}
+	case *packets.Packet_AdminRoleResponse:
+		a.handleAdminRoleResponse(senderId, message)
 	}
 }
 
+// Whether our roles grant the permission, telling the client why not if they don't
+func (a *Admin) allowed(permission roles.Permission) bool {
+	if roles.Has(a.roles, permission) {
+		return true
+	}
+	a.logger.Printf("Refusing request that needs the %s permission, which %s's roles %v don't grant", permission, a.user.Username, a.roles)
+	a.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("You need the %s permission to do that", permission)))
+	return false
+}
+
 func (a *Admin) handleSqlQuery(senderId uint32, message *packets.Packet_SqlQuery) {
 	if senderId != a.client.Id() {
 		a.logger.Printf("Received request to run SQL query from another client (%d)", senderId)
 		return
 	}
 
-	rows, err := a.client.RunSql(message.SqlQuery.Query)
+	query := message.SqlQuery.Query
+	adminUserId := a.user.ID
+	a.logger.Printf("Received request to run SQL query: %s", query)
+
+	// The query could take a while, so run it away from the level's goroutine and come back with the result
+	go func() {
+		result, err := a.client.RunSql(query)
+
+		details := query
+		if err != nil {
+			details += fmt.Sprintf(" (failed: %v)", err)
+		}
+		writeAuditLog(a.queries, a.logger, adminUserId, "sql", "", details)
+
+		a.client.Post(func() {
+			if err != nil {
+				a.logger.Printf("Error running SQL query: %v", err)
+				a.client.SocketSend(packets.NewSqlResponse(false, err, nil, nil, false))
+				return
+			}
+
+			rowMessages := make([]*packets.SqlRow, len(result.Rows))
+			for i, row := range result.Rows {
+				rowMessages[i] = &packets.SqlRow{Values: row}
+			}
+			a.client.SocketSend(packets.NewSqlResponse(true, nil, result.Columns, rowMessages, result.Truncated))
+		})
+	}()
+}
+
+func (a *Admin) handleAdminResetPassword(senderId uint32, message *packets.Packet_AdminResetPassword) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to reset a password from another client (%d)", senderId)
+		return
+	}
+
+	username := strings.ToLower(message.AdminResetPassword.Username)
+	a.logger.Printf("Received request to reset password for %s", username)
+
+	// Hashing the password is slow, so do it away from the level's goroutine and come back with the result
+	adminUserId, adminRoles := a.user.ID, a.roles
+	go func() {
+		temporaryPassword, err := a.resetPassword(adminRoles, username)
+		if err == nil {
+			writeAuditLog(a.queries, a.logger, adminUserId, "reset_password", username, "")
+		}
+		a.client.Post(func() {
+			if err != nil {
+				a.client.SocketSend(packets.NewAdminResetPasswordResponse(false, "", err))
+				return
+			}
+			a.client.SocketSend(packets.NewAdminResetPasswordResponse(true, temporaryPassword, nil))
+		})
+	}()
+}
+
+// Sets the user's password to a temporary one they'll have to change before they can log in, and returns it. Only
+// works on users whose roles don't outrank the admin's.
+func (a *Admin) resetPassword(adminRoles []string, username string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	user, err := a.queries.GetUserByUsername(ctx, username)
+	if err != nil {
+		a.logger.Printf("Failed to get user %s: %v", username, err)
+		return "", fmt.Errorf("failed to get user %s: %v", username, err)
+	}
+	if err := checkCanActOn(ctx, a.queries, adminRoles, user); err != nil {
+		a.logger.Printf("Refusing to reset password for %s: %v", username, err)
+		return "", err
+	}
+
+	temporaryPassword := password.Generate(12)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(temporaryPassword), bcrypt.DefaultCost)
 	if err != nil {
-		a.logger.Printf("Error running SQL query: %v", err)
-		a.client.SocketSend(packets.NewSqlResponse(false, err, nil, nil))
+		a.logger.Printf("Failed to hash temporary password for %s: %v", username, err)
+		return "", fmt.Errorf("failed to hash temporary password: %v", err)
+	}
+
+	err = a.queries.UpdateUserPassword(ctx, db.UpdateUserPasswordParams{
+		ID:                 user.ID,
+		PasswordHash:       string(passwordHash),
+		MustChangePassword: true,
+	})
+	if err != nil {
+		a.logger.Printf("Failed to update password for %s: %v", username, err)
+		return "", fmt.Errorf("failed to update password: %v", err)
+	}
+
+	a.logger.Printf("Reset password for %s", username)
+	return temporaryPassword, nil
+}
+
+func (a *Admin) handleAdminKick(senderId uint32, message *packets.Packet_AdminKick) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to kick a player from another client (%d)", senderId)
 		return
 	}
 
-	columns := rows.FieldDescriptions()
-	columnNames := make([]string, len(columns))
-	for i, column := range columns {
-		columnNames[i] = column.Name
+	a.moderate("kick", message.AdminKick.Username, kickUser(message.AdminKick.Reason))
+}
+
+func (a *Admin) handleAdminBan(senderId uint32, message *packets.Packet_AdminBan) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to ban a player from another client (%d)", senderId)
+		return
+	}
+
+	a.moderate("ban", message.AdminBan.Username, a.banUser(message.AdminBan.Reason, message.AdminBan.DurationMinutes))
+}
+
+func (a *Admin) handleAdminUnban(senderId uint32, message *packets.Packet_AdminUnban) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to unban a player from another client (%d)", senderId)
+		return
 	}
 
-	rowMessages := make([]*packets.SqlRow, 0)
-	for rows.Next() {
-		row := make([]interface{}, len(columns))
-		for i := range row {
-			row[i] = new(interface{})
+	a.moderate("unban", message.AdminUnban.Username, moderation{apply: func(ctx context.Context, user db.User) (packets.Msg, string, error) {
+		lifted, err := a.queries.LiftBans(ctx, user.ID)
+		if err != nil {
+			return nil, "", err
+		}
+		if lifted == 0 {
+			return nil, "", fmt.Errorf("%s is not banned", user.Username)
 		}
+		return nil, "", nil
+	}})
+}
+
+func (a *Admin) handleAdminMute(senderId uint32, message *packets.Packet_AdminMute) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to mute a player from another client (%d)", senderId)
+		return
+	}
+
+	a.moderate("mute", message.AdminMute.Username, a.muteUser(message.AdminMute.Reason, message.AdminMute.DurationMinutes))
+}
+
+func (a *Admin) handleAdminUnmute(senderId uint32, message *packets.Packet_AdminUnmute) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to unmute a player from another client (%d)", senderId)
+		return
+	}
 
-		err = rows.Scan(row...)
+	a.moderate("unmute", message.AdminUnmute.Username, moderation{apply: func(ctx context.Context, user db.User) (packets.Msg, string, error) {
+		lifted, err := a.queries.LiftMutes(ctx, user.ID)
 		if err != nil {
-			a.logger.Printf("Error scanning row: %v", err)
-			a.client.SocketSend(packets.NewSqlResponse(false, err, nil, nil))
-			return
+			return nil, "", err
 		}
+		if lifted == 0 {
+			return nil, "", fmt.Errorf("%s is not muted", user.Username)
+		}
+		return packets.NewModerationNotice("unmute", "", time.Time{}), "", nil
+	}})
+}
 
-		rowMessage := &packets.SqlRow{
-			Values: make([]string, len(columns)),
+// A moderation action to apply to a user. Apply returns the notice to pass on to the user (if any) and the details to
+// log.
+type moderation struct {
+	requireInGame bool
+	apply         func(context.Context, db.User) (packets.Msg, string, error)
+}
+
+func kickUser(reason string) moderation {
+	return moderation{requireInGame: true, apply: func(_ context.Context, _ db.User) (packets.Msg, string, error) {
+		return packets.NewModerationNotice("kick", reason, time.Time{}), reason, nil
+	}}
+}
+
+func (a *Admin) banUser(reason string, durationMinutes uint32) moderation {
+	expiresAt := expiryFromMinutes(durationMinutes)
+	adminUserId := a.user.ID
+	return moderation{apply: func(ctx context.Context, user db.User) (packets.Msg, string, error) {
+		ban, err := a.queries.CreateBan(ctx, db.CreateBanParams{
+			UserID:          user.ID,
+			Reason:          reason,
+			ExpiresAt:       expiresAt,
+			CreatedByUserID: pgtype.Int4{Int32: adminUserId, Valid: true},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		r := newRestriction(ban.Reason, ban.ExpiresAt)
+		return packets.NewModerationNotice("ban", r.reason, r.expiresAt), r.describe(), nil
+	}}
+}
+
+func (a *Admin) muteUser(reason string, durationMinutes uint32) moderation {
+	expiresAt := expiryFromMinutes(durationMinutes)
+	adminUserId := a.user.ID
+	return moderation{apply: func(ctx context.Context, user db.User) (packets.Msg, string, error) {
+		mute, err := a.queries.CreateMute(ctx, db.CreateMuteParams{
+			UserID:          user.ID,
+			Reason:          reason,
+			ExpiresAt:       expiresAt,
+			CreatedByUserID: pgtype.Int4{Int32: adminUserId, Valid: true},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		r := newRestriction(mute.Reason, mute.ExpiresAt)
+		return packets.NewModerationNotice("mute", r.reason, r.expiresAt), r.describe(), nil
+	}}
+}
+
+// Applies a moderation action to a user away from the level's goroutine, then comes back to tell the admin how it went
+func (a *Admin) moderate(action, username string, m moderation) {
+	username = strings.ToLower(username)
+	a.logger.Printf("Received request to %s %s", action, username)
+	adminUserId, adminRoles := a.user.ID, a.roles
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		err := a.applyModeration(ctx, adminUserId, adminRoles, action, username, m, pgtype.Int4{})
+		if err != nil {
+			a.logger.Printf("Failed to %s %s: %v", action, username, err)
+		}
+		a.client.Post(func() {
+			a.client.SocketSend(packets.NewAdminModerationResponse(err == nil, action, username, err))
+		})
+	}()
+}
+
+// Applies a moderation action to a user whose roles don't outrank the admin's, lets them know if they're in the game,
+// and writes it to the audit log along with the report it was taken over, if any. Must be called away from the level's
+// goroutine.
+func (a *Admin) applyModeration(ctx context.Context, adminUserId int32, adminRoles []string, action, username string, m moderation, reportId pgtype.Int4) error {
+	user, err := a.queries.GetUserByUsername(ctx, username)
+	if err != nil {
+		return fmt.Errorf("no such user %s", username)
+	}
+	if err := checkCanActOn(ctx, a.queries, adminRoles, user); err != nil {
+		return err
+	}
+
+	peerId, inGame, err := findUserInGame(a.client, a.queries, user.ID)
+	if err != nil {
+		return fmt.Errorf("failed to look up %s's characters", username)
+	}
+	if m.requireInGame && !inGame {
+		return fmt.Errorf("%s is not in the game", username)
+	}
+
+	notice, details, err := m.apply(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	a.client.Post(func() {
+		if notice != nil && inGame {
+			a.client.PassToPeer(notice, peerId)
 		}
+		a.logger.Printf("Did %s of %s: %s", action, username, details)
+	})
+	writeReportAuditLog(a.queries, a.logger, adminUserId, action, username, details, reportId)
+	return nil
+}
 
-		for i := range row {
-			rowMessage.Values[i] = fmt.Sprintf("%v", *row[i].(*interface{}))
+func (a *Admin) handleAdminGrantRole(senderId uint32, message *packets.Packet_AdminGrantRole) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to grant a role from another client (%d)", senderId)
+		return
+	}
+
+	role := message.AdminGrantRole.Role
+	a.changeRole("grant_role", message.AdminGrantRole.Username, role, func(ctx context.Context, user db.User) error {
+		if err := roles.Validate(role); err != nil {
+			return err
 		}
+		granted, err := a.queries.GrantRole(ctx, db.GrantRoleParams{
+			UserID:          user.ID,
+			Role:            role,
+			GrantedByUserID: pgtype.Int4{Int32: a.user.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		if granted == 0 {
+			return fmt.Errorf("%s already has the %s role", user.Username, role)
+		}
+		return nil
+	})
+}
 
-		rowMessages = append(rowMessages, rowMessage)
+func (a *Admin) handleAdminRevokeRole(senderId uint32, message *packets.Packet_AdminRevokeRole) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to revoke a role from another client (%d)", senderId)
+		return
 	}
 
-	a.client.SocketSend(packets.NewSqlResponse(true, nil, columnNames, rowMessages))
+	role := message.AdminRevokeRole.Role
+	a.changeRole("revoke_role", message.AdminRevokeRole.Username, role, func(ctx context.Context, user db.User) error {
+		// Otherwise there might be nobody left who can give it back
+		if user.ID == a.user.ID && role == string(roles.Superadmin) {
+			return fmt.Errorf("can't revoke your own %s role", roles.Superadmin)
+		}
+		revoked, err := a.queries.RevokeRole(ctx, db.RevokeRoleParams{
+			UserID: user.ID,
+			Role:   role,
+		})
+		if err != nil {
+			return err
+		}
+		if revoked == 0 {
+			return fmt.Errorf("%s doesn't have the %s role", user.Username, role)
+		}
+		return nil
+	})
+}
+
+// Grants or revokes a role away from the level's goroutine, then comes back to tell the admin what roles the user has
+// now. Admins can only change roles that don't outrank their own, for users whose roles don't either. Successful
+// changes go in the audit log, and take effect straight away wherever the user is logged in.
+func (a *Admin) changeRole(action, username, role string, apply func(context.Context, db.User) error) {
+	username = strings.ToLower(username)
+	a.logger.Printf("Received request to %s %s for %s", action, role, username)
+	adminUserId, adminRoles := a.user.ID, a.roles
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		user, err := a.queries.GetUserByUsername(ctx, username)
+		if err != nil {
+			err = fmt.Errorf("no such user %s", username)
+		} else if !roles.CanActOn(adminRoles, []string{role}) {
+			err = fmt.Errorf("the %s role outranks yours", role)
+		} else if err = checkCanActOn(ctx, a.queries, adminRoles, user); err == nil {
+			err = apply(ctx, user)
+		}
+
+		var userRoles []string
+		if err == nil {
+			userRoles, err = a.queries.GetRolesByUserId(ctx, user.ID)
+		}
+
+		// If they're playing, their roles need updating there too
+		var peerId uint32
+		var inGame bool
+		if err == nil {
+			peerId, inGame, _ = findUserInGame(a.client, a.queries, user.ID)
+		}
+
+		if err != nil {
+			a.logger.Printf("Failed to %s %s for %s: %v", action, role, username, err)
+			a.client.Post(func() {
+				a.client.SocketSend(packets.NewAdminRoleResponse(false, username, role, nil, err))
+			})
+			return
+		}
+
+		a.client.Post(func() {
+			a.logger.Printf("Did %s %s for %s, who now has %v", action, role, username, userRoles)
+			response := packets.NewAdminRoleResponse(true, username, role, userRoles, nil)
+			a.client.SocketSend(response)
+			if inGame {
+				a.client.PassToPeer(response, peerId)
+			}
+			a.client.AdminSessions().ForEach(func(clientId uint32, sessionUserId int32) {
+				if sessionUserId != user.ID {
+					return
+				}
+				if clientId == a.client.Id() {
+					a.setRoles(userRoles)
+					return
+				}
+				a.client.PassToPeer(response, clientId)
+			})
+		})
+		writeAuditLog(a.queries, a.logger, adminUserId, action, username, role)
+	}()
 }
 
 func (a *Admin) handleLevelUpload(senderId uint32, message *packets.Packet_LevelUpload) {
@@ -176,7 +603,7 @@ func (a *Admin) handleLevelUpload(senderId uint32, message *packets.Packet_Level
 	defer cancel()
 
 	uploadedLevelGdResPath := message.LevelUpload.GdResPath
-	uploaderUserId := a.adminModel.UserID
+	uploaderUserId := a.user.ID
 
 	level, err := a.queries.GetLevelByGdResPath(ctx, uploadedLevelGdResPath)
 	if err == nil {
@@ -279,21 +706,29 @@ func (a *Admin) handleAdminJoinGameRequest(senderId uint32, _ *packets.Packet_Ad
 	}
 
 	a.logger.Println("Received request to join game")
-	a.client.SocketSend(packets.NewAdminJoinGameResponse(true, nil))
 
-	actor, err := a.queries.GetActorByUserId(context.Background(), a.adminModel.UserID)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	characters, err := a.queries.GetActorsByUserId(ctx, a.user.ID)
+	if err == nil && len(characters) <= 0 {
+		err = errors.New("you don't have any characters")
+	}
 	if err != nil {
-		a.logger.Printf("Failed to get actor for user %d: %v", a.adminModel.UserID, err)
+		a.logger.Printf("Failed to get characters for user %d: %v", a.user.ID, err)
 		a.client.SocketSend(packets.NewAdminJoinGameResponse(false, err))
 		return
 	}
 
-	if !actor.LevelID.Valid {
-		a.logger.Printf("Failed to get level id for actor %d, gonna try giving them level 1", actor.ID)
-		actor.LevelID = pgtype.Int4{Int32: 1, Valid: true}
-		err = a.queries.UpdateActorLevel(context.Background(), db.UpdateActorLevelParams{
-			ID:      actor.ID,
-			LevelID: actor.LevelID,
+	for i, character := range characters {
+		if character.LevelID.Valid {
+			continue
+		}
+		a.logger.Printf("Failed to get level id for actor %d, gonna try giving them level 1", character.ID)
+		characters[i].LevelID = pgtype.Int4{Int32: 1, Valid: true}
+		err = a.queries.UpdateActorLevel(ctx, db.UpdateActorLevelParams{
+			ID:      character.ID,
+			LevelID: characters[i].LevelID,
 		})
 		if err != nil {
 			a.logger.Printf("Failed to update actor level: %v", err)
@@ -302,13 +737,32 @@ func (a *Admin) handleAdminJoinGameRequest(senderId uint32, _ *packets.Packet_Ad
 		}
 	}
 
-	a.client.SetState(&InGame{
-		levelId: actor.LevelID.Int32,
-		player:  objs.NewActor(actor.LevelID.Int32, actor.X, actor.Y, actor.Name, actor.SpriteRegionX, actor.SpriteRegionY, actor.ID),
+	// Admins pick a character like everyone else, which checks they haven't been banned in the meantime
+	a.client.SocketSend(packets.NewAdminJoinGameResponse(true, nil))
+	a.client.SetState(&CharacterSelect{
+		user:       a.user,
+		characters: characters,
 	})
 }
 
+// Another admin changed our roles
+func (a *Admin) handleAdminRoleResponse(senderId uint32, message *packets.Packet_AdminRoleResponse) {
+	if senderId == a.client.Id() {
+		a.logger.Println("Received a role change from ourselves, ignoring")
+		return
+	}
+
+	a.setRoles(message.AdminRoleResponse.Roles)
+	a.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("Your roles have been changed to %v", a.roles)))
+}
+
+func (a *Admin) setRoles(userRoles []string) {
+	a.roles = userRoles
+	a.logger.Printf("Roles changed to %v", a.roles)
+}
+
 func (a *Admin) OnExit() {
+	a.client.AdminSessions().Remove(a.client.Id())
 }
 
 func (a *Admin) clearLevelData(dbCtx context.Context, levelId int32, levelName string, uploaderUserId int32) {
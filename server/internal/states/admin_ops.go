@@ -0,0 +1,343 @@
+package states
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/db"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/objs"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/skills"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/ds"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+)
+
+// Typed operations for the admin tools, so common tasks don't need the SQL console
+
+// The most XP that can be granted at once. Well within what the database can hold, so a typo can't wrap it around.
+const maxGrantXp = 1_000_000
+
+func (a *Admin) handleAdminLookupPlayer(senderId uint32, message *packets.Packet_AdminLookupPlayer) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to look up a player from another client (%d)", senderId)
+		return
+	}
+
+	name := strings.TrimSpace(message.AdminLookupPlayer.Name)
+	a.logger.Printf("Received request to look up %s", name)
+
+	go func() {
+		info, err := a.lookupPlayer(name)
+		a.client.Post(func() {
+			if err != nil {
+				a.logger.Printf("Failed to look up %s: %v", name, err)
+				a.client.SocketSend(packets.NewAdminPlayerInfo(false, nil, err))
+				return
+			}
+			a.client.SocketSend(packets.NewAdminPlayerInfo(true, info, nil))
+		})
+	}()
+}
+
+// Finds the account with the username, or failing that, the account the character with that name belongs to
+func (a *Admin) lookupPlayer(name string) (*packets.AdminPlayerInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	user, err := a.queries.GetUserByUsername(ctx, strings.ToLower(name))
+	if errors.Is(err, pgx.ErrNoRows) {
+		character, characterErr := a.queries.GetActorByName(ctx, name)
+		if characterErr != nil {
+			return nil, fmt.Errorf("no user or character named %s", name)
+		}
+		user, err = a.queries.GetUserById(ctx, character.UserID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	info := &packets.AdminPlayerInfo{Username: user.Username}
+
+	info.Roles, err = a.queries.GetRolesByUserId(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	characters, err := a.queries.GetActorsByUserId(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, character := range characters {
+		info.Characters = append(info.Characters, packets.NewCharacterInfo(character.ID, character.Name, character.SpriteRegionX, character.SpriteRegionY, character.LevelID.Int32, dbActorAppearance(character)))
+		if characterInGame(a.client, character.ID) {
+			info.OnlineAs = character.Name
+		}
+	}
+
+	ban, err := activeBan(ctx, a.queries, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if ban != nil {
+		info.Ban = ban.describe()
+	}
+
+	mute, err := activeMute(ctx, a.queries, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if mute != nil {
+		info.Mute = mute.describe()
+	}
+
+	return info, nil
+}
+
+func (a *Admin) handleAdminEditInventory(senderId uint32, message *packets.Packet_AdminEditInventory) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to edit an inventory from another client (%d)", senderId)
+		return
+	}
+
+	itemName := message.AdminEditInventory.ItemName
+	quantity := message.AdminEditInventory.Quantity
+	details := fmt.Sprintf("%+d %s", quantity, itemName)
+	a.characterOp("edit_inventory", message.AdminEditInventory.CharacterName, details, func(ctx context.Context, character db.Actor) (packets.Msg, func(context.Context) error, error) {
+		if quantity == 0 {
+			return nil, nil, errors.New("quantity must not be zero")
+		}
+
+		itemModel, err := a.queries.GetItemByName(ctx, itemName)
+		if err != nil {
+			return nil, nil, fmt.Errorf("no item named %s", itemName)
+		}
+		toolProps := a.client.UtilFunctions().ToolPropsFromInt4Id(itemModel.ToolPropertiesID)
+		item := objs.NewItem(itemModel.Name, itemModel.Description, itemModel.Value, itemModel.SpriteRegionX, itemModel.SpriteRegionY, toolProps, itemModel.GrantsVip, itemModel.Tradeable, itemModel.ID)
+
+		offline := func(ctx context.Context) error {
+			return a.editOfflineInventory(ctx, character.ID, itemModel.ID, quantity)
+		}
+		return packets.NewItemQuantity(item, quantity), offline, nil
+	})
+}
+
+func (a *Admin) editOfflineInventory(ctx context.Context, actorId int32, itemId int32, quantity int32) error {
+	if quantity > 0 {
+		return a.queries.AddActorInventoryItem(ctx, db.AddActorInventoryItemParams{
+			ActorID:  actorId,
+			ItemID:   itemId,
+			Quantity: quantity,
+		})
+	}
+
+	invItems, err := a.queries.GetActorInventoryItems(ctx, actorId)
+	if err != nil {
+		return err
+	}
+	var held int32
+	for _, invItem := range invItems {
+		if invItem.ItemID == itemId {
+			held = invItem.Quantity
+		}
+	}
+
+	remaining := held + quantity
+	if remaining <= 0 {
+		return a.queries.RemoveActorInventoryItem(ctx, db.RemoveActorInventoryItemParams{
+			ActorID: actorId,
+			ItemID:  itemId,
+		})
+	}
+	return a.queries.UpsertActorInventoryItem(ctx, db.UpsertActorInventoryItemParams{
+		ActorID:  actorId,
+		ItemID:   itemId,
+		Quantity: remaining,
+	})
+}
+
+func (a *Admin) handleAdminGrantXp(senderId uint32, message *packets.Packet_AdminGrantXp) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to grant XP from another client (%d)", senderId)
+		return
+	}
+
+	skill := skills.Skill(message.AdminGrantXp.Skill)
+	xp := message.AdminGrantXp.Xp
+	details := fmt.Sprintf("%d %s XP", xp, skills.SkillNames[skill])
+	a.characterOp("grant_xp", message.AdminGrantXp.CharacterName, details, func(ctx context.Context, character db.Actor) (packets.Msg, func(context.Context) error, error) {
+		if _, exists := skills.SkillNames[skill]; !exists {
+			return nil, nil, fmt.Errorf("unknown skill %d", skill)
+		}
+		if xp == 0 || xp > maxGrantXp {
+			return nil, nil, fmt.Errorf("XP must be between 1 and %d", maxGrantXp)
+		}
+
+		offline := func(ctx context.Context) error {
+			return a.queries.AddActorXp(ctx, db.AddActorXpParams{
+				ActorID: character.ID,
+				Skill:   int32(skill),
+				Xp:      int32(xp),
+			})
+		}
+		return packets.NewXpReward(skill, xp), offline, nil
+	})
+}
+
+func (a *Admin) handleAdminTeleport(senderId uint32, message *packets.Packet_AdminTeleport) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to teleport a player from another client (%d)", senderId)
+		return
+	}
+
+	levelId := message.AdminTeleport.LevelId
+	x, y := message.AdminTeleport.X, message.AdminTeleport.Y
+	details := fmt.Sprintf("level %d (%d, %d)", levelId, x, y)
+	a.characterOp("teleport", message.AdminTeleport.CharacterName, details, func(ctx context.Context, character db.Actor) (packets.Msg, func(context.Context) error, error) {
+		if _, err := a.queries.GetLevelById(ctx, levelId); err != nil {
+			return nil, nil, fmt.Errorf("no level with ID %d", levelId)
+		}
+		if a.client.LevelPointMaps().Collisions.Contains(levelId, ds.NewPoint(x, y)) {
+			return nil, nil, fmt.Errorf("(%d, %d) is blocked in level %d", x, y, levelId)
+		}
+
+		offline := func(ctx context.Context) error {
+			return a.queries.UpdateActorLocation(ctx, db.UpdateActorLocationParams{
+				ID:      character.ID,
+				LevelID: pgtype.Int4{Int32: levelId, Valid: true},
+				X:       x,
+				Y:       y,
+			})
+		}
+		return message, offline, nil
+	})
+}
+
+// Runs an operation on a character away from the level's goroutine. If the character is in the game, only their own
+// state may change them, so they're passed the message prepare returns to apply it themselves. Otherwise the offline
+// function changes them in the database. Either way, the admin hears how it went and successes go in the audit log.
+func (a *Admin) characterOp(op, characterName, details string, prepare func(context.Context, db.Actor) (packets.Msg, func(context.Context) error, error)) {
+	a.logger.Printf("Received request to %s for %s: %s", op, characterName, details)
+	adminUserId := a.user.ID
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		fail := func(err error) {
+			a.logger.Printf("Failed to %s for %s: %v", op, characterName, err)
+			a.client.Post(func() {
+				a.client.SocketSend(packets.NewAdminOpResponse(false, op, characterName, err))
+			})
+		}
+
+		character, err := a.queries.GetActorByName(ctx, characterName)
+		if err != nil {
+			fail(fmt.Errorf("no character named %s", characterName))
+			return
+		}
+
+		online, offline, err := prepare(ctx, character)
+		if err != nil {
+			fail(err)
+			return
+		}
+
+		peerId, inGame := characterClientId(a.client, character.ID)
+		if !inGame {
+			if err := offline(ctx); err != nil {
+				fail(err)
+				return
+			}
+		}
+
+		a.client.Post(func() {
+			if inGame {
+				a.client.PassToPeer(online, peerId)
+			}
+			a.client.SocketSend(packets.NewAdminOpResponse(true, op, character.Name, nil))
+		})
+		writeAuditLog(a.queries, a.logger, adminUserId, op, character.Name, details)
+	}()
+}
+
+func (a *Admin) handleAdminListOnlinePlayers(senderId uint32, _ *packets.Packet_AdminListOnlinePlayers) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to list online players from another client (%d)", senderId)
+		return
+	}
+
+	// Where each player is belongs to the level they're in, so ask every level about its own
+	var playersMux sync.Mutex
+	players := make([]*packets.OnlinePlayer, 0)
+	a.client.PostToEveryLevel(func(level *central.Level) {
+		level.ForEachActor(func(_ uint32, actor *objs.Actor) {
+			if actor.IsNpc {
+				return
+			}
+			player := &packets.OnlinePlayer{
+				Name:    actor.Name,
+				LevelId: actor.LevelId,
+				X:       actor.X,
+				Y:       actor.Y,
+			}
+			playersMux.Lock()
+			players = append(players, player)
+			playersMux.Unlock()
+		})
+	}, func() {
+		a.client.Post(func() {
+			a.client.SocketSend(packets.NewAdminOnlinePlayers(players))
+		})
+	})
+}
+
+func (a *Admin) handleAdminListLevels(senderId uint32, _ *packets.Packet_AdminListLevels) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to list levels from another client (%d)", senderId)
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		levelModels, err := a.queries.GetLevels(ctx)
+		if err != nil {
+			a.logger.Printf("Failed to get levels: %v", err)
+			levelModels = nil
+		}
+
+		// Each level counts its own players, since only it knows who's still there
+		var playerCountsMux sync.Mutex
+		playerCounts := make(map[int32]uint32)
+		a.client.PostToEveryLevel(func(level *central.Level) {
+			var players uint32
+			level.ForEachActor(func(_ uint32, actor *objs.Actor) {
+				if !actor.IsNpc {
+					players++
+				}
+			})
+			playerCountsMux.Lock()
+			playerCounts[level.Id] = players
+			playerCountsMux.Unlock()
+		}, func() {
+			a.client.Post(func() {
+				levels := make([]*packets.LevelInfo, len(levelModels))
+				for i, level := range levelModels {
+					levels[i] = &packets.LevelInfo{
+						Id:        level.ID,
+						GdResPath: level.GdResPath,
+						Players:   playerCounts[level.ID],
+					}
+				}
+				a.client.SocketSend(packets.NewAdminLevelList(levels))
+			})
+		})
+	}()
+}
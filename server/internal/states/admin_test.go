@@ -0,0 +1,131 @@
+package states
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/db"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/roles"
+)
+
+// A database with just enough in it to look up users and their roles. Anything that would change it is recorded
+// instead, so tests can check nothing was changed.
+type fakeDb struct {
+	users  map[string]db.User
+	roles  map[int32][]string
+	writes []string
+}
+
+func (f *fakeDb) Exec(_ context.Context, sql string, _ ...interface{}) (pgconn.CommandTag, error) {
+	f.writes = append(f.writes, sql)
+	return pgconn.CommandTag{}, nil
+}
+
+func (f *fakeDb) Query(_ context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	if strings.Contains(sql, "-- name: GetRolesByUserId ") {
+		return &fakeRows{values: f.roles[args[0].(int32)]}, nil
+	}
+	return nil, errors.New("unexpected query")
+}
+
+func (f *fakeDb) QueryRow(_ context.Context, sql string, args ...interface{}) pgx.Row {
+	if strings.Contains(sql, "-- name: GetUserByUsername ") {
+		if user, exists := f.users[args[0].(string)]; exists {
+			return fakeRow{id: user.ID, username: user.Username, passwordHash: user.PasswordHash, mustChangePassword: user.MustChangePassword}
+		}
+		return fakeRow{err: pgx.ErrNoRows}
+	}
+	return fakeRow{err: errors.New("unexpected query")}
+}
+
+func (f *fakeDb) CopyFrom(_ context.Context, _ pgx.Identifier, _ []string, _ pgx.CopyFromSource) (int64, error) {
+	return 0, errors.New("unexpected copy")
+}
+
+// A single row, or the error from trying to get it
+type fakeRow struct {
+	id                 int32
+	username           string
+	passwordHash       string
+	mustChangePassword bool
+	err                error
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	*dest[0].(*int32) = r.id
+	*dest[1].(*string) = r.username
+	*dest[2].(*string) = r.passwordHash
+	*dest[3].(*bool) = r.mustChangePassword
+	return nil
+}
+
+// Rows with a single string column
+type fakeRows struct {
+	values []string
+	next   int
+}
+
+func (r *fakeRows) Close()                                       {}
+func (r *fakeRows) Err() error                                   { return nil }
+func (r *fakeRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *fakeRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *fakeRows) Values() ([]any, error)                       { return nil, errors.New("unsupported") }
+func (r *fakeRows) RawValues() [][]byte                          { return nil }
+func (r *fakeRows) Conn() *pgx.Conn                              { return nil }
+
+func (r *fakeRows) Next() bool {
+	r.next++
+	return r.next <= len(r.values)
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	*dest[0].(*string) = r.values[r.next-1]
+	return nil
+}
+
+func newFakeAdmin(database *fakeDb, user db.User) *Admin {
+	return &Admin{
+		user:    user,
+		roles:   database.roles[user.ID],
+		queries: db.New(database),
+		logger:  log.New(io.Discard, "", 0),
+	}
+}
+
+// Otherwise a moderator could reset a superadmin's password and log in as them with the temporary one
+func TestModeratorCannotResetSuperadminPassword(t *testing.T) {
+	moderator := db.User{ID: 1, Username: "mod"}
+	superadmin := db.User{ID: 2, Username: "boss"}
+	player := db.User{ID: 3, Username: "player"}
+	database := &fakeDb{
+		users: map[string]db.User{"mod": moderator, "boss": superadmin, "player": player},
+		roles: map[int32][]string{
+			moderator.ID:  {string(roles.Moderator)},
+			superadmin.ID: {string(roles.Superadmin)},
+		},
+	}
+	admin := newFakeAdmin(database, moderator)
+
+	if temporaryPassword, err := admin.resetPassword(admin.roles, superadmin.Username); err == nil {
+		t.Fatalf("Moderator reset the superadmin's password to %q", temporaryPassword)
+	}
+	if len(database.writes) > 0 {
+		t.Fatalf("Expected nothing to be written after refusing, got %d writes", len(database.writes))
+	}
+
+	if _, err := admin.resetPassword(admin.roles, player.Username); err != nil {
+		t.Fatalf("Moderator couldn't reset a player's password: %v", err)
+	}
+	if len(database.writes) != 1 {
+		t.Fatalf("Expected the player's password to be written once, got %d writes", len(database.writes))
+	}
+}
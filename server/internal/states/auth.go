@@ -0,0 +1,87 @@
+package states
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/db"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/password"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/ratelimit"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Counts a strike against the subject, recording it for the admins if that gets it locked out
+func strike(client central.ClientInterfacer, logger *log.Logger, lockout *ratelimit.Lockout, kind, subject string) {
+	duration := lockout.Strike(subject)
+	if duration <= 0 {
+		return
+	}
+
+	logger.Printf("Locked out %s %s for %v", kind, subject, duration)
+	lockedUntil := time.Now().UTC().Add(duration)
+	queries := client.DbTx().Queries
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		err := queries.CreateAuthLockout(ctx, db.CreateAuthLockoutParams{
+			Kind:        kind,
+			Subject:     subject,
+			LockedUntil: pgtype.Timestamp{Time: lockedUntil, Valid: true},
+		})
+		if err != nil {
+			logger.Printf("Failed to record lockout of %s %s: %v", kind, subject, err)
+		}
+	}()
+}
+
+func tooManyAttemptsError(wait time.Duration) error {
+	return fmt.Errorf("too many attempts, please try again in %v", wait.Round(time.Second))
+}
+
+// Replaces the user's password if they got the old one right. Returns what to tell the client if it fails, and whether
+// the failure should count towards a lockout. Safe to run off the level's goroutine, since it only talks to the
+// database.
+func changePassword(queries *db.Queries, logger *log.Logger, user db.User, oldPassword, newPassword string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(oldPassword))
+	if err != nil {
+		logger.Printf("Password change for %s failed: %v", user.Username, err)
+		return true, errors.New("invalid username or password")
+	}
+
+	err = password.Validate(newPassword, user.Username)
+	if err != nil {
+		return false, fmt.Errorf("invalid password: %v", err)
+	}
+
+	if newPassword == oldPassword {
+		return false, errors.New("invalid password: must be different to the old one")
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		logger.Printf("Failed to hash password: %s", user.Username)
+		return false, errors.New("internal server error, please try again later")
+	}
+
+	err = queries.UpdateUserPassword(ctx, db.UpdateUserPasswordParams{
+		ID:                 user.ID,
+		PasswordHash:       string(passwordHash),
+		MustChangePassword: false,
+	})
+	if err != nil {
+		logger.Printf("Failed to update password for %s: %v", user.Username, err)
+		return false, errors.New("internal server error, please try again later")
+	}
+
+	logger.Printf("Changed password for %s", user.Username)
+	return false, nil
+}
@@ -0,0 +1,449 @@
+package states
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/db"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/roles"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+)
+
+// Chat channels for a player in the game. The channels themselves live in the hub, so their members can be in any
+// level, and what the player has joined is saved so they're put back in the same channels next time.
+
+func validateChannelName(name string) error {
+	if len(name) < 2 {
+		return errors.New("too short")
+	}
+	if len(name) > 20 {
+		return errors.New("too long")
+	}
+	for _, r := range name {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '_' && r != '-' {
+			return errors.New("only lowercase letters, numbers, underscores and dashes are allowed")
+		}
+	}
+	return nil
+}
+
+// Puts the player in the global channel and every channel they were in last time
+func (g *InGame) joinSavedChannels() {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if err := g.joinChannel(central.GlobalChannel); err != nil {
+		g.logger.Printf("Failed to join the global channel: %v", err)
+	}
+
+	memberships, err := g.queries.GetChatChannelMembershipsByActorId(ctx, g.player.DbId)
+	if err != nil {
+		g.logger.Printf("Failed to get channel memberships: %v", err)
+		return
+	}
+	for _, name := range memberships {
+		if err := g.joinChannel(name); err != nil {
+			g.logger.Printf("Failed to rejoin %s: %v", name, err)
+		}
+	}
+}
+
+// Adds the player to the channel, tells everyone else in it, and sends the client who's there and what they've said.
+// If they were muted in it before, they still are.
+func (g *InGame) joinChannel(name string) error {
+	channels := g.client.Channels()
+	kind, _, exists := channels.Info(name)
+	if !exists {
+		return fmt.Errorf("no channel named %s", name)
+	}
+
+	members, history, others, err := channels.Join(name, g.client.Id(), central.ChannelMember{
+		ActorId: g.player.DbId,
+		Name:    g.player.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Everyone is in the global channel, so there's no point listing them or telling everyone who comes and goes
+	if name == central.GlobalChannel {
+		members = nil
+	} else {
+		g.client.Broadcast(packets.NewChannelMemberUpdate(name, g.player.Name, true), others)
+	}
+
+	if g.client.ProtocolVersion() >= packets.ChannelsProtocolVersion {
+		historyMsgs := make([]*packets.ChannelMessage, len(history))
+		for i, message := range history {
+			historyMsgs[i] = &packets.ChannelMessage{
+				Channel:    name,
+				SenderName: message.SenderName,
+				Msg:        message.Msg,
+				SentAt:     message.SentAt.Unix(),
+			}
+		}
+		g.client.SocketSend(packets.NewChannelJoined(name, string(kind), members, historyMsgs))
+	}
+	return nil
+}
+
+// Takes the player out of every channel when they leave the game. They stay members in the database, so they're put
+// back in them next time.
+func (g *InGame) leaveAllChannels() {
+	for name, others := range g.client.Channels().LeaveAll(g.client.Id()) {
+		if name != central.GlobalChannel {
+			g.client.Broadcast(packets.NewChannelMemberUpdate(name, g.player.Name, false), others)
+		}
+	}
+}
+
+// Puts the player in the given channel, which the server manages, and takes them out of any others of the same kind.
+// No channel means they shouldn't be in any.
+func (g *InGame) syncChannelOfKind(kind central.ChannelKind, channel string) {
+	channels := g.client.Channels()
+	joined := channels.Joined(g.client.Id())
+
+	for _, name := range joined {
+		if joinedKind, _, _ := channels.Info(name); name == channel || joinedKind != kind {
+			continue
+		}
+		if others, wasMember := channels.Leave(name, g.client.Id()); wasMember {
+			g.client.Broadcast(packets.NewChannelMemberUpdate(name, g.player.Name, false), others)
+		}
+	}
+
+	if channel == "" || slices.Contains(joined, channel) {
+		return
+	}
+	if err := g.joinChannel(channel); err != nil {
+		g.logger.Printf("Failed to join %s channel %s: %v", kind, channel, err)
+	}
+}
+
+func (g *InGame) handleJoinChannel(senderId uint32, message *packets.Packet_JoinChannel) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a request to join a channel from another client (%d), ignoring", senderId)
+		return
+	}
+
+	name := strings.ToLower(strings.TrimSpace(message.JoinChannel.Name))
+	fail := func(err error) {
+		g.logger.Printf("Failed to join channel %s: %v", name, err)
+		g.client.SocketSend(packets.NewChannelResponse(false, "join", name, err))
+	}
+
+	if err := validateChannelName(name); err != nil {
+		fail(fmt.Errorf("invalid channel name: %v", err))
+		return
+	}
+
+	channels := g.client.Channels()
+	if slices.Contains(channels.Joined(g.client.Id()), name) {
+		fail(fmt.Errorf("you're already in %s", name))
+		return
+	}
+
+	kind, _, exists := channels.Info(name)
+	if exists && kind != central.ChannelSystem && kind != central.ChannelCustom {
+		fail(fmt.Errorf("you can't join %s yourself", name))
+		return
+	}
+	if !exists {
+		channels.Ensure(name, central.ChannelCustom, g.player.DbId)
+	}
+
+	if err := g.joinChannel(name); err != nil {
+		fail(err)
+		return
+	}
+
+	actorId := g.player.DbId
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		if !exists {
+			err := g.queries.CreateChatChannelIfNotExists(ctx, db.CreateChatChannelIfNotExistsParams{
+				Name:         name,
+				Kind:         string(central.ChannelCustom),
+				OwnerActorID: pgtype.Int4{Int32: actorId, Valid: true},
+			})
+			if err != nil {
+				g.logger.Printf("Failed to save new channel %s: %v", name, err)
+				return
+			}
+		}
+		err := g.queries.AddChatChannelMember(ctx, db.AddChatChannelMemberParams{ChannelName: name, ActorID: actorId})
+		if err != nil {
+			g.logger.Printf("Failed to save membership of %s: %v", name, err)
+		}
+	}()
+
+	g.client.SocketSend(packets.NewChannelResponse(true, "join", name, nil))
+}
+
+func (g *InGame) handleLeaveChannel(senderId uint32, message *packets.Packet_LeaveChannel) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a request to leave a channel from another client (%d), ignoring", senderId)
+		return
+	}
+
+	name := strings.ToLower(strings.TrimSpace(message.LeaveChannel.Name))
+	fail := func(err error) {
+		g.logger.Printf("Failed to leave channel %s: %v", name, err)
+		g.client.SocketSend(packets.NewChannelResponse(false, "leave", name, err))
+	}
+
+	if name == central.GlobalChannel {
+		fail(errors.New("everyone is in the global channel"))
+		return
+	}
+	kind, _, exists := g.client.Channels().Info(name)
+	if exists && kind != central.ChannelSystem && kind != central.ChannelCustom {
+		fail(fmt.Errorf("you can't leave %s yourself", name))
+		return
+	}
+
+	others, wasMember := g.client.Channels().Leave(name, g.client.Id())
+	if !wasMember {
+		fail(fmt.Errorf("you aren't in %s", name))
+		return
+	}
+	g.client.Broadcast(packets.NewChannelMemberUpdate(name, g.player.Name, false), others)
+
+	g.forgetChannelMember(name, g.player.DbId)
+	g.client.SocketSend(packets.NewChannelResponse(true, "leave", name, nil))
+}
+
+// Removes the character's saved membership of the channel in the background
+func (g *InGame) forgetChannelMember(name string, actorId int32) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		err := g.queries.RemoveChatChannelMember(ctx, db.RemoveChatChannelMemberParams{ChannelName: name, ActorID: actorId})
+		if err != nil {
+			g.logger.Printf("Failed to remove membership of %s for actor %d: %v", name, actorId, err)
+		}
+	}()
+}
+
+func (g *InGame) handleChannelMessage(senderId uint32, message *packets.Packet_ChannelMessage) {
+	channelMsg := message.ChannelMessage
+	if strings.TrimSpace(channelMsg.Msg) == "" {
+		g.logger.Println("Received a channel message with no content, ignoring")
+		return
+	}
+
+	if senderId != g.client.Id() {
+		if g.isIgnoring(senderId) {
+			return
+		}
+		if g.client.ProtocolVersion() >= packets.ChannelsProtocolVersion {
+			g.client.SocketSendAs(message, senderId)
+		}
+		return
+	}
+
+	// The global channel is where yells go, so older clients hear them too
+	if channelMsg.Channel == central.GlobalChannel {
+		g.yell(channelMsg.Msg)
+		return
+	}
+
+	if g.refuseIfMuted() {
+		return
+	}
+
+	msg, allowed := g.moderateChat(channelMsg.Msg)
+	if !allowed {
+		return
+	}
+
+	others, sent, err := g.client.Channels().Post(channelMsg.Channel, g.client.Id(), msg)
+	if err != nil {
+		g.client.SocketSend(packets.NewChannelResponse(false, "message", channelMsg.Channel, err))
+		return
+	}
+
+	g.logChat("channel", channelMsg.Channel, channelMsg.Msg, sent.Msg)
+	censored := packets.NewChannelMessage(channelMsg.Channel, sent.SenderName, sent.Msg, sent.SentAt)
+	g.client.Broadcast(censored, others)
+	g.client.SocketSend(censored)
+}
+
+func (g *InGame) handleChannelMemberUpdate(senderId uint32, message *packets.Packet_ChannelMemberUpdate) {
+	if senderId == g.client.Id() {
+		g.logger.Println("Received a channel member update from ourselves, ignoring")
+		return
+	}
+
+	if g.client.ProtocolVersion() >= packets.ChannelsProtocolVersion {
+		g.client.SocketSend(message)
+	}
+}
+
+func (g *InGame) handleListChannels(senderId uint32, _ *packets.Packet_ListChannels) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a request to list channels from another client (%d), ignoring", senderId)
+		return
+	}
+
+	channels := g.client.Channels()
+	joined := channels.Joined(g.client.Id())
+
+	infos := make([]*packets.ChannelInfo, 0)
+	for _, channel := range channels.List() {
+		isMember := slices.Contains(joined, channel.Name)
+		// Other people's parties and guilds are none of our business
+		if !isMember && channel.Kind != central.ChannelSystem && channel.Kind != central.ChannelCustom {
+			continue
+		}
+		infos = append(infos, &packets.ChannelInfo{
+			Name:    channel.Name,
+			Kind:    string(channel.Kind),
+			Members: uint32(channel.Members),
+			Joined:  isMember,
+		})
+	}
+	g.client.SocketSend(packets.NewChannelList(infos))
+}
+
+// Channels are moderated by whoever made them, and by anyone with the moderate permission
+func (g *InGame) handleChannelModerate(senderId uint32, message *packets.Packet_ChannelModerate) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a channel moderation request from another client (%d), ignoring", senderId)
+		return
+	}
+
+	moderate := message.ChannelModerate
+	name, action, targetName := strings.ToLower(moderate.Channel), moderate.Action, moderate.Name
+	fail := func(err error) {
+		g.logger.Printf("Failed to %s %s in %s: %v", action, targetName, name, err)
+		g.client.SocketSend(packets.NewChannelResponse(false, action, name, err))
+	}
+
+	kind, ownerActorId, exists := g.client.Channels().Info(name)
+	if !exists {
+		fail(fmt.Errorf("no channel named %s", name))
+		return
+	}
+	isOwner := kind == central.ChannelCustom && ownerActorId == g.player.DbId
+	if !isOwner && !g.hasPermission(roles.Moderate) {
+		fail(fmt.Errorf("you can't moderate %s", name))
+		return
+	}
+	if strings.EqualFold(targetName, g.player.Name) {
+		fail(errors.New("you can't moderate yourself"))
+		return
+	}
+
+	succeed := func() {
+		g.client.SocketSend(packets.NewChannelResponse(true, action, name, nil))
+		if !isOwner {
+			writeAuditLog(g.queries, g.logger, g.userId, "channel_"+action, targetName, name)
+		}
+	}
+
+	switch action {
+	case "mute", "unmute", "kick":
+		clientId, member, found := g.client.Channels().FindMember(name, targetName)
+		if !found {
+			fail(fmt.Errorf("%s isn't in %s", targetName, name))
+			return
+		}
+		if action == "kick" {
+			g.removeFromChannel(name, clientId, member)
+		} else if err := g.setChannelMuted(name, clientId, member, action == "mute"); err != nil {
+			fail(err)
+			return
+		}
+		succeed()
+
+	case "ban", "unban":
+		banned := action == "ban"
+		moderatorId := g.player.DbId
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			target, err := g.queries.GetActorByName(ctx, targetName)
+			if err != nil {
+				err = fmt.Errorf("no character named %s", targetName)
+			} else if banned {
+				err = g.queries.AddChatChannelBan(ctx, db.AddChatChannelBanParams{
+					ChannelName:     name,
+					ActorID:         target.ID,
+					BannedByActorID: pgtype.Int4{Int32: moderatorId, Valid: true},
+				})
+			} else {
+				var lifted int64
+				lifted, err = g.queries.RemoveChatChannelBan(ctx, db.RemoveChatChannelBanParams{ChannelName: name, ActorID: target.ID})
+				if err == nil && lifted == 0 {
+					err = fmt.Errorf("%s isn't banned from %s", target.Name, name)
+				}
+			}
+
+			g.client.Post(func() {
+				if err != nil {
+					fail(err)
+					return
+				}
+				g.client.Channels().SetBanned(name, target.ID, banned)
+				if clientId, member, found := g.client.Channels().FindMember(name, target.Name); banned && found {
+					g.removeFromChannel(name, clientId, member)
+				}
+				succeed()
+			})
+		}()
+
+	default:
+		fail(fmt.Errorf("unknown action %s", action))
+	}
+}
+
+// Kicks the member out of the channel, and tells them and everyone left in it
+func (g *InGame) removeFromChannel(name string, clientId uint32, member central.ChannelMember) {
+	others, wasMember := g.client.Channels().Leave(name, clientId)
+	if !wasMember {
+		return
+	}
+	removed := packets.NewChannelMemberUpdate(name, member.Name, false)
+	g.client.Broadcast(removed, others)
+	g.client.PassToPeer(removed, clientId)
+	g.forgetChannelMember(name, member.ActorId)
+}
+
+func (g *InGame) setChannelMuted(name string, clientId uint32, member central.ChannelMember, muted bool) error {
+	if err := g.client.Channels().SetMuted(name, member.ActorId, muted); err != nil {
+		return err
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		var err error
+		if muted {
+			err = g.queries.AddChatChannelMute(ctx, db.AddChatChannelMuteParams{ChannelName: name, ActorID: member.ActorId})
+		} else {
+			err = g.queries.RemoveChatChannelMute(ctx, db.RemoveChatChannelMuteParams{ChannelName: name, ActorID: member.ActorId})
+		}
+		if err != nil {
+			g.logger.Printf("Failed to save mute of actor %d in %s: %v", member.ActorId, name, err)
+		}
+	}()
+
+	notice := fmt.Sprintf("You have been unmuted in %s", name)
+	if muted {
+		notice = fmt.Sprintf("You have been muted in %s", name)
+	}
+	g.client.PassToPeer(packets.NewServerMessage(notice), clientId)
+	return nil
+}
@@ -0,0 +1,372 @@
+package states
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/appearance"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/db"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/objs"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+)
+
+const maxCharactersPerUser = 5
+
+type CharacterSelect struct {
+	client     central.ClientInterfacer
+	queries    *db.Queries
+	logger     *log.Logger
+	user       db.User
+	characters []db.Actor
+}
+
+func (c *CharacterSelect) Name() string {
+	return "CharacterSelect"
+}
+
+func (c *CharacterSelect) SetClient(client central.ClientInterfacer) {
+	c.client = client
+	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), c.Name())
+	c.queries = client.DbTx().Queries
+	c.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+}
+
+func (c *CharacterSelect) OnEnter() {
+	// Older clients don't know about this screen, so pick for them like they were used to. Logging in makes sure
+	// there's at least one character to pick.
+	if c.client.ProtocolVersion() < packets.CharacterSelectProtocolVersion {
+		c.selectCharacter(c.characters[0])
+		return
+	}
+
+	c.sendCharacterList()
+}
+
+func (c *CharacterSelect) HandleMessage(senderId uint32, message packets.Msg) {
+	switch message := message.(type) {
+	case *packets.Packet_CreateCharacterRequest:
+		c.handleCreateCharacterRequest(senderId, message)
+	case *packets.Packet_DeleteCharacterRequest:
+		c.handleDeleteCharacterRequest(senderId, message)
+	case *packets.Packet_RenameCharacterRequest:
+		c.handleRenameCharacterRequest(senderId, message)
+	case *packets.Packet_SelectCharacterRequest:
+		c.handleSelectCharacterRequest(senderId, message)
+	case *packets.Packet_Logout:
+		c.client.EndSession()
+		c.client.SetState(&Connected{})
+	case *packets.Packet_ResumeSession:
+		c.handleResumeSession(senderId, message)
+	}
+}
+
+func (c *CharacterSelect) handleCreateCharacterRequest(_ uint32, message *packets.Packet_CreateCharacterRequest) {
+	if len(c.characters) >= maxCharactersPerUser {
+		c.client.SocketSend(packets.NewCreateCharacterResponse(false, fmt.Errorf("you can't have more than %d characters", maxCharactersPerUser)))
+		return
+	}
+
+	request := message.CreateCharacterRequest
+	name := request.Name
+	err := validateUsername(name, c.client.GameData().Profanity.Detector())
+	if err != nil {
+		c.logger.Printf("Invalid character name %s: %v", name, err)
+		c.client.SocketSend(packets.NewCreateCharacterResponse(false, fmt.Errorf("invalid name: %v", err)))
+		return
+	}
+
+	chosenAppearance, err := appearanceFromRequest(request.Appearance, request.SpriteRegionX, request.SpriteRegionY)
+	if err != nil {
+		c.logger.Printf("Invalid appearance for character %s: %v", name, err)
+		c.client.SocketSend(packets.NewCreateCharacterResponse(false, fmt.Errorf("invalid appearance: %v", err)))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	_, err = c.queries.GetActorByName(ctx, name)
+	if err == nil {
+		c.client.SocketSend(packets.NewCreateCharacterResponse(false, errors.New("that name is already taken")))
+		return
+	}
+
+	character, err := createCharacter(ctx, c.queries, c.user.ID, name, chosenAppearance)
+	if err != nil {
+		c.logger.Printf("Failed to create character %s: %v", name, err)
+		c.client.SocketSend(packets.NewCreateCharacterResponse(false, errors.New("internal server error, please try again later")))
+		return
+	}
+
+	c.logger.Printf("Created character %s", character.Name)
+	c.characters = append(c.characters, character)
+	c.client.SocketSend(packets.NewCreateCharacterResponse(true, nil))
+	c.sendCharacterList()
+}
+
+func (c *CharacterSelect) handleDeleteCharacterRequest(_ uint32, message *packets.Packet_DeleteCharacterRequest) {
+	character, exists := c.findCharacter(message.DeleteCharacterRequest.CharacterId)
+	if !exists {
+		c.client.SocketSend(packets.NewDeleteCharacterResponse(false, errors.New("character not found")))
+		return
+	}
+
+	if characterInGame(c.client, character.ID) {
+		c.client.SocketSend(packets.NewDeleteCharacterResponse(false, errors.New("that character is in the game")))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	err := c.queries.DeleteActor(ctx, db.DeleteActorParams{
+		ID:     character.ID,
+		UserID: c.user.ID,
+	})
+	if err != nil {
+		c.logger.Printf("Failed to delete character %s: %v", character.Name, err)
+		c.client.SocketSend(packets.NewDeleteCharacterResponse(false, errors.New("internal server error, please try again later")))
+		return
+	}
+
+	c.logger.Printf("Deleted character %s", character.Name)
+	c.reloadCharacters()
+	c.client.SocketSend(packets.NewDeleteCharacterResponse(true, nil))
+	c.sendCharacterList()
+}
+
+func (c *CharacterSelect) handleRenameCharacterRequest(_ uint32, message *packets.Packet_RenameCharacterRequest) {
+	character, exists := c.findCharacter(message.RenameCharacterRequest.CharacterId)
+	if !exists {
+		c.client.SocketSend(packets.NewRenameCharacterResponse(false, errors.New("character not found")))
+		return
+	}
+
+	name := message.RenameCharacterRequest.Name
+	err := validateUsername(name, c.client.GameData().Profanity.Detector())
+	if err != nil {
+		c.logger.Printf("Invalid character name %s: %v", name, err)
+		c.client.SocketSend(packets.NewRenameCharacterResponse(false, fmt.Errorf("invalid name: %v", err)))
+		return
+	}
+
+	if characterInGame(c.client, character.ID) {
+		c.client.SocketSend(packets.NewRenameCharacterResponse(false, errors.New("that character is in the game")))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	// Changing the case of a character's own name is fine
+	existing, err := c.queries.GetActorByName(ctx, name)
+	if err == nil && existing.ID != character.ID {
+		c.client.SocketSend(packets.NewRenameCharacterResponse(false, errors.New("that name is already taken")))
+		return
+	}
+
+	err = c.queries.RenameActor(ctx, db.RenameActorParams{
+		ID:     character.ID,
+		UserID: c.user.ID,
+		Name:   name,
+	})
+	if err != nil {
+		c.logger.Printf("Failed to rename character %s to %s: %v", character.Name, name, err)
+		c.client.SocketSend(packets.NewRenameCharacterResponse(false, errors.New("internal server error, please try again later")))
+		return
+	}
+
+	c.logger.Printf("Renamed character %s to %s", character.Name, name)
+	c.reloadCharacters()
+	c.client.SocketSend(packets.NewRenameCharacterResponse(true, nil))
+	c.sendCharacterList()
+}
+
+func (c *CharacterSelect) handleSelectCharacterRequest(_ uint32, message *packets.Packet_SelectCharacterRequest) {
+	character, exists := c.findCharacter(message.SelectCharacterRequest.CharacterId)
+	if !exists {
+		c.client.SocketSend(packets.NewSelectCharacterResponse(false, errors.New("character not found")))
+		return
+	}
+
+	c.selectCharacter(character)
+}
+
+func (c *CharacterSelect) selectCharacter(character db.Actor) {
+	// Only one character per account can be in the game at a time
+	if anyCharacterInGame(c.client, c.characters) {
+		c.logger.Printf("User %s is already in the game", c.user.Username)
+		c.client.SocketSend(packets.NewSelectCharacterResponse(false, errors.New("already logged in")))
+		return
+	}
+
+	// The account might have been banned since logging in
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	ban, err := activeBan(ctx, c.queries, c.user.ID)
+	if err != nil {
+		c.logger.Printf("Failed to check for a ban on user %s: %v", c.user.Username, err)
+		c.client.SocketSend(packets.NewSelectCharacterResponse(false, errors.New("internal server error, please try again later")))
+		return
+	}
+	if ban != nil {
+		c.logger.Printf("User %s is banned", c.user.Username)
+		c.client.SocketSend(packets.NewSelectCharacterResponse(false, fmt.Errorf("your account is banned %s", ban.describe())))
+		return
+	}
+
+	if !character.LevelID.Valid {
+		c.logger.Printf("Actor %s has no level ID", character.Name)
+		c.client.SocketSend(packets.NewSelectCharacterResponse(false, errors.New("internal server error, please try again later")))
+		return
+	}
+
+	c.logger.Printf("Selected character %s", character.Name)
+	c.client.SocketSend(packets.NewSelectCharacterResponse(true, nil))
+
+	levelId := character.LevelID.Int32
+	playerObj := newPlayerObj(levelId, character)
+	c.client.EnterLevel(levelId, func() {
+		c.client.SetState(&InGame{
+			levelId: levelId,
+			player:  playerObj,
+		})
+	})
+}
+
+func (c *CharacterSelect) handleResumeSession(senderId uint32, _ *packets.Packet_ResumeSession) {
+	if senderId != c.client.Id() {
+		return
+	}
+
+	// We've been handed a new connection, so it needs to catch up on everything
+	c.client.SocketSend(packets.NewResumeSessionResponse(true, c.client.StartSession(), nil))
+	c.client.SocketSend(packets.NewClientId(c.client.Id()))
+	c.sendCharacterList()
+}
+
+func (c *CharacterSelect) sendCharacterList() {
+	characters := make([]*packets.CharacterInfo, len(c.characters))
+	for i, character := range c.characters {
+		characters[i] = packets.NewCharacterInfo(character.ID, character.Name, character.SpriteRegionX, character.SpriteRegionY, character.LevelID.Int32, dbActorAppearance(character))
+	}
+	c.client.SocketSend(packets.NewCharacterList(characters, maxCharactersPerUser))
+}
+
+// Refreshes our copy of the user's characters after changing them in the database
+func (c *CharacterSelect) reloadCharacters() {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	characters, err := c.queries.GetActorsByUserId(ctx, c.user.ID)
+	if err != nil {
+		c.logger.Printf("Failed to get characters for user %s: %v", c.user.Username, err)
+		return
+	}
+	c.characters = characters
+}
+
+func (c *CharacterSelect) findCharacter(id int32) (db.Actor, bool) {
+	for _, character := range c.characters {
+		if character.ID == id {
+			return character, true
+		}
+	}
+	return db.Actor{}, false
+}
+
+func (c *CharacterSelect) OnExit() {
+}
+
+// Creates a character in the starting level
+func createCharacter(ctx context.Context, queries *db.Queries, userId int32, name string, a appearance.Appearance) (db.Actor, error) {
+	// TODO: Don't hardcode level ID
+	level, err := queries.GetLevelById(ctx, 1)
+	if err != nil {
+		return db.Actor{}, fmt.Errorf("failed to get level 1: %v", err)
+	}
+
+	spriteRegionX, spriteRegionY := a.SpriteRegion()
+	return queries.CreateActor(ctx, db.CreateActorParams{
+		LevelID:       pgtype.Int4{Int32: level.ID, Valid: true},
+		X:             -1,
+		Y:             -1,
+		Name:          name,
+		SpriteRegionX: spriteRegionX,
+		SpriteRegionY: spriteRegionY,
+		UserID:        userId,
+		Body:          a.Body,
+		Hair:          a.Hair,
+		Outfit:        a.Outfit,
+		Palette:       a.Palette,
+	})
+}
+
+// Works out the appearance a client chose for a new character. Clients which don't know about appearances only send
+// a sprite region.
+func appearanceFromRequest(msg *packets.Appearance, spriteRegionX, spriteRegionY int32) (appearance.Appearance, error) {
+	if msg == nil {
+		return appearance.FromSpriteRegion(spriteRegionX, spriteRegionY)
+	}
+
+	a := appearanceFromMsg(msg)
+	return a, appearance.Validate(a)
+}
+
+func appearanceFromMsg(msg *packets.Appearance) appearance.Appearance {
+	return appearance.Appearance{
+		Body:    msg.Body,
+		Hair:    msg.Hair,
+		Outfit:  msg.Outfit,
+		Palette: msg.Palette,
+	}
+}
+
+func dbActorAppearance(actor db.Actor) appearance.Appearance {
+	return appearance.Appearance{
+		Body:    actor.Body,
+		Hair:    actor.Hair,
+		Outfit:  actor.Outfit,
+		Palette: actor.Palette,
+	}
+}
+
+// The player's in-game actor for one of their characters
+func newPlayerObj(levelId int32, character db.Actor) *objs.Actor {
+	player := objs.NewActor(levelId, character.X, character.Y, character.Name, character.SpriteRegionX, character.SpriteRegionY, character.ID)
+	player.Appearance = dbActorAppearance(character)
+	return player
+}
+
+// Whether anyone is currently playing as the character with the given database ID
+func characterInGame(client central.ClientInterfacer, actorId int32) bool {
+	_, found := characterClientId(client, actorId)
+	return found
+}
+
+// The ID of the client playing the character, if anyone is
+func characterClientId(client central.ClientInterfacer, actorId int32) (uint32, bool) {
+	var clientId uint32
+	found := false
+	client.SharedGameObjects().Actors.ForEach(func(ownerClientId uint32, actor *objs.Actor) {
+		if !actor.IsNpc && actor.DbId == actorId {
+			clientId = ownerClientId
+			found = true
+		}
+	})
+	return clientId, found
+}
+
+func anyCharacterInGame(client central.ClientInterfacer, characters []db.Actor) bool {
+	for _, character := range characters {
+		if characterInGame(client, character.ID) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package states
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/db"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+)
+
+// Keeping track of what players say: in each level's chat log for reports, and in the chat archive for moderators to
+// search
+
+// The most messages sent back from a search of the chat archive
+const maxChatLogEntries = 500
+
+// Remembers something the player said, as they typed it and as everyone else saw it
+func (g *InGame) logChat(kind, to, rawMsg, msg string) {
+	line := central.ChatLine{
+		SenderActorId: g.player.DbId,
+		SenderName:    g.player.Name,
+		Kind:          kind,
+		To:            to,
+		Msg:           msg,
+		SentAt:        time.Now(),
+	}
+	g.client.ChatArchive().Write(g.levelId, line, rawMsg)
+	g.logChatInLevel(line)
+}
+
+// Remembers something said in the chat log of the level the player's in, so it can go with their reports
+func (g *InGame) logChatInLevel(line central.ChatLine) {
+	if level := g.client.Level(); level != nil {
+		level.ChatLog.Push(line)
+	}
+}
+
+func (a *Admin) handleAdminSearchChatLog(senderId uint32, message *packets.Packet_AdminSearchChatLog) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to search the chat log from another client (%d)", senderId)
+		return
+	}
+
+	search := message.AdminSearchChatLog
+	player := strings.TrimSpace(search.Player)
+	since, until := search.Since, search.Until
+	keyword := strings.TrimSpace(search.Keyword)
+	adminUserId := a.user.ID
+	a.logger.Printf("Received request to search the chat log for %q from %q between %d and %d", keyword, player, since, until)
+
+	go func() {
+		entries, truncated, err := a.searchChatLog(player, since, until, keyword)
+		if err == nil {
+			// What players whisper to each other is private, so there should be a record of who's been reading it
+			details := fmt.Sprintf("keyword %q between %d and %d", keyword, since, until)
+			writeAuditLog(a.queries, a.logger, adminUserId, "search_chat", player, details)
+		}
+
+		a.client.Post(func() {
+			if err != nil {
+				a.logger.Printf("Failed to search the chat log: %v", err)
+				a.client.SocketSend(packets.NewAdminChatLog(false, nil, false, err))
+				return
+			}
+			a.client.SocketSend(packets.NewAdminChatLog(true, entries, truncated, nil))
+		})
+	}()
+}
+
+// Finds the most recent messages matching the filters, and whether there were more than could be returned
+func (a *Admin) searchChatLog(player string, since, until int64, keyword string) ([]*packets.ChatLogEntry, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	params := db.SearchChatLogParams{
+		Since:      pgtype.Timestamp{Time: time.Unix(since, 0).UTC(), Valid: true},
+		Until:      pgtype.Timestamp{Time: time.Now().UTC().Add(time.Hour), Valid: true},
+		Keyword:    escapeLikePattern(keyword),
+		MaxEntries: maxChatLogEntries + 1,
+	}
+	if until > 0 {
+		params.Until.Time = time.Unix(until, 0).UTC()
+	}
+	if player != "" {
+		actor, err := a.queries.GetActorByName(ctx, player)
+		if err != nil {
+			return nil, false, fmt.Errorf("no character named %s", player)
+		}
+		params.ActorID = pgtype.Int4{Int32: actor.ID, Valid: true}
+		params.ActorName = actor.Name
+	}
+
+	rows, err := a.queries.SearchChatLog(ctx, params)
+	if err != nil {
+		return nil, false, err
+	}
+
+	truncated := len(rows) > maxChatLogEntries
+	if truncated {
+		rows = rows[:maxChatLogEntries]
+	}
+
+	entries := make([]*packets.ChatLogEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = &packets.ChatLogEntry{
+			SenderName:  row.SenderName,
+			LevelId:     row.LevelID,
+			Kind:        row.Kind,
+			To:          row.Recipient,
+			RawMsg:      row.RawMsg,
+			CensoredMsg: row.CensoredMsg,
+			SentAt:      row.SentAt.Time.Unix(),
+		}
+	}
+	return entries, truncated, nil
+}
+
+// Escapes the wildcards in text to be matched literally by LIKE
+func escapeLikePattern(text string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(text)
+}
@@ -0,0 +1,518 @@
+package states
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/objs"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/roles"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/skills"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/ds"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+)
+
+// What sort of value a chat command's argument takes, which tells the client how to autocomplete it
+type argKind string
+
+const (
+	argText        argKind = "text"
+	argInt         argKind = "int"
+	argPlayer      argKind = "player"      // The name of a player who's online
+	argItem        argKind = "item"        // The name of an item
+	argSkill       argKind = "skill"       // The name of a skill
+	argDestination argKind = "destination" // A level ID or the name of a player to go to
+	argMessage     argKind = "message"     // Everything else on the line, which must come last
+)
+
+type commandArg struct {
+	name     string
+	kind     argKind
+	optional bool // Only the trailing arguments can be optional
+}
+
+type chatCommand struct {
+	name        string
+	aliases     []string
+	description string
+	permission  roles.Permission // Empty if anyone can use the command
+	args        []commandArg
+	run         func(g *InGame, args commandArgs) error
+}
+
+// E.g. "/give <player> <item> [quantity]"
+func (c *chatCommand) usage() string {
+	var b strings.Builder
+	b.WriteString("/" + c.name)
+	for _, arg := range c.args {
+		if arg.optional {
+			fmt.Fprintf(&b, " [%s]", arg.name)
+		} else {
+			fmt.Fprintf(&b, " <%s>", arg.name)
+		}
+	}
+	return b.String()
+}
+
+// Splits the text after the command's name into its arguments, checking there are the right number of them and that
+// numbers are numbers. Anything that needs the game to make sense of, like a player's name, is checked when it's used.
+func (c *chatCommand) parse(text string) (commandArgs, error) {
+	args := make(commandArgs)
+	rest := strings.TrimSpace(text)
+
+	for _, arg := range c.args {
+		var value string
+		if arg.kind == argMessage {
+			value, rest = rest, ""
+		} else {
+			value, rest = nextToken(rest)
+		}
+
+		if value == "" {
+			if arg.optional {
+				break
+			}
+			return nil, fmt.Errorf("missing %s", arg.name)
+		}
+
+		if arg.kind == argInt {
+			if _, err := strconv.ParseInt(value, 10, 32); err != nil {
+				return nil, fmt.Errorf("%s must be a whole number", arg.name)
+			}
+		}
+		args[arg.name] = value
+	}
+
+	if rest != "" {
+		return nil, errors.New("too many arguments")
+	}
+	return args, nil
+}
+
+// Splits off the first word, or the first phrase in double quotes so names can have spaces in them
+func nextToken(text string) (string, string) {
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, `"`) {
+		if end := strings.Index(text[1:], `"`); end >= 0 {
+			return text[1 : end+1], strings.TrimSpace(text[end+2:])
+		}
+	}
+	token, rest, _ := strings.Cut(text, " ")
+	return token, strings.TrimSpace(rest)
+}
+
+// The arguments a command was given, keyed by name. Missing optional arguments are absent.
+type commandArgs map[string]string
+
+func (a commandArgs) has(name string) bool {
+	_, exists := a[name]
+	return exists
+}
+
+// The argument as a number, or the fallback if it's absent. Parsing already checked it's a number.
+func (a commandArgs) int(name string, fallback int32) int32 {
+	value, exists := a[name]
+	if !exists {
+		return fallback
+	}
+	n, _ := strconv.ParseInt(value, 10, 32)
+	return int32(n)
+}
+
+type commandRegistry struct {
+	commands []*chatCommand // In the order they were registered, which is how /help lists them
+	byName   map[string]*chatCommand
+}
+
+func (r *commandRegistry) register(c *chatCommand) {
+	r.commands = append(r.commands, c)
+	r.byName[c.name] = c
+	for _, alias := range c.aliases {
+		r.byName[alias] = c
+	}
+}
+
+func (r *commandRegistry) lookup(name string) (*chatCommand, bool) {
+	c, exists := r.byName[strings.ToLower(name)]
+	return c, exists
+}
+
+// The commands a player with the given roles is allowed to use
+func (r *commandRegistry) available(userRoles []string) []*chatCommand {
+	available := make([]*chatCommand, 0, len(r.commands))
+	for _, c := range r.commands {
+		if c.permission == "" || roles.Has(userRoles, c.permission) {
+			available = append(available, c)
+		}
+	}
+	return available
+}
+
+// Every chat command, filled in by init since /help needs to refer back to it
+var chatCommands = &commandRegistry{byName: make(map[string]*chatCommand)}
+
+func init() {
+	chatCommands.register(&chatCommand{
+		name:        "help",
+		description: "Lists the commands you can use, or explains one of them",
+		args:        []commandArg{{name: "command", kind: argText, optional: true}},
+		run:         runHelpCommand,
+	})
+	chatCommands.register(&chatCommand{
+		name:        "who",
+		description: "Lists the players who are online",
+		run:         runWhoCommand,
+	})
+	chatCommands.register(&chatCommand{
+		name:        "time",
+		description: "Tells you the time of day in the game world",
+		run:         runTimeCommand,
+	})
+	chatCommands.register(&chatCommand{
+		name:        "report",
+		description: "Reports a player to the moderators, along with what's recently been said around you",
+		args: []commandArg{
+			{name: "player", kind: argText},
+			{name: "reason", kind: argMessage},
+		},
+		run: runReportCommand,
+	})
+	chatCommands.register(&chatCommand{
+		name:        "emote",
+		aliases:     []string{"e"},
+		description: "Waves, bows, cheers, laughs, sits or dances, e.g. /emote wave",
+		args:        []commandArg{{name: "emote", kind: argText}},
+		run:         runEmoteCommand,
+	})
+	chatCommands.register(&chatCommand{
+		name:        "tp",
+		aliases:     []string{"level"},
+		description: "Teleports you to a level, or to another player",
+		permission:  roles.Teleport,
+		args: []commandArg{
+			{name: "level_or_player", kind: argDestination},
+			{name: "x", kind: argInt, optional: true},
+			{name: "y", kind: argInt, optional: true},
+		},
+		run: runTpCommand,
+	})
+	chatCommands.register(&chatCommand{
+		name:        "give",
+		description: "Gives a player some of an item, or takes it away with a negative quantity",
+		permission:  roles.EditContent,
+		args: []commandArg{
+			{name: "player", kind: argPlayer},
+			{name: "item", kind: argItem},
+			{name: "quantity", kind: argInt, optional: true},
+		},
+		run: runGiveCommand,
+	})
+	chatCommands.register(&chatCommand{
+		name:        "xp",
+		description: "Gives a player XP in a skill",
+		permission:  roles.EditContent,
+		args: []commandArg{
+			{name: "player", kind: argPlayer},
+			{name: "skill", kind: argSkill},
+			{name: "amount", kind: argInt},
+		},
+		run: runXpCommand,
+	})
+	chatCommands.register(&chatCommand{
+		name:        "kick",
+		description: "Disconnects a player",
+		permission:  roles.Moderate,
+		args: []commandArg{
+			{name: "player", kind: argPlayer},
+			{name: "reason", kind: argMessage, optional: true},
+		},
+		run: runKickCommand,
+	})
+	chatCommands.register(&chatCommand{
+		name:        "broadcast",
+		description: "Sends a message to everyone who's online",
+		permission:  roles.Moderate,
+		args:        []commandArg{{name: "message", kind: argMessage}},
+		run:         runBroadcastCommand,
+	})
+	chatCommands.register(&chatCommand{
+		name:        "spawn",
+		description: "Puts an item on the ground where you're standing",
+		permission:  roles.EditContent,
+		args:        []commandArg{{name: "item", kind: argItem}},
+		run:         runSpawnCommand,
+	})
+}
+
+// Runs a chat message starting with a slash as a command, telling the player if it doesn't work out
+func (g *InGame) runCommand(text string) {
+	name, rest, _ := strings.Cut(strings.TrimPrefix(text, "/"), " ")
+
+	command, exists := chatCommands.lookup(name)
+	if !exists {
+		g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("Unknown command /%s, try /help", name)))
+		return
+	}
+
+	if command.permission != "" && !g.hasPermission(command.permission) {
+		g.logger.Printf("Refusing /%s, which needs the %s permission", command.name, command.permission)
+		g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("You need the %s permission to use /%s", command.permission, command.name)))
+		return
+	}
+
+	args, err := command.parse(rest)
+	if err != nil {
+		g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("%s. Usage: %s", capitalize(err.Error()), command.usage())))
+		return
+	}
+
+	g.logger.Printf("Running command: %s", text)
+	if err := command.run(g, args); err != nil {
+		g.client.SocketSend(packets.NewServerMessage(capitalize(err.Error())))
+		return
+	}
+
+	// Anything that needs a permission is something an admin did
+	if command.permission != "" {
+		writeAuditLog(g.queries, g.logger, g.userId, "/"+command.name, args["player"], strings.TrimSpace(rest))
+	}
+}
+
+// Tells the client what commands the player can use, for help and autocompletion
+func (g *InGame) sendCommandList() {
+	if g.client.ProtocolVersion() < packets.CommandListProtocolVersion {
+		return
+	}
+
+	skillNames := make([]string, 0, len(skills.SkillNames))
+	for _, name := range skills.SkillNames {
+		skillNames = append(skillNames, name)
+	}
+	sort.Strings(skillNames)
+
+	available := chatCommands.available(g.userRoles())
+	commandInfos := make([]*packets.CommandInfo, len(available))
+	for i, c := range available {
+		args := make([]*packets.CommandArg, len(c.args))
+		for j, arg := range c.args {
+			args[j] = &packets.CommandArg{Name: arg.name, Kind: string(arg.kind), Optional: arg.optional}
+			if arg.kind == argSkill {
+				args[j].Options = skillNames
+			}
+		}
+		commandInfos[i] = &packets.CommandInfo{
+			Name:        c.name,
+			Aliases:     c.aliases,
+			Description: c.description,
+			Usage:       c.usage(),
+			Args:        args,
+		}
+	}
+	g.client.SocketSend(packets.NewCommandList(commandInfos))
+}
+
+// Finds the client playing the named character, who must be online
+func (g *InGame) findPlayer(name string) (uint32, *objs.Actor, error) {
+	var clientId uint32
+	var player *objs.Actor
+	g.client.SharedGameObjects().Actors.ForEach(func(ownerClientId uint32, actor *objs.Actor) {
+		if !actor.IsNpc && strings.EqualFold(actor.Name, name) {
+			clientId = ownerClientId
+			player = actor
+		}
+	})
+	if player == nil {
+		return 0, nil, fmt.Errorf("%s isn't online", name)
+	}
+	return clientId, player, nil
+}
+
+func (g *InGame) findItem(name string) (*objs.Item, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	itemModel, err := g.queries.GetItemByName(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("no item named %s", name)
+	}
+	toolProps := g.client.UtilFunctions().ToolPropsFromInt4Id(itemModel.ToolPropertiesID)
+	return objs.NewItem(itemModel.Name, itemModel.Description, itemModel.Value, itemModel.SpriteRegionX, itemModel.SpriteRegionY, toolProps, itemModel.GrantsVip, itemModel.Tradeable, itemModel.ID), nil
+}
+
+func findSkill(name string) (skills.Skill, error) {
+	for skill, skillName := range skills.SkillNames {
+		if strings.EqualFold(skillName, name) {
+			return skill, nil
+		}
+	}
+	return 0, fmt.Errorf("no skill named %s", name)
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func runHelpCommand(g *InGame, args commandArgs) error {
+	if args.has("command") {
+		command, exists := chatCommands.lookup(strings.TrimPrefix(args["command"], "/"))
+		if !exists {
+			return fmt.Errorf("unknown command %s", args["command"])
+		}
+		g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("%s: %s", command.usage(), command.description)))
+		return nil
+	}
+
+	available := chatCommands.available(g.userRoles())
+	usages := make([]string, len(available))
+	for i, c := range available {
+		usages[i] = c.usage()
+	}
+	g.client.SocketSend(packets.NewServerMessage("Commands: " + strings.Join(usages, ", ")))
+	return nil
+}
+
+func runWhoCommand(g *InGame, _ commandArgs) error {
+	names := make([]string, 0)
+	g.client.SharedGameObjects().Actors.ForEach(func(_ uint32, actor *objs.Actor) {
+		if !actor.IsNpc {
+			names = append(names, actor.Name)
+		}
+	})
+	sort.Strings(names)
+	g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("%d online: %s", len(names), strings.Join(names, ", "))))
+	return nil
+}
+
+func runTpCommand(g *InGame, args commandArgs) error {
+	destination := args["level_or_player"]
+
+	if id, err := strconv.ParseInt(destination, 10, 32); err == nil {
+		levelId := int32(id)
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		if _, err := g.queries.GetLevelById(ctx, levelId); err != nil {
+			return fmt.Errorf("no level with ID %d", levelId)
+		}
+		return g.teleportIfClear(levelId, args.int("x", g.player.X), args.int("y", g.player.Y))
+	}
+
+	clientId, player, err := g.findPlayer(destination)
+	if err != nil {
+		return err
+	}
+
+	// Where they are belongs to the level they're in, so find out from there, then come back to go to them
+	name := player.Name
+	found := g.client.PostToPeer(clientId, func() {
+		actor, exists := g.client.SharedGameObjects().Actors.Get(clientId)
+		if !exists {
+			g.client.Post(func() {
+				g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("%s isn't online", name)))
+			})
+			return
+		}
+
+		levelId, x, y := actor.LevelId, actor.X, actor.Y
+		g.client.Post(func() {
+			if err := g.teleportIfClear(levelId, args.int("x", x), args.int("y", y)); err != nil {
+				g.client.SocketSend(packets.NewServerMessage(capitalize(err.Error())))
+			}
+		})
+	})
+	if !found {
+		return fmt.Errorf("%s isn't online", name)
+	}
+	return nil
+}
+
+// Teleports the player, unless they'd land somewhere blocked
+func (g *InGame) teleportIfClear(levelId, x, y int32) error {
+	if g.client.LevelPointMaps().Collisions.Contains(levelId, ds.NewPoint(x, y)) {
+		return fmt.Errorf("(%d, %d) is blocked in level %d", x, y, levelId)
+	}
+	g.teleport(levelId, x, y)
+	return nil
+}
+
+func runGiveCommand(g *InGame, args commandArgs) error {
+	clientId, player, err := g.findPlayer(args["player"])
+	if err != nil {
+		return err
+	}
+	item, err := g.findItem(args["item"])
+	if err != nil {
+		return err
+	}
+	quantity := args.int("quantity", 1)
+	if quantity == 0 {
+		return errors.New("quantity must not be zero")
+	}
+
+	if clientId == g.client.Id() {
+		g.changeItemQuantity(item, quantity)
+	} else {
+		g.client.PassToPeer(packets.NewItemQuantity(item, quantity), clientId)
+	}
+	g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("Gave %s %d %s", player.Name, quantity, item.Name)))
+	return nil
+}
+
+func runXpCommand(g *InGame, args commandArgs) error {
+	clientId, player, err := g.findPlayer(args["player"])
+	if err != nil {
+		return err
+	}
+	skill, err := findSkill(args["skill"])
+	if err != nil {
+		return err
+	}
+	amount := args.int("amount", 0)
+	if amount <= 0 {
+		return errors.New("amount must be more than zero")
+	}
+
+	if clientId == g.client.Id() {
+		g.awardPlayerXp(skill, uint32(amount))
+	} else {
+		g.client.PassToPeer(packets.NewXpReward(skill, uint32(amount)), clientId)
+	}
+	g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("Gave %s %d %s XP", player.Name, amount, skills.SkillNames[skill])))
+	return nil
+}
+
+func runKickCommand(g *InGame, args commandArgs) error {
+	clientId, player, err := g.findPlayer(args["player"])
+	if err != nil {
+		return err
+	}
+	if clientId == g.client.Id() {
+		return errors.New("you can't kick yourself")
+	}
+
+	g.client.PassToPeer(packets.NewModerationNotice("kick", args["reason"], time.Time{}), clientId)
+	g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("Kicked %s", player.Name)))
+	return nil
+}
+
+func runBroadcastCommand(g *InGame, args commandArgs) error {
+	announcement := packets.NewServerMessage(fmt.Sprintf("[%s] %s", g.player.Name, args["message"]))
+	g.client.Broadcast(announcement)
+	g.client.SocketSend(announcement)
+	return nil
+}
+
+func runSpawnCommand(g *InGame, args commandArgs) error {
+	item, err := g.findItem(args["item"])
+	if err != nil {
+		return err
+	}
+	g.dropGroundItem(item)
+	return nil
+}
@@ -6,24 +6,26 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
 	goaway "github.com/TwiN/go-away"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/appearance"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/db"
-	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/objs"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/password"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type Connected struct {
-	client            central.ClientInterfacer
-	queries           *db.Queries
-	logger            *log.Logger
-	profanityDetector *goaway.ProfanityDetector
+	client      central.ClientInterfacer
+	queries     *db.Queries
+	logger      *log.Logger
+	authPending bool // Whether a login or registration is being processed in the background
+	exited      bool
 }
 
 func (c *Connected) Name() string {
@@ -35,7 +37,6 @@ func (c *Connected) SetClient(client central.ClientInterfacer) {
 	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), c.Name())
 	c.queries = client.DbTx().Queries
 	c.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
-	c.profanityDetector = goaway.NewProfanityDetector().WithCustomDictionary(client.GameData().Profanity, []string{}, []string{})
 }
 
 func (c *Connected) OnEnter() {
@@ -67,93 +68,268 @@ func (c *Connected) OnEnter() {
 
 func (c *Connected) HandleMessage(senderId uint32, message packets.Msg) {
 	switch message := message.(type) {
+	case *packets.Packet_Hello:
+		c.handleHello(senderId, message)
 	case *packets.Packet_LoginRequest:
 		c.handleLoginRequest(senderId, message)
 	case *packets.Packet_RegisterRequest:
 		c.handleRegisterRequest(senderId, message)
+	case *packets.Packet_ResumeSession:
+		c.handleResumeSession(senderId, message)
+	case *packets.Packet_ChangePassword:
+		c.handleChangePassword(senderId, message)
 	}
 }
 
+func (c *Connected) handleHello(_ uint32, message *packets.Packet_Hello) {
+	version := message.Hello.ProtocolVersion
+
+	if version < packets.MinProtocolVersion {
+		c.logger.Printf("Client speaks protocol version %d, but the oldest we support is %d", version, packets.MinProtocolVersion)
+		c.client.SocketSend(packets.NewHelloResponse(false, nil, 0, 0, fmt.Errorf("Your game is out of date (protocol version %d, but the server needs at least %d). Please refresh the page or download the latest version.", version, packets.MinProtocolVersion)))
+		c.client.Close("incompatible protocol version")
+		return
+	}
+
+	if version > packets.ProtocolVersion {
+		c.logger.Printf("Client speaks protocol version %d, but the newest we support is %d", version, packets.ProtocolVersion)
+		c.client.SocketSend(packets.NewHelloResponse(false, nil, 0, 0, fmt.Errorf("Your game is newer than the server (protocol version %d, but the server only supports up to %d). Please try again later.", version, packets.ProtocolVersion)))
+		c.client.Close("incompatible protocol version")
+		return
+	}
+
+	// Only turn on what the client asked for and we know how to do
+	capabilities := make([]string, 0)
+	for _, capability := range message.Hello.Capabilities {
+		if slices.Contains(packets.SupportedCapabilities, capability) && !slices.Contains(capabilities, capability) {
+			capabilities = append(capabilities, capability)
+		}
+	}
+
+	c.client.SetProtocol(version, capabilities)
+	c.client.SetBatching(c.client.HasCapability(packets.CapabilityBatching))
+	c.client.SetCompression(c.client.HasCapability(packets.CapabilityCompression))
+
+	c.logger.Printf("Client speaks protocol version %d with capabilities %v", version, capabilities)
+	// Let the client know how fast the server's clock runs, so it can make sense of the ticks on actor updates
+	level := c.client.Level()
+	c.client.SocketSend(packets.NewHelloResponse(true, capabilities, uint32(level.TickRate()), level.Tick(), nil))
+}
+
 func (c *Connected) handleLoginRequest(_ uint32, message *packets.Packet_LoginRequest) {
+	if c.authPending {
+		c.client.SocketSend(packets.NewLoginResponse(false, "", errors.New("please wait for your last attempt to finish")))
+		return
+	}
+
+	username := strings.ToLower(message.LoginRequest.Username)
+	ip := c.client.RemoteIp()
+	limits := c.client.AuthLimits()
+	if wait := max(limits.LoginsByIp.Remaining(ip), limits.LoginsByUsername.Remaining(username)); wait > 0 {
+		c.logger.Printf("Login for %s refused, locked out for another %v", username, wait.Round(time.Second))
+		c.client.SocketSend(packets.NewLoginResponse(false, "", tooManyAttemptsError(wait)))
+		return
+	}
+
+	// Looking the user up and checking their password is slow, especially bcrypt, so do it away from the level's
+	// goroutine and come back with the result
+	c.authPending = true
+	plaintext := message.LoginRequest.Password
+	go func() {
+		attempt := c.checkCredentials(username, plaintext)
+		c.client.Post(func() {
+			c.authPending = false
+			if c.exited {
+				return
+			}
+			c.finishLogin(username, attempt)
+		})
+	}()
+}
+
+// The outcome of checking a user's credentials
+type loginAttempt struct {
+	user       db.User
+	roles      []string // Any at all means the user logs in as an admin
+	characters []db.Actor
+	err        error // What to tell the client if the login failed
+	badDetails bool  // Whether the failure should count towards a lockout
+}
+
+// A hash at the same cost as real passwords, to check against when there's no such user so that takes as long as a wrong
+// password does. Otherwise how quickly a login fails would give away which usernames exist.
+const dummyPasswordHash = "$2a$10$Xwa/twJNAiWA5OmkO4R2qOiZeKwe60IECSWO8Jz9BGWwTpT7jjJGS"
+
+// Safe to run off the level's goroutine, since it only talks to the database
+func (c *Connected) checkCredentials(username, plaintext string) *loginAttempt {
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 	defer cancel()
 
 	genericError := errors.New("invalid username or password")
+	internalError := errors.New("internal server error, please try again later")
 
-	user, err := c.queries.GetUserByUsername(ctx, strings.ToLower(message.LoginRequest.Username))
+	user, err := c.queries.GetUserByUsername(ctx, username)
 	if err != nil {
 		c.logger.Printf("Login failed: %v", err)
-		c.client.SocketSend(packets.NewLoginResponse(false, genericError))
-		return
-	}
-
-	// Check if the user is already in the game
-	player, err := c.queries.GetActorByUserId(ctx, user.ID)
-	found := false
-	c.client.SharedGameObjects().Actors.ForEach(func(_ uint32, actor *objs.Actor) {
-		if found {
-			return
+		if err == pgx.ErrNoRows {
+			bcrypt.CompareHashAndPassword([]byte(dummyPasswordHash), []byte(plaintext))
 		}
-		if actor.DbId == player.ID {
-			found = true
-			return
-		}
-	})
-	if found {
-		c.logger.Printf("User %s is already in the game", user.Username)
-		c.client.SocketSend(packets.NewLoginResponse(false, errors.New("already logged in")))
-		return
+		return &loginAttempt{err: genericError, badDetails: err == pgx.ErrNoRows}
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(message.LoginRequest.Password))
+	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(plaintext))
 	if err != nil {
 		c.logger.Printf("Login failed: %v", err)
-		c.client.SocketSend(packets.NewLoginResponse(false, genericError))
+		return &loginAttempt{err: genericError, badDetails: true}
+	}
+
+	ban, err := activeBan(ctx, c.queries, user.ID)
+	if err != nil {
+		c.logger.Printf("Failed to check for a ban on user %s: %v", user.Username, err)
+		return &loginAttempt{err: internalError}
+	}
+	if ban != nil {
+		c.logger.Printf("Refusing login for banned user %s", user.Username)
+		return &loginAttempt{err: fmt.Errorf("your account is banned %s", ban.describe())}
+	}
+
+	// Temporary passwords from an admin are only good for choosing a new one
+	if user.MustChangePassword {
+		c.logger.Printf("User %s needs to change their password before logging in", user.Username)
+		return &loginAttempt{err: errors.New("your password has been reset, please choose a new one")}
+	}
+
+	userRoles, err := c.queries.GetRolesByUserId(ctx, user.ID)
+	if err != nil {
+		c.logger.Printf("Failed to get roles for user %s: %v", user.Username, err)
+		return &loginAttempt{err: internalError}
+	}
+	if len(userRoles) > 0 {
+		return &loginAttempt{user: user, roles: userRoles}
+	}
+
+	characters, err := c.queries.GetActorsByUserId(ctx, user.ID)
+	if err != nil {
+		c.logger.Printf("Failed to get characters for user %s: %v", user.Username, err)
+		return &loginAttempt{err: internalError}
+	}
+
+	return &loginAttempt{user: user, characters: characters}
+}
+
+func (c *Connected) finishLogin(username string, attempt *loginAttempt) {
+	limits := c.client.AuthLimits()
+
+	if attempt.err != nil {
+		if attempt.badDetails {
+			strike(c.client, c.logger, limits.LoginsByIp, "login_ip", c.client.RemoteIp())
+			strike(c.client, c.logger, limits.LoginsByUsername, "login_username", username)
+		}
+		c.client.SocketSend(packets.NewLoginResponse(false, "", attempt.err))
 		return
 	}
 
-	admin, err := c.queries.GetAdminByUserId(ctx, user.ID)
-	if err == nil {
-		c.logger.Printf("Admin login: %s", user.Username)
+	// The IP's strikes are left to run out on their own, or someone could guess other people's passwords and log into
+	// their own account every so often to start afresh
+	limits.LoginsByUsername.Clear(username)
+
+	if len(attempt.roles) > 0 {
+		c.logger.Printf("Admin login: %s %v", attempt.user.Username, attempt.roles)
 		c.client.SocketSend(packets.NewAdminLoginGranted())
-		c.client.SetState(&Admin{adminModel: &admin})
+		c.client.SetState(&Admin{user: attempt.user, roles: attempt.roles})
 		return
 	}
-	if err != pgx.ErrNoRows {
-		c.logger.Printf("Failed to get admin for user %s: %v", user.Username, err)
-		// It's OK to send the specific error since this is an admin
-		c.client.SocketSend(packets.NewLoginResponse(false, err))
+
+	// Check if the user is already in the game as any of their characters
+	if anyCharacterInGame(c.client, attempt.characters) {
+		c.logger.Printf("User %s is already in the game", attempt.user.Username)
+		c.client.SocketSend(packets.NewLoginResponse(false, "", errors.New("already logged in")))
 		return
 	}
 
-	actor, err := c.queries.GetActorByUserId(ctx, user.ID)
-	if err != nil {
-		c.logger.Printf("Failed to get actor for user %s: %v", user.Username, err)
-		c.client.SocketSend(packets.NewLoginResponse(false, errors.New("internal server error, please try again later")))
+	// Older clients have no way to create a character, so there'd be nothing for them to do
+	if c.client.ProtocolVersion() < packets.CharacterSelectProtocolVersion && len(attempt.characters) == 0 {
+		c.logger.Printf("User %s has no characters", attempt.user.Username)
+		c.client.SocketSend(packets.NewLoginResponse(false, "", errors.New("you have no characters, please update your game to create one")))
 		return
 	}
 
 	c.logger.Println("Login successful")
-	c.client.SocketSend(packets.NewLoginResponse(true, nil))
+	c.client.SocketSend(packets.NewLoginResponse(true, c.client.StartSession(), nil))
+	c.client.SetState(&CharacterSelect{
+		user:       attempt.user,
+		characters: attempt.characters,
+	})
+}
 
-	if !actor.LevelID.Valid {
-		c.logger.Printf("Actor %s has no level ID", actor.Name)
-		c.client.SocketSend(packets.NewLoginResponse(false, errors.New("internal server error, please try again later")))
+func (c *Connected) handleResumeSession(_ uint32, message *packets.Packet_ResumeSession) {
+	// If this works, our connection now belongs to the player's old client, which takes it from here
+	if c.client.ResumeSession(message.ResumeSession.SessionToken) {
+		c.logger.Println("Handed connection over to resumed session")
 		return
 	}
 
-	c.client.SetState(&InGame{
-		levelId: actor.LevelID.Int32,
-		player:  objs.NewActor(actor.LevelID.Int32, actor.X, actor.Y, actor.Name, actor.SpriteRegionX, actor.SpriteRegionY, actor.ID),
-	})
+	c.logger.Println("Tried to resume a session that doesn't exist or has expired")
+	c.client.SocketSend(packets.NewResumeSessionResponse(false, "", errors.New("Your session has expired, please log in again")))
 }
 
-func (c *Connected) handleRegisterRequest(_ uint32, message *packets.Packet_RegisterRequest) {
+func (c *Connected) handleChangePassword(_ uint32, message *packets.Packet_ChangePassword) {
+	if c.authPending {
+		c.client.SocketSend(packets.NewChangePasswordResponse(false, errors.New("please wait for your last attempt to finish")))
+		return
+	}
+
+	username := strings.ToLower(message.ChangePassword.Username)
+	ip := c.client.RemoteIp()
+	limits := c.client.AuthLimits()
+	if wait := max(limits.LoginsByIp.Remaining(ip), limits.LoginsByUsername.Remaining(username)); wait > 0 {
+		c.logger.Printf("Password change for %s refused, locked out for another %v", username, wait.Round(time.Second))
+		c.client.SocketSend(packets.NewChangePasswordResponse(false, tooManyAttemptsError(wait)))
+		return
+	}
+
+	c.authPending = true
+	oldPassword, newPassword := message.ChangePassword.OldPassword, message.ChangePassword.NewPassword
+	go func() {
+		badDetails, err := c.changePassword(username, oldPassword, newPassword)
+		c.client.Post(func() {
+			c.authPending = false
+			if badDetails {
+				strike(c.client, c.logger, limits.LoginsByIp, "login_ip", ip)
+				strike(c.client, c.logger, limits.LoginsByUsername, "login_username", username)
+			}
+			if err != nil {
+				c.client.SocketSend(packets.NewChangePasswordResponse(false, err))
+				return
+			}
+			limits.LoginsByUsername.Clear(username)
+			c.client.SocketSend(packets.NewChangePasswordResponse(true, nil))
+		})
+	}()
+}
+
+// Safe to run off the level's goroutine, since it only talks to the database
+func (c *Connected) changePassword(username, oldPassword, newPassword string) (bool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 	defer cancel()
 
+	user, err := c.queries.GetUserByUsername(ctx, username)
+	if err != nil {
+		c.logger.Printf("Password change failed: %v", err)
+		return err == pgx.ErrNoRows, errors.New("invalid username or password")
+	}
+
+	return changePassword(c.queries, c.logger, user, oldPassword, newPassword)
+}
+
+func (c *Connected) handleRegisterRequest(_ uint32, message *packets.Packet_RegisterRequest) {
+	if c.authPending {
+		c.client.SocketSend(packets.NewRegisterResponse(false, errors.New("please wait for your last attempt to finish")))
+		return
+	}
+
 	username := strings.ToLower(message.RegisterRequest.Username)
-	err := c.validateUsername(username)
+	err := validateUsername(username, c.client.GameData().Profanity.Detector())
 	if err != nil {
 		reason := fmt.Sprintf("invalid username: %v", err)
 		c.logger.Println(reason)
@@ -161,21 +337,77 @@ func (c *Connected) handleRegisterRequest(_ uint32, message *packets.Packet_Regi
 		return
 	}
 
-	_, err = c.queries.GetUserByUsername(ctx, username)
+	request := message.RegisterRequest
+	chosenAppearance, err := appearanceFromRequest(request.Appearance, request.SpriteRegionX, request.SpriteRegionY)
+	if err != nil {
+		reason := fmt.Sprintf("invalid appearance: %v", err)
+		c.logger.Println(reason)
+		c.client.SocketSend(packets.NewRegisterResponse(false, errors.New(reason)))
+		return
+	}
+
+	err = password.Validate(message.RegisterRequest.Password, username)
+	if err != nil {
+		reason := fmt.Sprintf("invalid password: %v", err)
+		c.logger.Println(reason)
+		c.client.SocketSend(packets.NewRegisterResponse(false, errors.New(reason)))
+		return
+	}
+
+	ip := c.client.RemoteIp()
+	registrations := c.client.AuthLimits().RegistrationsByIp
+	if wait := registrations.Remaining(ip); wait > 0 {
+		c.logger.Printf("Registration for %s refused, locked out for another %v", username, wait.Round(time.Second))
+		c.client.SocketSend(packets.NewRegisterResponse(false, tooManyAttemptsError(wait)))
+		return
+	}
+	strike(c.client, c.logger, registrations, "register_ip", ip)
+
+	// Hashing the password is slow, so do it away from the level's goroutine and come back with the result
+	c.authPending = true
+	go func() {
+		err := c.register(username, request, chosenAppearance)
+		c.client.Post(func() {
+			c.authPending = false
+			if c.exited {
+				return
+			}
+			if err != nil {
+				c.client.SocketSend(packets.NewRegisterResponse(false, err))
+				return
+			}
+			c.client.SocketSend(packets.NewRegisterResponse(true, nil))
+			c.logger.Printf("User %s registered successfully", username)
+		})
+	}()
+}
+
+// Creates the user and their actor, returning what to tell the client if it fails. Safe to run off the level's
+// goroutine, since it only talks to the database.
+func (c *Connected) register(username string, request *packets.RegisterRequest, chosenAppearance appearance.Appearance) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	_, err := c.queries.GetUserByUsername(ctx, username)
 	if err == nil {
 		c.logger.Printf("User already exists: %s", username)
-		c.client.SocketSend(packets.NewRegisterResponse(false, errors.New("user already exists")))
-		return
+		return errors.New("user already exists")
+	}
+
+	// The first character is named after the user, so the name needs to be free too
+	_, err = c.queries.GetActorByName(ctx, username)
+	if err == nil {
+		c.logger.Printf("Character already exists: %s", username)
+		return errors.New("user already exists")
 	}
 
-	genericFailMessage := packets.NewRegisterResponse(false, errors.New("internal server error, please try again later"))
+	genericError := errors.New("internal server error, please try again later")
 
 	// Add new user
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte(message.RegisterRequest.Password), bcrypt.DefaultCost)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
 	if err != nil {
 		c.logger.Printf("Failed to hash password: %s", username)
-		c.client.SocketSend(genericFailMessage)
-		return
+		return genericError
 	}
 
 	user, err := c.queries.CreateUser(ctx, db.CreateUserParams{
@@ -185,43 +417,25 @@ func (c *Connected) handleRegisterRequest(_ uint32, message *packets.Packet_Regi
 
 	if err != nil {
 		c.logger.Printf("Failed to create user %s: %v", username, err)
-		c.client.SocketSend(genericFailMessage)
-		return
+		return genericError
 	}
 
-	// TODO: Don't hardcode level ID
-
-	level, err := c.queries.GetLevelById(ctx, 1)
-	if err != nil {
-		c.logger.Printf("Failed to get level %d: %v", level.ID, err)
-		c.client.SocketSend(genericFailMessage)
-		return
-	}
-	_, err = c.queries.CreateActor(ctx, db.CreateActorParams{
-		LevelID:       pgtype.Int4{Int32: level.ID, Valid: true},
-		X:             -1,
-		Y:             -1,
-		Name:          message.RegisterRequest.Username,
-		SpriteRegionX: message.RegisterRequest.SpriteRegionX,
-		SpriteRegionY: message.RegisterRequest.SpriteRegionY,
-		UserID:        user.ID,
-	})
-
+	_, err = createCharacter(ctx, c.queries, user.ID, username, chosenAppearance)
 	if err != nil {
 		c.logger.Printf("Failed to create actor for user %s: %v", username, err)
-		c.client.SocketSend(genericFailMessage)
-		return
+		return genericError
 	}
 
-	c.client.SocketSend(packets.NewRegisterResponse(true, nil))
-
-	c.logger.Printf("User %s registered successfully", username)
+	return nil
 }
 
 func (c *Connected) OnExit() {
+	// Anything still running in the background finds out when it posts back
+	c.exited = true
 }
 
-func (c *Connected) validateUsername(username string) error {
+// Also used for character names, which share the same rules
+func validateUsername(username string, profanityDetector *goaway.ProfanityDetector) error {
 	if len(username) <= 0 {
 		return errors.New("empty")
 	}
@@ -231,7 +445,7 @@ func (c *Connected) validateUsername(username string) error {
 	if username != strings.TrimSpace(username) {
 		return errors.New("leading or trailing whitespace")
 	}
-	if c.profanityDetector.IsProfane(username) {
+	if profanityDetector.IsProfane(username) {
 		return errors.New("watch your profanity")
 	}
 
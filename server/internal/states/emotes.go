@@ -0,0 +1,79 @@
+package states
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/emotes"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+)
+
+// Emotes, and letting everyone in the level see what the player's doing, e.g. chopping a shrub or sitting down
+
+// How long a player has to wait between emotes, so they can't flood everyone's screen with them
+const emoteCooldown = time.Second
+
+func (g *InGame) handleEmote(senderId uint32, message *packets.Packet_Emote) {
+	if senderId == g.client.Id() {
+		if err := g.emote(message.Emote.Name); err != nil {
+			g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("Couldn't %s: %v", message.Emote.Name, err)))
+		}
+		return
+	}
+
+	if g.isIgnoring(senderId) {
+		return
+	}
+
+	if g.client.ProtocolVersion() >= packets.EmotesProtocolVersion {
+		g.client.SocketSendAs(message, senderId)
+		return
+	}
+
+	// Older clients can't show emotes, so tell them what happened instead
+	emote, exists := emotes.Get(message.Emote.Name)
+	other, known := g.client.SharedGameObjects().Actors.Get(senderId)
+	if exists && known {
+		g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("%s %s", other.Name, emote.Verb)))
+	}
+}
+
+// Shows everyone in the level the player doing the emote, and keeps them doing it if it lasts until they move
+func (g *InGame) emote(name string) error {
+	emote, exists := emotes.Get(name)
+	if !exists {
+		return fmt.Errorf("there's no such emote. Try one of: %s", strings.Join(emotes.Names(), ", "))
+	}
+	if wait := time.Until(g.lastEmoteAt.Add(emoteCooldown)); wait > 0 {
+		return fmt.Errorf("please wait a moment before doing that again")
+	}
+	g.lastEmoteAt = time.Now()
+
+	if emote.Animation != emotes.Idle {
+		// Can't sit down and keep chopping at the same time
+		g.maybeCancelHarvestTimer()
+		g.setAnimation(emote.Animation)
+	}
+
+	msg := packets.NewEmote(emote.Name)
+	g.client.Broadcast(msg, g.othersInLevel)
+	g.client.SocketSend(msg)
+	return nil
+}
+
+// Changes what the player's doing, and lets everyone else in the level know at the end of the tick
+func (g *InGame) setAnimation(animation emotes.Animation) {
+	if g.player.Animation == animation {
+		return
+	}
+	g.player.Animation = animation
+
+	if level := g.client.Level(); level != nil {
+		level.MarkActorChanged(g.client.Id())
+	}
+}
+
+func runEmoteCommand(g *InGame, args commandArgs) error {
+	return g.emote(args["emote"])
+}
@@ -0,0 +1,660 @@
+package states
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/db"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/guilds"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/items"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/objs"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/ds"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+)
+
+// Guilds for a player in the game. Unlike parties, guilds are saved, so the database is the source of truth and each
+// member's state reloads its guild from there whenever another member says it's changed.
+
+const (
+	// How many gold bars it costs to start a guild
+	guildCreationCost uint32 = 500
+
+	// How many of the guild bank's most recent deposits and withdrawals to send
+	guildBankLogLength int32 = 50
+)
+
+func validateGuildTag(tag string) error {
+	if len(tag) < 2 {
+		return errors.New("too short")
+	}
+	if len(tag) > 4 {
+		return errors.New("too long")
+	}
+	for _, r := range tag {
+		if r < 'A' || r > 'Z' {
+			return errors.New("only letters are allowed")
+		}
+	}
+	return nil
+}
+
+// Loads the player's guild, so their tag is right from the moment they appear
+func (g *InGame) loadGuild() {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	membership, err := g.queries.GetGuildMembership(ctx, g.player.DbId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return
+	}
+	if err != nil {
+		g.logger.Printf("Failed to get guild: %v", err)
+		return
+	}
+	g.guild = &membership
+	g.player.GuildTag = membership.Tag
+}
+
+// Reloads the player's guild in the background, then updates their tag, puts them in the right guild channel and
+// tells the client
+func (g *InGame) refreshGuild() {
+	actorId := g.player.DbId
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		var guild *db.GetGuildMembershipRow
+		var members []db.GetGuildMembersRow
+		var invites []string
+
+		membership, err := g.queries.GetGuildMembership(ctx, actorId)
+		if err == nil {
+			guild = &membership
+			members, err = g.queries.GetGuildMembers(ctx, membership.ID)
+		} else if errors.Is(err, pgx.ErrNoRows) {
+			invites, err = g.queries.GetGuildInvitesByActorId(ctx, actorId)
+		}
+
+		g.client.Post(func() {
+			if err != nil {
+				g.logger.Printf("Failed to reload guild: %v", err)
+				return
+			}
+			g.setGuild(guild, members, invites)
+		})
+	}()
+}
+
+func (g *InGame) setGuild(guild *db.GetGuildMembershipRow, members []db.GetGuildMembersRow, invites []string) {
+	g.guild = guild
+
+	tag, channel := "", ""
+	if guild != nil {
+		tag, channel = guild.Tag, central.GuildChannel(guild.ID)
+		g.client.Channels().Ensure(channel, central.ChannelGuild, 0)
+	}
+	g.syncChannelOfKind(central.ChannelGuild, channel)
+
+	if tag != g.player.GuildTag {
+		g.player.GuildTag = tag
+		g.client.SocketSend(packets.NewActor(g.player))
+		g.client.Level().MarkActorChanged(g.client.Id())
+	}
+
+	if g.client.ProtocolVersion() < packets.GuildsProtocolVersion {
+		return
+	}
+	if guild == nil {
+		g.client.SocketSend(packets.NewGuildInfo("", "", "", nil, "", invites))
+		return
+	}
+	memberMsgs := make([]*packets.GuildMember, len(members))
+	for i, member := range members {
+		memberMsgs[i] = &packets.GuildMember{
+			Name:   member.Name,
+			Rank:   member.Rank,
+			Online: characterInGame(g.client, member.ID),
+		}
+	}
+	g.client.SocketSend(packets.NewGuildInfo(guild.Name, guild.Tag, guild.Rank, memberMsgs, channel, nil))
+}
+
+// Tells everyone in the guild who's online that it's changed, so they reload it
+func (g *InGame) notifyGuild(guildId int32) {
+	g.client.Broadcast(packets.NewGuildInfo("", "", "", nil, "", nil), g.client.Channels().Members(central.GuildChannel(guildId)))
+}
+
+// Another member changed our guild, or took us out of it
+func (g *InGame) handleGuildInfo(senderId uint32, _ *packets.Packet_GuildInfo) {
+	if senderId == g.client.Id() {
+		g.logger.Println("Received a guild update from ourselves, ignoring")
+		return
+	}
+	g.refreshGuild()
+}
+
+// Checks the player is in a guild and their rank lets them do something, telling them if not
+func (g *InGame) guildAllows(action string, permission guilds.Permission) bool {
+	if g.guild == nil {
+		g.client.SocketSend(packets.NewGuildResponse(false, action, "", errors.New("you aren't in a guild")))
+		return false
+	}
+	if !guilds.Has(g.guild.Rank, permission) {
+		g.client.SocketSend(packets.NewGuildResponse(false, action, "", fmt.Errorf("a %s can't do that", g.guild.Rank)))
+		return false
+	}
+	return true
+}
+
+func (g *InGame) handleCreateGuild(senderId uint32, message *packets.Packet_CreateGuild) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a request to create a guild from another client (%d), ignoring", senderId)
+		return
+	}
+
+	name := strings.TrimSpace(message.CreateGuild.Name)
+	tag := strings.ToUpper(strings.TrimSpace(message.CreateGuild.Tag))
+	fail := func(err error) {
+		g.logger.Printf("Failed to create guild %s: %v", name, err)
+		g.client.SocketSend(packets.NewGuildResponse(false, "create", name, err))
+	}
+
+	if g.guild != nil {
+		fail(errors.New("you're already in a guild"))
+		return
+	}
+	if err := validateUsername(name, g.client.GameData().Profanity.Detector()); err != nil {
+		fail(fmt.Errorf("invalid guild name: %v", err))
+		return
+	}
+	if err := validateGuildTag(tag); err != nil {
+		fail(fmt.Errorf("invalid guild tag: %v", err))
+		return
+	}
+	if g.client.GameData().Profanity.Detector().IsProfane(tag) {
+		fail(errors.New("invalid guild tag: watch your profanity"))
+		return
+	}
+	if g.inventory.GetItemQuantity(*items.GoldBars) < guildCreationCost {
+		fail(fmt.Errorf("starting a guild costs %d gold bars", guildCreationCost))
+		return
+	}
+
+	// Take the gold now so it can't be spent twice while we wait on the database, and give it back if it doesn't work
+	g.changeItemQuantity(items.GoldBars, -int32(guildCreationCost))
+
+	actorId := g.player.DbId
+	dbTx := g.client.DbTx()
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		// Either the guild is made with us leading it, or nothing changes and we get our gold back
+		var guild db.Guild
+		err := dbTx.InTransaction(ctx, func(queries *db.Queries) error {
+			var err error
+			guild, err = queries.CreateGuild(ctx, db.CreateGuildParams{
+				Name:             name,
+				Tag:              tag,
+				CreatedByActorID: pgtype.Int4{Int32: actorId, Valid: true},
+			})
+			if err != nil {
+				return err
+			}
+			err = queries.AddGuildMember(ctx, db.AddGuildMemberParams{ActorID: actorId, GuildID: guild.ID, Rank: string(guilds.Leader)})
+			if err != nil {
+				return err
+			}
+			return queries.DeleteGuildInvitesByActorId(ctx, actorId)
+		})
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			err = errors.New("a guild with that name or tag already exists")
+		}
+
+		g.client.Post(func() {
+			if err != nil {
+				g.changeItemQuantity(items.GoldBars, int32(guildCreationCost))
+				fail(err)
+				return
+			}
+			g.client.SocketSend(packets.NewGuildResponse(true, "create", guild.Name, nil))
+			g.refreshGuild()
+		})
+	}()
+}
+
+func (g *InGame) handleGuildInvite(senderId uint32, message *packets.Packet_GuildInvite) {
+	invite := message.GuildInvite
+
+	if senderId != g.client.Id() {
+		if g.isIgnoring(senderId) {
+			g.logger.Printf("Dropping a guild invitation from %s, who we're ignoring", invite.Name)
+			return
+		}
+		if g.client.ProtocolVersion() < packets.GuildsProtocolVersion {
+			g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("%s invited you to join %s, but your game needs updating to accept", invite.Name, invite.GuildName)))
+			return
+		}
+		g.client.SocketSend(message)
+		return
+	}
+
+	if !g.guildAllows("invite", guilds.Invite) {
+		return
+	}
+
+	guildId, guildName := g.guild.ID, g.guild.Name
+	actorId, playerName := g.player.DbId, g.player.Name
+	g.guildOp("invite", invite.Name, func(ctx context.Context, target db.Actor) error {
+		_, err := g.queries.GetGuildMembership(ctx, target.ID)
+		if err == nil {
+			return fmt.Errorf("%s is already in a guild", target.Name)
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		return g.queries.CreateGuildInvite(ctx, db.CreateGuildInviteParams{
+			GuildID:          guildId,
+			ActorID:          target.ID,
+			InvitedByActorID: pgtype.Int4{Int32: actorId, Valid: true},
+		})
+	}, func(target db.Actor) {
+		if clientId, inGame := characterClientId(g.client, target.ID); inGame {
+			g.client.PassToPeer(packets.NewGuildInvite(playerName, guildName), clientId)
+		}
+	})
+}
+
+func (g *InGame) handleAcceptGuildInvite(senderId uint32, message *packets.Packet_AcceptGuildInvite) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a guild invitation acceptance from another client (%d), ignoring", senderId)
+		return
+	}
+
+	guildName := message.AcceptGuildInvite.GuildName
+	fail := func(err error) {
+		g.logger.Printf("Failed to join guild %s: %v", guildName, err)
+		g.client.SocketSend(packets.NewGuildResponse(false, "accept", guildName, err))
+	}
+
+	if g.guild != nil {
+		fail(errors.New("you're already in a guild"))
+		return
+	}
+
+	actorId := g.player.DbId
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		guild, err := g.queries.GetGuildByName(ctx, guildName)
+		if err != nil {
+			err = fmt.Errorf("no guild named %s", guildName)
+		}
+		if err == nil {
+			var accepted int64
+			accepted, err = g.queries.DeleteGuildInvite(ctx, db.DeleteGuildInviteParams{GuildID: guild.ID, ActorID: actorId})
+			if err == nil && accepted == 0 {
+				err = fmt.Errorf("%s hasn't invited you", guild.Name)
+			}
+		}
+		if err == nil {
+			err = g.queries.AddGuildMember(ctx, db.AddGuildMemberParams{ActorID: actorId, GuildID: guild.ID, Rank: string(guilds.Recruit)})
+		}
+		if err == nil {
+			err = g.queries.DeleteGuildInvitesByActorId(ctx, actorId)
+		}
+
+		g.client.Post(func() {
+			if err != nil {
+				fail(err)
+				return
+			}
+			g.client.SocketSend(packets.NewGuildResponse(true, "accept", guild.Name, nil))
+			g.notifyGuild(guild.ID)
+			g.refreshGuild()
+		})
+	}()
+}
+
+func (g *InGame) handleLeaveGuild(senderId uint32, _ *packets.Packet_LeaveGuild) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a request to leave a guild from another client (%d), ignoring", senderId)
+		return
+	}
+
+	fail := func(err error) {
+		g.logger.Printf("Failed to leave guild: %v", err)
+		g.client.SocketSend(packets.NewGuildResponse(false, "leave", "", err))
+	}
+
+	if g.guild == nil {
+		fail(errors.New("you aren't in a guild"))
+		return
+	}
+
+	guild := *g.guild
+	actorId := g.player.DbId
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		// The leader can only leave once they're the last one left, which disbands the guild
+		disband := false
+		members, err := g.queries.GetGuildMembers(ctx, guild.ID)
+		if err == nil && guild.Rank == string(guilds.Leader) {
+			if len(members) > 1 {
+				err = errors.New("make someone else the leader first")
+			}
+			disband = true
+		}
+		if err == nil && disband {
+			err = g.queries.DeleteGuild(ctx, guild.ID)
+		} else if err == nil {
+			_, err = g.queries.RemoveGuildMember(ctx, db.RemoveGuildMemberParams{ActorID: actorId, GuildID: guild.ID})
+		}
+
+		g.client.Post(func() {
+			if err != nil {
+				fail(err)
+				return
+			}
+			g.client.SocketSend(packets.NewGuildResponse(true, "leave", guild.Name, nil))
+			if disband {
+				g.client.Channels().Remove(central.GuildChannel(guild.ID))
+			} else {
+				g.notifyGuild(guild.ID)
+			}
+			g.refreshGuild()
+		})
+	}()
+}
+
+func (g *InGame) handleGuildKick(senderId uint32, message *packets.Packet_GuildKick) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a request to kick someone from a guild from another client (%d), ignoring", senderId)
+		return
+	}
+
+	if !g.guildAllows("kick", guilds.Kick) {
+		return
+	}
+
+	guildId, rank := g.guild.ID, g.guild.Rank
+	g.guildOp("kick", message.GuildKick.Name, func(ctx context.Context, target db.Actor) error {
+		membership, err := g.queries.GetGuildMembership(ctx, target.ID)
+		if err != nil || membership.ID != guildId {
+			return fmt.Errorf("%s isn't in your guild", target.Name)
+		}
+		if !guilds.Outranks(rank, membership.Rank) {
+			return fmt.Errorf("you can only kick members below you, and %s is a %s", target.Name, membership.Rank)
+		}
+		_, err = g.queries.RemoveGuildMember(ctx, db.RemoveGuildMemberParams{ActorID: target.ID, GuildID: guildId})
+		return err
+	}, func(db.Actor) {
+		g.notifyGuild(guildId)
+	})
+}
+
+func (g *InGame) handleGuildSetRank(senderId uint32, message *packets.Packet_GuildSetRank) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a request to change a guild rank from another client (%d), ignoring", senderId)
+		return
+	}
+
+	if !g.guildAllows("set_rank", guilds.SetRanks) {
+		return
+	}
+
+	newRank := strings.ToLower(message.GuildSetRank.Rank)
+	if err := guilds.Validate(newRank); err != nil {
+		g.client.SocketSend(packets.NewGuildResponse(false, "set_rank", message.GuildSetRank.Name, err))
+		return
+	}
+
+	guildId, actorId := g.guild.ID, g.player.DbId
+	g.guildOp("set_rank", message.GuildSetRank.Name, func(ctx context.Context, target db.Actor) error {
+		membership, err := g.queries.GetGuildMembership(ctx, target.ID)
+		if err != nil || membership.ID != guildId {
+			return fmt.Errorf("%s isn't in your guild", target.Name)
+		}
+		_, err = g.queries.SetGuildMemberRank(ctx, db.SetGuildMemberRankParams{ActorID: target.ID, GuildID: guildId, Rank: newRank})
+		if err == nil && newRank == string(guilds.Leader) {
+			// There's only ever one leader, so making someone else it hands leadership over
+			_, err = g.queries.SetGuildMemberRank(ctx, db.SetGuildMemberRankParams{ActorID: actorId, GuildID: guildId, Rank: string(guilds.Officer)})
+		}
+		return err
+	}, func(db.Actor) {
+		g.notifyGuild(guildId)
+		g.refreshGuild()
+	})
+}
+
+// Changes someone else's place in the guild in the database, away from the level's goroutine, then comes back to tell
+// the client how it went and, if it worked, run done
+func (g *InGame) guildOp(action, name string, apply func(context.Context, db.Actor) error, done func(db.Actor)) {
+	actorId := g.player.DbId
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		target, err := g.queries.GetActorByName(ctx, name)
+		if err != nil {
+			err = fmt.Errorf("no character named %s", name)
+		} else if target.ID == actorId {
+			err = errors.New("that's you")
+		}
+		if err == nil {
+			err = apply(ctx, target)
+		}
+
+		g.client.Post(func() {
+			if err != nil {
+				g.logger.Printf("Failed to %s %s: %v", action, name, err)
+				g.client.SocketSend(packets.NewGuildResponse(false, action, name, err))
+				return
+			}
+			g.client.SocketSend(packets.NewGuildResponse(true, action, target.Name, nil))
+			done(target)
+		})
+	}()
+}
+
+func (g *InGame) handleGuildBankDeposit(senderId uint32, message *packets.Packet_GuildBankDeposit) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a guild bank deposit from another client (%d), ignoring", senderId)
+		return
+	}
+
+	if !g.guildAllows("deposit", guilds.Deposit) {
+		return
+	}
+
+	quantity := message.GuildBankDeposit.Quantity
+	itemObj, err := g.itemObjFromMessage(message.GuildBankDeposit.Item)
+	if err == nil && !itemObj.Tradeable {
+		err = fmt.Errorf("%s can't be put in the bank", itemObj.Name)
+	}
+	if err == nil && (quantity == 0 || quantity > math.MaxInt32) {
+		err = fmt.Errorf("quantity must be between 1 and %d", math.MaxInt32)
+	}
+	if err == nil && g.inventory.GetItemQuantity(*itemObj) < quantity {
+		err = fmt.Errorf("you don't have %d of that", quantity)
+	}
+	if err != nil {
+		g.client.SocketSend(packets.NewGuildResponse(false, "deposit", "", err))
+		return
+	}
+
+	// Take the items now so they can't be used twice while we wait on the database, and give them back if it fails
+	g.changeItemQuantity(itemObj, -int32(quantity))
+
+	g.bankOp("deposit", itemObj, int32(quantity), func(ctx context.Context, guildId int32) error {
+		return g.queries.AddGuildBankItem(ctx, db.AddGuildBankItemParams{GuildID: guildId, ItemID: itemObj.DbId, Quantity: int32(quantity)})
+	}, func(err error) {
+		if err != nil {
+			g.changeItemQuantity(itemObj, int32(quantity))
+		}
+	})
+}
+
+func (g *InGame) handleGuildBankWithdraw(senderId uint32, message *packets.Packet_GuildBankWithdraw) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a guild bank withdrawal from another client (%d), ignoring", senderId)
+		return
+	}
+
+	if !g.guildAllows("withdraw", guilds.Withdraw) {
+		return
+	}
+
+	quantity := message.GuildBankWithdraw.Quantity
+	itemObj, err := g.itemObjFromMessage(message.GuildBankWithdraw.Item)
+	if err == nil && (quantity == 0 || quantity > math.MaxInt32) {
+		err = fmt.Errorf("quantity must be between 1 and %d", math.MaxInt32)
+	}
+	if err != nil {
+		g.client.SocketSend(packets.NewGuildResponse(false, "withdraw", "", err))
+		return
+	}
+
+	dbTx := g.client.DbTx()
+	g.bankOp("withdraw", itemObj, -int32(quantity), func(ctx context.Context, guildId int32) error {
+		// The player only gets the items if they're gone from the bank, so both have to happen or neither
+		return dbTx.InTransaction(ctx, func(queries *db.Queries) error {
+			taken, err := queries.TakeGuildBankItem(ctx, db.TakeGuildBankItemParams{Quantity: int32(quantity), GuildID: guildId, ItemID: itemObj.DbId})
+			if err != nil {
+				return err
+			}
+			if taken == 0 {
+				return fmt.Errorf("the bank doesn't have %d of that", quantity)
+			}
+			return queries.DeleteEmptyGuildBankItems(ctx, guildId)
+		})
+	}, func(err error) {
+		if err == nil {
+			g.changeItemQuantity(itemObj, int32(quantity))
+		}
+	})
+}
+
+// Changes the guild bank in the database, away from the level's goroutine, and logs it if it worked. Then comes back to
+// run done with how it went, tell the client, and send it the bank's new contents.
+func (g *InGame) bankOp(action string, itemObj *objs.Item, quantity int32, apply func(context.Context, int32) error, done func(error)) {
+	guildId, actorId := g.guild.ID, g.player.DbId
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		err := apply(ctx, guildId)
+		if err == nil {
+			logErr := g.queries.CreateGuildBankLogEntry(ctx, db.CreateGuildBankLogEntryParams{
+				GuildID:  guildId,
+				ActorID:  pgtype.Int4{Int32: actorId, Valid: true},
+				ItemID:   itemObj.DbId,
+				Quantity: quantity,
+			})
+			if logErr != nil {
+				g.logger.Printf("Failed to log guild bank %s: %v", action, logErr)
+			}
+		}
+
+		g.client.Post(func() {
+			done(err)
+			if err != nil {
+				g.logger.Printf("Failed to %s %d %s: %v", action, quantity, itemObj.Name, err)
+				g.client.SocketSend(packets.NewGuildResponse(false, action, itemObj.Name, err))
+				return
+			}
+			g.client.SocketSend(packets.NewGuildResponse(true, action, itemObj.Name, nil))
+			g.sendGuildBank(guildId)
+		})
+	}()
+}
+
+func (g *InGame) handleRequestGuildBank(senderId uint32, _ *packets.Packet_RequestGuildBank) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a request for the guild bank from another client (%d), ignoring", senderId)
+		return
+	}
+
+	if g.guild == nil {
+		g.client.SocketSend(packets.NewGuildResponse(false, "bank", "", errors.New("you aren't in a guild")))
+		return
+	}
+	g.sendGuildBank(g.guild.ID)
+}
+
+func (g *InGame) sendGuildBank(guildId int32) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		bankItems, err := g.queries.GetGuildBankItems(ctx, guildId)
+		if err != nil {
+			g.logger.Printf("Failed to get guild bank: %v", err)
+			return
+		}
+
+		bank := ds.NewInventory()
+		for _, itemModel := range bankItems {
+			toolProps := g.client.UtilFunctions().ToolPropsFromInt4Id(itemModel.ToolPropertiesID)
+			item := objs.NewItem(itemModel.Name, itemModel.Description, itemModel.Value, itemModel.SpriteRegionX, itemModel.SpriteRegionY, toolProps, itemModel.GrantsVip, itemModel.Tradeable, itemModel.ItemID)
+			bank.AddItem(*item, uint32(itemModel.Quantity))
+		}
+		g.client.Post(func() {
+			g.client.SocketSend(packets.NewGuildBank(bank))
+		})
+	}()
+}
+
+func (g *InGame) handleRequestGuildBankLog(senderId uint32, _ *packets.Packet_RequestGuildBankLog) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a request for the guild bank log from another client (%d), ignoring", senderId)
+		return
+	}
+
+	if g.guild == nil {
+		g.client.SocketSend(packets.NewGuildResponse(false, "bank_log", "", errors.New("you aren't in a guild")))
+		return
+	}
+
+	guildId := g.guild.ID
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		logRows, err := g.queries.GetGuildBankLog(ctx, db.GetGuildBankLogParams{GuildID: guildId, Limit: guildBankLogLength})
+		if err != nil {
+			g.logger.Printf("Failed to get guild bank log: %v", err)
+			return
+		}
+
+		entries := make([]*packets.GuildBankLogEntry, len(logRows))
+		for i, row := range logRows {
+			toolProps := g.client.UtilFunctions().ToolPropsFromInt4Id(row.ToolPropertiesID)
+			item := objs.NewItem(row.Name, row.Description, row.Value, row.SpriteRegionX, row.SpriteRegionY, toolProps, row.GrantsVip, row.Tradeable, row.ItemID)
+			entries[i] = &packets.GuildBankLogEntry{
+				Name:      row.ActorName,
+				Item:      packets.NewItem(item).(*packets.Packet_Item).Item,
+				Quantity:  row.Quantity,
+				CreatedAt: row.CreatedAt.Time.Unix(),
+			}
+		}
+		g.client.Post(func() {
+			g.client.SocketSend(packets.NewGuildBankLog(entries))
+		})
+	}()
+}
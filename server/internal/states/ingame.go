@@ -6,19 +6,21 @@ import (
 	"fmt"
 	"log"
 	"math"
-	"strconv"
 	"strings"
 	"time"
 
-	goaway "github.com/TwiN/go-away"
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/appearance"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/db"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/chatfilter"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/emotes"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/items"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/npcs"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/objs"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/props"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/quests"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/roles"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/skills"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/ds"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
@@ -34,7 +36,16 @@ type InGame struct {
 	logger                 *log.Logger
 	cancelPlayerUpdateLoop context.CancelFunc
 	cancelHarvestTimer     context.CancelFunc
-	profanityDetector      *goaway.ProfanityDetector
+	userId                 int32
+	mute                   *restriction
+	friends                []db.GetFriendsRow
+	ignored                map[int32]string // Names of the characters we're ignoring, keyed by actor ID
+	changingLevel          bool             // Whether we're leaving or entering the game, or only going to another level
+	lastPartyPosition      *packets.PartyMemberPosition
+	guild                  *db.GetGuildMembershipRow // Nil if we aren't in one
+	lastReportAt           time.Time
+	lastEmoteAt            time.Time
+	roles                  []string // Loaded when we enter the game, and kept up to date by whoever changes them
 }
 
 func (g *InGame) Name() string {
@@ -46,7 +57,6 @@ func (g *InGame) SetClient(client central.ClientInterfacer) {
 	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), g.Name())
 	g.queries = client.DbTx().Queries
 	g.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
-	g.profanityDetector = goaway.NewProfanityDetector().WithCustomDictionary(client.GameData().Slurs, []string{}, []string{})
 }
 
 func (g *InGame) OnEnter() {
@@ -57,29 +67,39 @@ func (g *InGame) OnEnter() {
 		g.player.Y = 12
 	}
 
-	g.logger.Println("Sending level data to client")
-	g.sendLevel()
-
 	g.client.SharedGameObjects().Actors.Add(g.player, g.client.Id())
 
 	// Load auxiliary data
 	g.loadInventory()
 	g.loadSkillsXp()
+	g.loadRoles()
 	g.loadIsVip() // Must occur after loading inventory as it depends on the presence of VIP-granting items
+	g.loadAccount()
+	g.loadSocial()
+	g.loadGuild()
 
-	// Send our client info about all the other actors in the level (including ourselves!)
+	// Get to know all the other actors in the level (including ourselves!). Everyone in the level is simulated on this
+	// goroutine, so we can safely read their actors, but we mustn't touch anyone else's.
 	ourPlayerInfo := packets.NewActor(g.player)
-	g.client.SharedGameObjects().Actors.ForEach(func(owner_client_id uint32, actor *objs.Actor) {
-		if actor.LevelId == g.levelId {
+	g.client.Level().Clients.ForEach(func(owner_client_id uint32, _ central.ClientInterfacer) {
+		actor, exists := g.client.SharedGameObjects().Actors.Get(owner_client_id)
+		if exists && actor.LevelId == g.levelId {
 			g.othersInLevel = append(g.othersInLevel, owner_client_id)
-			g.logger.Printf("Sending actor info for client %d", owner_client_id)
-			go g.client.SocketSendAs(packets.NewActor(actor), owner_client_id)
 		}
 	})
 
-	// Send auxiliary data to the client
-	g.sendInventory()
-	g.sendSkillsXp()
+	g.sendSnapshot()
+	g.sendCommandList()
+	g.sendWorldTime()
+	g.sendSocialLists()
+
+	if !g.changingLevel {
+		g.announcePresence(true)
+		g.joinSavedChannels()
+	}
+	g.changingLevel = false
+	g.syncParty()
+	g.refreshGuild()
 
 	// Send our info back to all the other clients in the level
 	g.client.Broadcast(ourPlayerInfo, g.othersInLevel)
@@ -138,6 +158,92 @@ func (g *InGame) HandleMessage(senderId uint32, message packets.Msg) {
 		g.handleQuestInfo(senderId, message)
 	case *packets.Packet_DespawnGroundItem:
 		g.handleDespawnGroundItem(senderId, message)
+	case *packets.Packet_ChangeAppearanceRequest:
+		g.handleChangeAppearanceRequest(senderId, message)
+	case *packets.Packet_ChangePassword:
+		g.handleChangePassword(senderId, message)
+	case *packets.Packet_ResumeSession:
+		g.handleResumeSession(senderId, message)
+	case *packets.Packet_ModerationNotice:
+		g.handleModerationNotice(senderId, message)
+	case *packets.Packet_ItemQuantity:
+		g.handleItemQuantity(senderId, message)
+	case *packets.Packet_XpReward:
+		g.handleXpReward(senderId, message)
+	case *packets.Packet_AdminTeleport:
+		g.handleAdminTeleport(senderId, message)
+	case *packets.Packet_ServerMessage:
+		g.handleServerMessage(senderId, message)
+	case *packets.Packet_Whisper:
+		g.handleWhisper(senderId, message)
+	case *packets.Packet_FriendRequest:
+		g.handleFriendRequest(senderId, message)
+	case *packets.Packet_AcceptFriendRequest:
+		g.handleAcceptFriendRequest(senderId, message)
+	case *packets.Packet_RemoveFriend:
+		g.handleRemoveFriend(senderId, message)
+	case *packets.Packet_FriendPresence:
+		g.handleFriendPresence(senderId, message)
+	case *packets.Packet_IgnorePlayer:
+		g.handleIgnorePlayer(senderId, message)
+	case *packets.Packet_UnignorePlayer:
+		g.handleUnignorePlayer(senderId, message)
+	case *packets.Packet_JoinChannel:
+		g.handleJoinChannel(senderId, message)
+	case *packets.Packet_LeaveChannel:
+		g.handleLeaveChannel(senderId, message)
+	case *packets.Packet_ChannelMessage:
+		g.handleChannelMessage(senderId, message)
+	case *packets.Packet_ChannelMemberUpdate:
+		g.handleChannelMemberUpdate(senderId, message)
+	case *packets.Packet_ChannelModerate:
+		g.handleChannelModerate(senderId, message)
+	case *packets.Packet_ListChannels:
+		g.handleListChannels(senderId, message)
+	case *packets.Packet_PartyInvite:
+		g.handlePartyInvite(senderId, message)
+	case *packets.Packet_PartyAccept:
+		g.handlePartyAccept(senderId, message)
+	case *packets.Packet_PartyLeave:
+		g.handlePartyLeave(senderId, message)
+	case *packets.Packet_PartyKick:
+		g.handlePartyKick(senderId, message)
+	case *packets.Packet_Party:
+		g.handleParty(senderId, message)
+	case *packets.Packet_PartyMemberPosition:
+		g.handlePartyMemberPosition(senderId, message)
+	case *packets.Packet_PartyQuestCredit:
+		g.handlePartyQuestCredit(senderId, message)
+	case *packets.Packet_CreateGuild:
+		g.handleCreateGuild(senderId, message)
+	case *packets.Packet_GuildInvite:
+		g.handleGuildInvite(senderId, message)
+	case *packets.Packet_AcceptGuildInvite:
+		g.handleAcceptGuildInvite(senderId, message)
+	case *packets.Packet_LeaveGuild:
+		g.handleLeaveGuild(senderId, message)
+	case *packets.Packet_GuildKick:
+		g.handleGuildKick(senderId, message)
+	case *packets.Packet_GuildSetRank:
+		g.handleGuildSetRank(senderId, message)
+	case *packets.Packet_GuildInfo:
+		g.handleGuildInfo(senderId, message)
+	case *packets.Packet_GuildBankDeposit:
+		g.handleGuildBankDeposit(senderId, message)
+	case *packets.Packet_GuildBankWithdraw:
+		g.handleGuildBankWithdraw(senderId, message)
+	case *packets.Packet_RequestGuildBank:
+		g.handleRequestGuildBank(senderId, message)
+	case *packets.Packet_RequestGuildBankLog:
+		g.handleRequestGuildBankLog(senderId, message)
+	case *packets.Packet_ReportPlayer:
+		g.handleReportPlayer(senderId, message)
+	case *packets.Packet_Emote:
+		g.handleEmote(senderId, message)
+	case *packets.Packet_WorldTime:
+		g.handleWorldTime(senderId, message)
+	case *packets.Packet_AdminRoleResponse:
+		g.handleAdminRoleResponse(senderId, message)
 	}
 }
 
@@ -147,64 +253,36 @@ func (g *InGame) handleChat(senderId uint32, message *packets.Packet_Chat) {
 		return
 	}
 
-	censoredText := message.Chat.Msg
-	censored := packets.NewChat(g.profanityDetector.Censor(censoredText))
-
 	if senderId == g.client.Id() {
-		// TODO: Remove this debug code
-		if strings.HasPrefix(censoredText, "/level ") {
-			if !g.isAdmin() {
-				g.client.SocketSend(packets.NewServerMessage("You are not an admin"))
-				return
-			}
-			levelId, err := strconv.Atoi(strings.TrimPrefix(censoredText, "/level "))
-			if err != nil {
-				g.logger.Printf("Failed to parse level ID: %v", err)
-				return
-			}
-			g.switchLevel(int32(levelId))
+		if strings.HasPrefix(message.Chat.Msg, "/") {
+			g.runCommand(message.Chat.Msg)
+			return
+		}
+
+		if g.refuseIfMuted() {
+			return
+		}
+
+		msg, allowed := g.moderateChat(message.Chat.Msg)
+		if !allowed {
 			return
-			//} else if strings.HasPrefix(censoredText, "/reward ") {
-			// 	if !g.isAdmin() {
-			// 		g.client.SocketSend(packets.NewServerMessage("You are not an admin"))
-			// 		return
-			// 	}
-			// 	parts := strings.Split(strings.TrimPrefix(censoredText, "/reward "), " ")
-			// 	if len(parts) != 2 {
-			// 		g.client.SocketSend(packets.NewServerMessage("Usage: /reward <actor_name> <gold_amount>"))
-			// 		return
-			// 	}
-			// 	actorName := parts[0]
-			// 	goldAmount, err := strconv.Atoi(parts[1])
-			// 	if err != nil {
-			// 		g.logger.Printf("Failed to parse gold amount: %v", err)
-			// 		g.client.SocketSend(packets.NewServerMessage("Invalid gold amount"))
-			// 		return
-			// 	}
-			// 	recipientId := uint32(0)
-			// 	g.client.SharedGameObjects().Actors.ForEach(func(owner_client_id uint32, a *objs.Actor) {
-			// 		if a.Name == actorName {
-			// 			recipientId = owner_client_id
-			// 		}
-			// 	})
-			// 	if recipientId == 0 {
-			// 		g.client.SocketSend(packets.NewServerMessage("Actor not found"))
-			// 		return
-			// 	}
-			// 	g.client.PassToPeer(packets.NewItemQuantity(items.GoldBars, int32(goldAmount)), recipientId)
-			// 	g.client.PassToPeer(packets.NewServerMessage(fmt.Sprintf("%s has gifted you %d gold bars", g.player.Name, goldAmount)), recipientId)
-			// 	return
 		}
-		// End debug code
 
 		g.logger.Println("Received a chat message from ourselves, broadcasting")
+		g.logChat("chat", "", message.Chat.Msg, msg)
+		censored := packets.NewChat(msg)
 		g.client.Broadcast(censored, g.othersInLevel)
 		g.client.SocketSend(censored)
 		return
 	}
 
+	if g.isIgnoring(senderId) {
+		return
+	}
+
+	// The sender's already put it through the chat filter
 	g.logger.Printf("Received a chat message from client %d, forwarding", senderId)
-	g.client.SocketSendAs(censored, senderId)
+	g.client.SocketSendAs(message, senderId)
 }
 
 func (g *InGame) handleYell(senderId uint32, message *packets.Packet_Yell) {
@@ -214,16 +292,188 @@ func (g *InGame) handleYell(senderId uint32, message *packets.Packet_Yell) {
 	}
 
 	if senderId == g.client.Id() {
-		g.logger.Println("Received a yell message from ourselves, broadcasting")
-		censored := packets.NewYell(g.player.Name, g.player.IsVip, g.profanityDetector.Censor(message.Yell.Msg))
-		g.client.Broadcast(censored)
-		go g.client.SocketSend(censored)
+		g.logger.Println("Received a yell message from ourselves, sending it to the global channel")
+		g.yell(message.Yell.Msg)
+		return
+	}
+
+	if g.isIgnoring(senderId) {
 		return
 	}
 
-	censored := packets.NewYell(message.Yell.SenderName, message.Yell.IsVip, g.profanityDetector.Censor(message.Yell.Msg))
 	g.logger.Printf("Received a yell message from client %d, forwarding", senderId)
-	go g.client.SocketSendAs(censored, senderId)
+	g.client.SocketSendAs(message, senderId)
+}
+
+// Sends a message to everyone in the global channel, i.e. everyone in the game
+func (g *InGame) yell(rawMsg string) {
+	if g.refuseIfMuted() {
+		return
+	}
+
+	msg, allowed := g.moderateChat(rawMsg)
+	if !allowed {
+		return
+	}
+
+	others, sent, err := g.client.Channels().Post(central.GlobalChannel, g.client.Id(), msg)
+	if err != nil {
+		g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("Couldn't yell: %v", err)))
+		return
+	}
+
+	g.logChat("yell", "", rawMsg, sent.Msg)
+	censored := packets.NewYell(g.player.Name, g.player.IsVip, sent.Msg)
+	g.client.Broadcast(censored, others)
+	g.client.SocketSend(censored)
+}
+
+// Puts something the player wants to say through the chat filter, returning what they can say, if anything. If the
+// filter says so, the player is muted on the spot, and the mute is saved in the background.
+func (g *InGame) moderateChat(msg string) (string, bool) {
+	verdict := g.client.GameData().ChatFilter.Check(g.player.DbId, msg, time.Now())
+	if verdict.Allowed() {
+		return verdict.Msg, true
+	}
+
+	g.logger.Printf("Chat filter rule %s stopped a message (%s)", verdict.Rule, verdict.Reason)
+	if verdict.Action != chatfilter.Mute {
+		g.client.SocketSend(packets.NewServerMessage("Your message wasn't sent: " + verdict.Reason))
+		return "", false
+	}
+
+	reason := "automatic: " + verdict.Reason
+	expiresAt := expiryFromMinutes(verdict.MuteMinutes)
+	g.mute = newRestriction(reason, expiresAt)
+	g.client.SocketSend(packets.NewModerationNotice("mute", g.mute.reason, g.mute.expiresAt))
+
+	userId := g.userId
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		_, err := g.queries.CreateMute(ctx, db.CreateMuteParams{
+			UserID:    userId,
+			Reason:    reason,
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			g.logger.Printf("Failed to save an automatic mute: %v", err)
+		}
+	}()
+	return "", false
+}
+
+// Tells the player they can't talk if they're muted, returning whether they are
+func (g *InGame) refuseIfMuted() bool {
+	if !g.mute.active() {
+		return false
+	}
+	g.logger.Println("Refusing to send a message while muted")
+	g.client.SocketSend(packets.NewServerMessage("You are muted " + g.mute.describe()))
+	return true
+}
+
+func (g *InGame) handleModerationNotice(senderId uint32, message *packets.Packet_ModerationNotice) {
+	if senderId == g.client.Id() {
+		g.logger.Println("Received a moderation notice from ourselves, ignoring")
+		return
+	}
+
+	notice := message.ModerationNotice
+	g.logger.Printf("Received a %s notice from client %d", notice.Action, senderId)
+
+	switch notice.Action {
+	case "kick", "ban":
+		g.client.SocketSend(message)
+		reason := "kicked by an admin"
+		if notice.Action == "ban" {
+			reason = "banned by an admin"
+		}
+		if notice.Reason != "" {
+			reason += ": " + notice.Reason
+		}
+		g.client.Close(reason)
+	case "mute":
+		var expiresAt time.Time
+		if notice.ExpiresAt != 0 {
+			expiresAt = time.Unix(notice.ExpiresAt, 0)
+		}
+		g.mute = &restriction{reason: notice.Reason, expiresAt: expiresAt}
+		g.client.SocketSend(message)
+	case "unmute":
+		g.mute = nil
+		g.client.SocketSend(message)
+	}
+}
+
+// Items given or taken away by an admin
+func (g *InGame) handleItemQuantity(senderId uint32, message *packets.Packet_ItemQuantity) {
+	if senderId == g.client.Id() {
+		g.logger.Println("Received an item quantity message from ourselves, ignoring")
+		return
+	}
+
+	item, err := g.itemObjFromMessage(message.ItemQuantity.Item)
+	if err != nil {
+		g.logger.Printf("Failed to get item from message: %v", err)
+		return
+	}
+
+	g.logger.Printf("Client %d changed our %s by %d", senderId, item.Name, message.ItemQuantity.Quantity)
+	g.changeItemQuantity(item, message.ItemQuantity.Quantity)
+}
+
+// Gives the player some of the item, or takes some away if the quantity is negative, and tells the client
+func (g *InGame) changeItemQuantity(item *objs.Item, quantity int32) {
+	if quantity > 0 {
+		g.addInventoryItem(*item, uint32(quantity), true)
+	} else {
+		// Can't take away more than we have
+		quantity = -int32(min(uint32(-quantity), g.inventory.GetItemQuantity(*item)))
+		if quantity == 0 {
+			return
+		}
+		g.removeInventoryItem(*item, uint32(-quantity))
+	}
+	g.client.SocketSend(packets.NewItemQuantity(item, quantity))
+}
+
+// Announcements from an admin
+func (g *InGame) handleServerMessage(senderId uint32, message *packets.Packet_ServerMessage) {
+	if senderId == g.client.Id() {
+		g.logger.Println("Received a server message from ourselves, ignoring")
+		return
+	}
+
+	g.client.SocketSend(message)
+}
+
+// XP granted by an admin, or shared by a party member
+func (g *InGame) handleXpReward(senderId uint32, message *packets.Packet_XpReward) {
+	if senderId == g.client.Id() {
+		g.logger.Println("Received an XP reward from ourselves, ignoring")
+		return
+	}
+
+	skill := skills.Skill(message.XpReward.Skill)
+	if _, exists := skills.SkillNames[skill]; !exists {
+		g.logger.Printf("Client %d tried to reward XP in unknown skill %d", senderId, skill)
+		return
+	}
+
+	g.logger.Printf("Client %d rewarded us %d %s XP", senderId, message.XpReward.Xp, skills.SkillNames[skill])
+	g.awardPlayerXp(skill, message.XpReward.Xp)
+}
+
+func (g *InGame) handleAdminTeleport(senderId uint32, message *packets.Packet_AdminTeleport) {
+	if senderId == g.client.Id() {
+		g.logger.Println("Received a teleport message from ourselves, ignoring")
+		return
+	}
+
+	g.logger.Printf("Client %d teleported us to level %d (%d, %d)", senderId, message.AdminTeleport.LevelId, message.AdminTeleport.X, message.AdminTeleport.Y)
+	g.teleport(message.AdminTeleport.LevelId, message.AdminTeleport.X, message.AdminTeleport.Y)
 }
 
 func (g *InGame) handleActorMove(senderId uint32, message *packets.Packet_ActorMove) {
@@ -236,7 +486,7 @@ func (g *InGame) handleActorMove(senderId uint32, message *packets.Packet_ActorM
 
 	if abs(message.ActorMove.Dx) > 1 || abs(message.ActorMove.Dy) > 1 {
 		g.logger.Printf("Player tried to move more than one tile at once (%d, %d)", message.ActorMove.Dx, message.ActorMove.Dy)
-		go g.client.SocketSend(playerMessageBeforeMove)
+		g.client.SocketSend(playerMessageBeforeMove)
 		return
 	}
 
@@ -246,6 +496,10 @@ func (g *InGame) handleActorMove(senderId uint32, message *packets.Packet_ActorM
 	}
 
 	g.maybeCancelHarvestTimer()
+	g.setAnimation(emotes.Idle)
+
+	// Even if something's in the way, the player turns to face it
+	g.player.Face(message.ActorMove.Dx, message.ActorMove.Dy)
 
 	targetX := g.player.X + message.ActorMove.Dx
 	targetY := g.player.Y + message.ActorMove.Dy
@@ -254,7 +508,8 @@ func (g *InGame) handleActorMove(senderId uint32, message *packets.Packet_ActorM
 	// Check if the target position is in a collision point
 	if g.client.LevelPointMaps().Collisions.Contains(g.levelId, collisionPoint) {
 		// g.logger.Printf("Player tried to move to a collision point (%d, %d)", targetX, targetY)
-		go g.client.SocketSend(playerMessageBeforeMove)
+		g.client.Level().MarkActorChanged(g.client.Id())
+		g.client.SocketSend(packets.NewActor(g.player))
 		return
 	}
 
@@ -266,7 +521,7 @@ func (g *InGame) handleActorMove(senderId uint32, message *packets.Packet_ActorM
 			g.enterDoor(door)
 		} else {
 			g.logger.Printf("Tried to enter a door with key ID %d, but doesn't have the key", door.KeyId)
-			go g.client.SocketSend(packets.NewServerMessage("It's locked"))
+			g.client.SocketSend(packets.NewServerMessage("It's locked"))
 		}
 
 		return
@@ -275,11 +530,11 @@ func (g *InGame) handleActorMove(senderId uint32, message *packets.Packet_ActorM
 	g.player.X = targetX
 	g.player.Y = targetY
 
-	go g.syncPlayerLocation(500 * time.Millisecond)
+	g.syncPlayerLocation(500 * time.Millisecond)
 
 	// g.logger.Printf("Player moved to (%d, %d)", g.player.X, g.player.Y)
 
-	g.client.Broadcast(packets.NewActor(g.player), g.othersInLevel)
+	g.client.Level().MarkActorChanged(g.client.Id())
 }
 
 func (g *InGame) handleActorInfo(senderId uint32, message *packets.Packet_Actor) {
@@ -298,7 +553,10 @@ func (g *InGame) handleActorInfo(senderId uint32, message *packets.Packet_Actor)
 func (g *InGame) handleLogout(senderId uint32, message *packets.Packet_Logout) {
 	if senderId == g.client.Id() {
 		g.maybeCancelHarvestTimer()
-		g.client.SetState(&Connected{})
+		g.client.EndSession()
+		g.client.ExitLevel(func() {
+			g.client.SetState(&Connected{})
+		})
 		return
 	}
 
@@ -306,10 +564,55 @@ func (g *InGame) handleLogout(senderId uint32, message *packets.Packet_Logout) {
 	g.removeFromOtherInLevel(senderId)
 }
 
+func (g *InGame) handleChangePassword(senderId uint32, message *packets.Packet_ChangePassword) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received request to change password from another client (%d)", senderId)
+		return
+	}
+
+	// Checking and hashing passwords is slow, so do it away from the level's goroutine and come back with the result
+	actorId := g.player.DbId
+	oldPassword, newPassword := message.ChangePassword.OldPassword, message.ChangePassword.NewPassword
+	lockout := g.client.AuthLimits().LoginsByUsername
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		user, err := g.queries.GetUserByActorId(ctx, actorId)
+		if err != nil {
+			g.logger.Printf("Failed to get user for actor %d: %v", actorId, err)
+			g.client.Post(func() {
+				g.client.SocketSend(packets.NewChangePasswordResponse(false, errors.New("internal server error, please try again later")))
+			})
+			return
+		}
+
+		badDetails := false
+		if wait := lockout.Remaining(user.Username); wait > 0 {
+			err = tooManyAttemptsError(wait)
+		} else {
+			badDetails, err = changePassword(g.queries, g.logger, user, oldPassword, newPassword)
+		}
+
+		g.client.Post(func() {
+			if badDetails {
+				strike(g.client, g.logger, lockout, "login_username", user.Username)
+			}
+			if err != nil {
+				g.client.SocketSend(packets.NewChangePasswordResponse(false, err))
+				return
+			}
+			lockout.Clear(user.Username)
+			g.client.SocketSend(packets.NewChangePasswordResponse(true, nil))
+		})
+	}()
+}
+
 func (g *InGame) handleDisconnect(senderId uint32, message *packets.Packet_Disconnect) {
 	if senderId == g.client.Id() {
 		g.maybeCancelHarvestTimer()
 		g.logger.Println("Client sent a disconnect, exiting")
+		g.client.EndSession()
 		g.client.SetState(nil)
 		return
 	}
@@ -318,6 +621,19 @@ func (g *InGame) handleDisconnect(senderId uint32, message *packets.Packet_Disco
 	g.removeFromOtherInLevel(senderId)
 }
 
+// We've been handed a new connection after our old one dropped, so bring it up to speed
+func (g *InGame) handleResumeSession(senderId uint32, _ *packets.Packet_ResumeSession) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Client %d tried to resume our session, ignoring", senderId)
+		return
+	}
+
+	g.logger.Println("Resuming session on a new connection")
+	g.client.SocketSend(packets.NewResumeSessionResponse(true, g.client.StartSession(), nil))
+	g.client.SocketSend(packets.NewClientId(g.client.Id()))
+	g.sendSnapshot()
+}
+
 func (g *InGame) handlePickupGroundItemRequest(senderId uint32, message *packets.Packet_PickupGroundItemRequest) {
 	if senderId != g.client.Id() {
 		// If the client isn't us, we just forward the message
@@ -393,26 +709,20 @@ func (g *InGame) handlePickupGroundItemRequest(senderId uint32, message *packets
 	// Add the item to the player's inventory
 	g.addInventoryItem(*groundItem.Item, 1, true)
 
-	// Start the respawn time
+	// Start the respawn time. The ground item belongs to the level, so it respawns there even if we've since left
 	if groundItem.RespawnSeconds > 0 { // 0 would mean it doesn't respawn
-		go func() {
-			pickedupInLevelId := g.levelId
-			time.Sleep(time.Duration(groundItem.RespawnSeconds) * time.Second)
+		pickedupInLevelId := g.levelId
+		g.client.PostToLevel(pickedupInLevelId, time.Duration(groundItem.RespawnSeconds)*time.Second, func(level *central.Level) {
 			groundItem.Id = g.client.SharedGameObjects().GroundItems.Add(groundItem)
 
 			// Don't add the ground item to the DB. It will be respawned naturally if the server is rebooted, so there's no need
-			// TODO: at the moment, we just broadcast this to everyone and let each client decide what to do with it.
-			// If I get time, I should find a way to determine the recipient IDs based only on who is currently in the same level as the item when it respawns.
-			g.client.Broadcast(packets.NewGroundItem(groundItem.Id, groundItem, pickedupInLevelId))
-			if pickedupInLevelId == g.player.LevelId {
-				g.client.SocketSend(packets.NewGroundItem(groundItem.Id, groundItem, pickedupInLevelId))
-			}
+			level.Broadcast(g.client.Id(), packets.NewGroundItem(groundItem.Id, groundItem, pickedupInLevelId))
 			g.logger.Printf("Ground item %d respawned at (%d, %d)", groundItem.Id, groundItem.X, groundItem.Y)
-		}()
+		})
 	}
 
 	g.client.Broadcast(message, g.othersInLevel)
-	go g.client.SocketSend(packets.NewPickupGroundItemResponse(true, groundItem, g.levelId, nil))
+	g.client.SocketSend(packets.NewPickupGroundItemResponse(true, groundItem, g.levelId, nil))
 
 	g.logger.Printf("Client %d picked up ground item %d", senderId, groundItem.Id)
 }
@@ -472,7 +782,7 @@ func timeToHarvest(level uint32, toolStrength int32, harvestableStrength int32)
 func (g *InGame) handleChopShrubRequest(senderId uint32, message *packets.Packet_ChopShrubRequest) {
 	if senderId != g.client.Id() {
 		// If the client isn't us, we just forward the message
-		go g.client.SocketSendAs(message, senderId)
+		g.client.SocketSendAs(message, senderId)
 		return
 	}
 
@@ -499,23 +809,28 @@ func (g *InGame) handleChopShrubRequest(senderId uint32, message *packets.Packet
 		return
 	}
 
-	go func() {
-		g.client.SocketSend(packets.NewServerMessage("You swing your axe at the shrub..."))
-		wcLvl := skills.Level(g.player.SkillsXp[skills.Woodcutting])
-		axeStrength := g.strongestToolFor(props.ShrubHarvestable).ToolProps.Strength
-		timeToChop := timeToHarvest(wcLvl, axeStrength, shrubStrength)
+	g.client.SocketSend(packets.NewServerMessage("You swing your axe at the shrub..."))
+	g.setAnimation(emotes.Chopping)
+	wcLvl := skills.Level(g.player.SkillsXp[skills.Woodcutting])
+	axeStrength := g.strongestToolFor(props.ShrubHarvestable).ToolProps.Strength
+	timeToChop := timeToHarvest(wcLvl, axeStrength, shrubStrength)
 
-		ctx, cancel := context.WithCancel(context.Background())
-		g.cancelHarvestTimer = cancel
+	ctx, cancel := context.WithCancel(context.Background())
+	g.cancelHarvestTimer = cancel
 
-		go func() {
-			select {
-			case <-time.After(timeToChop):
-				g.chopDownShrub(message, shrub)
-			case <-ctx.Done():
-				g.logger.Println("Chopping was interrupted")
-			}
-		}()
+	go func() {
+		select {
+		case <-time.After(timeToChop):
+			g.client.Post(func() {
+				// We might have been interrupted while waiting for our turn
+				if ctx.Err() == nil {
+					g.chopDownShrub(message, shrub)
+					g.setAnimation(emotes.Idle)
+				}
+			})
+		case <-ctx.Done():
+			g.logger.Println("Chopping was interrupted")
+		}
 	}()
 }
 
@@ -533,21 +848,19 @@ func (g *InGame) chopDownShrub(message *packets.Packet_ChopShrubRequest, shrub *
 		Y:       shrub.Y,
 	})
 
-	// Start the respawn time
+	// Start the respawn time. The shrub belongs to the level, so it respawns there even if we've since left
 	if shrub.RespawnSeconds > 0 {
-		go func() {
-			time.Sleep(time.Duration(shrub.RespawnSeconds) * time.Second)
+		g.client.PostToLevel(g.levelId, time.Duration(shrub.RespawnSeconds)*time.Second, func(level *central.Level) {
 			shrub.Id = g.client.SharedGameObjects().Shrubs.Add(shrub)
-			g.queries.CreateLevelShrub(context.Background(), db.CreateLevelShrubParams{
-				LevelID:  g.levelId,
+			go g.queries.CreateLevelShrub(context.Background(), db.CreateLevelShrubParams{
+				LevelID:  level.Id,
 				Strength: shrub.Strength,
 				X:        shrub.X,
 				Y:        shrub.Y,
 			})
-			g.client.Broadcast(packets.NewShrub(shrub.Id, shrub), g.othersInLevel)
-			g.client.SocketSend(packets.NewShrub(shrub.Id, shrub))
+			level.Broadcast(g.client.Id(), packets.NewShrub(shrub.Id, shrub))
 			g.logger.Printf("Shrub %d respawned at (%d, %d)", shrub.Id, shrub.X, shrub.Y)
-		}()
+		})
 	}
 
 	// Tell all the clients in the level that the shrub was chopped
@@ -555,26 +868,20 @@ func (g *InGame) chopDownShrub(message *packets.Packet_ChopShrubRequest, shrub *
 
 	g.logger.Printf("Chopped shrub %d", shrub.Id)
 
-	// Send the response and reward the player with some XP
-	go func() {
-		g.client.SocketSend(packets.NewChopShrubResponse(true, shrub.Id, nil))
-		time.Sleep(100 * time.Millisecond) // Just to make sure the client receives the response before the XP reward
-		g.awardPlayerXp(skills.Woodcutting, 30*uint32(shrub.Strength+1))
-	}()
+	g.client.SocketSend(packets.NewChopShrubResponse(true, shrub.Id, nil))
 
-	// Award the player with some logs after a tiny delay
-	go func() {
-		time.Sleep(100 * time.Millisecond)
-		numLogs := shrub.Strength + 1
-		g.addInventoryItem(*items.Logs, uint32(numLogs), true)
-		g.client.SocketSend(packets.NewItemQuantity(items.Logs, numLogs))
-	}()
+	// Reward the player with some XP and logs. These are queued after the response, so the client sees them in that order
+	g.awardPlayerXp(skills.Woodcutting, 30*uint32(shrub.Strength+1))
+	g.sharePartyXp(skills.Woodcutting, 30*uint32(shrub.Strength+1))
+	numLogs := shrub.Strength + 1
+	g.addInventoryItem(*items.Logs, uint32(numLogs), true)
+	g.client.SocketSend(packets.NewItemQuantity(items.Logs, numLogs))
 }
 
 func (g *InGame) handleMineOreRequest(senderId uint32, message *packets.Packet_MineOreRequest) {
 	if senderId != g.client.Id() {
 		// If the client isn't us, we just forward the message
-		go g.client.SocketSendAs(message, senderId)
+		g.client.SocketSendAs(message, senderId)
 		return
 	}
 
@@ -602,6 +909,7 @@ func (g *InGame) handleMineOreRequest(senderId uint32, message *packets.Packet_M
 	}
 
 	g.client.SocketSend(packets.NewServerMessage("You swing your pickaxe at the ore..."))
+	g.setAnimation(emotes.Mining)
 	miningLvl := skills.Level(g.player.SkillsXp[skills.Mining])
 	pickaxeStrength := g.strongestToolFor(props.OreHarvestable).ToolProps.Strength
 	timeToMine := timeToHarvest(miningLvl, pickaxeStrength, oreStrength)
@@ -612,7 +920,13 @@ func (g *InGame) handleMineOreRequest(senderId uint32, message *packets.Packet_M
 	go func() {
 		select {
 		case <-time.After(timeToMine):
-			g.mineOre(message, ore)
+			g.client.Post(func() {
+				// We might have been interrupted while waiting for our turn
+				if ctx.Err() == nil {
+					g.mineOre(message, ore)
+					g.setAnimation(emotes.Idle)
+				}
+			})
 		case <-ctx.Done():
 			g.logger.Println("Mining was interrupted")
 		}
@@ -633,21 +947,19 @@ func (g *InGame) mineOre(message *packets.Packet_MineOreRequest, ore *objs.Ore)
 		Y:       ore.Y,
 	})
 
-	// Start the respawn time
+	// Start the respawn time. The ore belongs to the level, so it respawns there even if we've since left
 	if ore.RespawnSeconds > 0 {
-		go func() {
-			time.Sleep(time.Duration(ore.RespawnSeconds) * time.Second)
+		g.client.PostToLevel(g.levelId, time.Duration(ore.RespawnSeconds)*time.Second, func(level *central.Level) {
 			ore.Id = g.client.SharedGameObjects().Ores.Add(ore)
-			g.queries.CreateLevelOre(context.Background(), db.CreateLevelOreParams{
-				LevelID:  g.levelId,
+			go g.queries.CreateLevelOre(context.Background(), db.CreateLevelOreParams{
+				LevelID:  level.Id,
 				Strength: ore.Strength,
 				X:        ore.X,
 				Y:        ore.Y,
 			})
-			g.client.Broadcast(packets.NewOre(ore.Id, ore), g.othersInLevel)
-			g.client.SocketSend(packets.NewOre(ore.Id, ore))
+			level.Broadcast(g.client.Id(), packets.NewOre(ore.Id, ore))
 			g.logger.Printf("Ore %d respawned at (%d, %d)", ore.Id, ore.X, ore.Y)
-		}()
+		})
 	}
 
 	// Tell all the clients in the level that the ore was mined
@@ -655,20 +967,14 @@ func (g *InGame) mineOre(message *packets.Packet_MineOreRequest, ore *objs.Ore)
 
 	g.logger.Printf("Mined ore %d", ore.Id)
 
-	// Send the response and reward the player with some XP
-	go func() {
-		g.client.SocketSend(packets.NewMineOreResponse(true, ore.Id, nil))
-		time.Sleep(100 * time.Millisecond) // Just to make sure the client receives the response before the XP reward
-		g.awardPlayerXp(skills.Mining, 30*uint32(ore.Strength+1))
-	}()
+	g.client.SocketSend(packets.NewMineOreResponse(true, ore.Id, nil))
 
-	// Award the player with some rocks after a tiny delay
-	go func() {
-		time.Sleep(100 * time.Millisecond)
-		numRocks := ore.Strength + 1
-		g.addInventoryItem(*items.Rocks, uint32(numRocks), true)
-		g.client.SocketSend(packets.NewItemQuantity(items.Rocks, numRocks))
-	}()
+	// Reward the player with some XP and rocks. These are queued after the response, so the client sees them in that order
+	g.awardPlayerXp(skills.Mining, 30*uint32(ore.Strength+1))
+	g.sharePartyXp(skills.Mining, 30*uint32(ore.Strength+1))
+	numRocks := ore.Strength + 1
+	g.addInventoryItem(*items.Rocks, uint32(numRocks), true)
+	g.client.SocketSend(packets.NewItemQuantity(items.Rocks, numRocks))
 }
 
 func (g *InGame) itemObjFromMessage(itemMsg *packets.Item) (*objs.Item, error) {
@@ -764,26 +1070,25 @@ func (g *InGame) handleDropItemRequest(senderId uint32, message *packets.Packet_
 	// Remove the item from the player's inventory
 	g.removeInventoryItem(*itemObj, message.DropItemRequest.Quantity)
 
-	// Create the ground item
+	g.dropGroundItem(itemObj)
+}
+
+// Puts the item on the ground where the player is standing, until someone picks it up or it despawns. These aren't
+// added to the database, so they're wiped on server reboot, which is expected behavior.
+func (g *InGame) dropGroundItem(itemObj *objs.Item) {
 	const playerDropsDespawnAfterSeconds = 5 * 60
 	groundItem := objs.NewGroundItem(0, g.levelId, itemObj, g.player.X, g.player.Y, 0, playerDropsDespawnAfterSeconds)
 
 	groundItem.Id = g.client.SharedGameObjects().GroundItems.Add(groundItem)
 
-	// Start the despawn timer
-	go func() {
-		time.Sleep(playerDropsDespawnAfterSeconds * time.Second)
-		g.client.SharedGameObjects().GroundItems.Remove(groundItem.Id)
-		despawnGroundItemMsg := packets.NewDespawnGroundItem(groundItem.Id, groundItem.LevelId)
-		// TODO: at the moment, we just broadcast this to everyone and let each client decide what to do with it.
-		// If I get time, I should find a way to determine the recipient IDs based only on who is currently in the same level as the item when it respawns.
-		g.client.Broadcast(despawnGroundItemMsg)
-		if groundItem.LevelId == g.player.LevelId {
-			g.client.SocketSend(despawnGroundItemMsg)
+	// Start the despawn timer on the level the item was dropped in
+	g.client.PostToLevel(g.levelId, playerDropsDespawnAfterSeconds*time.Second, func(level *central.Level) {
+		// Someone might have picked it up in the meantime
+		if !g.client.SharedGameObjects().GroundItems.Remove(groundItem.Id) {
+			return
 		}
-	}()
-
-	// Don't add dropped items to the database. Means player-dropped items will be wiped on server reboot, which is expected behavior
+		level.Broadcast(g.client.Id(), packets.NewDespawnGroundItem(groundItem.Id, groundItem.LevelId))
+	})
 
 	g.client.Broadcast(packets.NewGroundItem(groundItem.Id, groundItem, g.levelId), g.othersInLevel)
 	g.client.SocketSend(packets.NewGroundItem(groundItem.Id, groundItem, g.levelId))
@@ -838,6 +1143,47 @@ func (g *InGame) handleInteractWithNpcRequest(senderId uint32, message *packets.
 	g.client.PassToPeer(message, actorId)
 }
 
+func (g *InGame) handleChangeAppearanceRequest(senderId uint32, message *packets.Packet_ChangeAppearanceRequest) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a change appearance request from client %d, but we only accept requests from ourselves - ignoring", senderId)
+		return
+	}
+
+	wardrobeActorId := message.ChangeAppearanceRequest.WardrobeActorId
+	err := g.checkActorIsInteractable(wardrobeActorId)
+	if err != nil {
+		g.client.SocketSend(packets.NewChangeAppearanceResponse(false, err))
+		return
+	}
+
+	wardrobe, _ := g.client.SharedGameObjects().Actors.Get(wardrobeActorId)
+	if !npcs.IsWardrobe(wardrobe) {
+		g.client.SocketSend(packets.NewChangeAppearanceResponse(false, fmt.Errorf("%s can't help you with that", wardrobe.Name)))
+		return
+	}
+
+	if message.ChangeAppearanceRequest.Appearance == nil {
+		g.client.SocketSend(packets.NewChangeAppearanceResponse(false, errors.New("No appearance given")))
+		return
+	}
+
+	newAppearance := appearanceFromMsg(message.ChangeAppearanceRequest.Appearance)
+	err = appearance.Validate(newAppearance)
+	if err != nil {
+		g.logger.Printf("Invalid appearance: %v", err)
+		g.client.SocketSend(packets.NewChangeAppearanceResponse(false, fmt.Errorf("Invalid appearance: %v", err)))
+		return
+	}
+
+	g.player.Appearance = newAppearance
+	g.player.SpriteRegionX, g.player.SpriteRegionY = newAppearance.SpriteRegion()
+	g.syncAppearance()
+
+	g.client.SocketSend(packets.NewChangeAppearanceResponse(true, nil))
+	g.client.SocketSend(packets.NewActor(g.player))
+	g.client.Level().MarkActorChanged(g.client.Id())
+}
+
 func (g *InGame) handleActorInventory(senderId uint32, message *packets.Packet_ActorInventory) {
 	if senderId == g.client.Id() {
 		g.logger.Println("Received an actor inventory message from ourselves, ignoring")
@@ -1011,22 +1357,12 @@ func (g *InGame) handleQuestInfo(senderId uint32, message *packets.Packet_QuestI
 	}
 
 	// Check if we have completed the quest
-	actorQuests, err := g.queries.GetActorQuests(context.Background(), g.player.DbId)
+	completedQuest, err := g.hasCompletedQuest(questInfo.DbId)
 	if err != nil {
 		g.logger.Printf("Failed to get actor quests: %v", err)
 		return
 	}
 
-	completedQuest := false
-	if actorQuests != nil {
-		for _, actorQuest := range actorQuests {
-			if actorQuest.QuestID == questInfo.DbId && actorQuest.Completed {
-				completedQuest = true
-				break
-			}
-		}
-	}
-
 	if !completedQuest {
 		startDialogueMsg := message.QuestInfo.StartDialogue
 		if startDialogueMsg != nil {
@@ -1043,12 +1379,14 @@ func (g *InGame) handleQuestInfo(senderId uint32, message *packets.Packet_QuestI
 			})
 
 			g.removeInventoryItem(*requiredItem, 1)
-			go g.client.SocketSendAs(packets.NewItemQuantity(requiredItem, -1), senderId)
+			g.client.SocketSendAs(packets.NewItemQuantity(requiredItem, -1), senderId)
 
-			go g.client.SocketSendAs(packets.NewNpcDialogue(message.QuestInfo.CompletedDialogue.Dialogue), senderId)
+			g.client.SocketSendAs(packets.NewNpcDialogue(message.QuestInfo.CompletedDialogue.Dialogue), senderId)
 
 			g.addInventoryItem(*rewardItem, 1, true)
-			go g.client.SocketSendAs(packets.NewItemQuantity(rewardItem, 1), senderId)
+			g.client.SocketSendAs(packets.NewItemQuantity(rewardItem, 1), senderId)
+
+			g.creditPartyQuest(message.QuestInfo)
 		}
 	} else {
 		g.client.SocketSendAs(packets.NewNpcDialogue([]string{"Thanks again for all your help!"}), senderId)
@@ -1056,6 +1394,19 @@ func (g *InGame) handleQuestInfo(senderId uint32, message *packets.Packet_QuestI
 
 }
 
+func (g *InGame) hasCompletedQuest(questId int32) (bool, error) {
+	actorQuests, err := g.queries.GetActorQuests(context.Background(), g.player.DbId)
+	if err != nil {
+		return false, err
+	}
+	for _, actorQuest := range actorQuests {
+		if actorQuest.QuestID == questId && actorQuest.Completed {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (g *InGame) handleDespawnGroundItem(senderId uint32, message *packets.Packet_DespawnGroundItem) {
 	if message.DespawnGroundItem.LevelId != g.player.LevelId {
 		g.logger.Printf("Received a despawn ground item message for level %d, but we're in level %d", message.DespawnGroundItem.LevelId, g.levelId)
@@ -1073,6 +1424,12 @@ func (g *InGame) handleGroundItem(senderId uint32, message *packets.Packet_Groun
 }
 
 func (g *InGame) OnExit() {
+	if !g.changingLevel {
+		g.announcePresence(false)
+		g.leavePartyOnExit()
+		g.leaveAllChannels()
+		g.client.GameData().ChatFilter.Forget(g.player.DbId)
+	}
 	g.client.Broadcast(packets.NewLogout(), g.othersInLevel)
 	g.client.SharedGameObjects().Actors.Remove(g.client.Id())
 	g.syncPlayerLocation(5 * time.Second)
@@ -1091,62 +1448,113 @@ func (g *InGame) removeFromOtherInLevel(clientId uint32) {
 	}
 }
 
-func (g *InGame) syncPlayerLocation(timeout time.Duration) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
+// Saves how the player looks to the database in the background
+func (g *InGame) syncAppearance() {
+	params := db.UpdateActorAppearanceParams{
+		ID:            g.player.DbId,
+		SpriteRegionX: g.player.SpriteRegionX,
+		SpriteRegionY: g.player.SpriteRegionY,
+		Body:          g.player.Appearance.Body,
+		Hair:          g.player.Appearance.Hair,
+		Outfit:        g.player.Appearance.Outfit,
+		Palette:       g.player.Appearance.Palette,
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := g.queries.UpdateActorAppearance(ctx, params); err != nil {
+			g.logger.Printf("Failed to update actor appearance: %v", err)
+		}
+	}()
+}
 
-	err := g.queries.UpdateActorLocation(ctx, db.UpdateActorLocationParams{
+// Saves the player's current location to the database in the background
+func (g *InGame) syncPlayerLocation(timeout time.Duration) {
+	// Take a snapshot now, since the player might have moved on by the time the query runs
+	params := db.UpdateActorLocationParams{
 		LevelID: pgtype.Int4{Int32: g.levelId, Valid: true},
 		X:       g.player.X,
 		Y:       g.player.Y,
 		ID:      g.player.DbId,
-	})
+	}
 
-	if err != nil {
-		g.logger.Printf("Failed to update actor position: %v", err)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		if err := g.queries.UpdateActorLocation(ctx, params); err != nil {
+			g.logger.Printf("Failed to update actor position: %v", err)
+		}
+	}()
+}
+
+// Sends everything the client needs to know about the level and ourselves together in one snapshot, so it never sees
+// half a level. After this, it only hears about what changes.
+func (g *InGame) sendSnapshot() {
+	snapshot := g.levelSnapshot()
+
+	for _, owner_client_id := range g.othersInLevel {
+		if actor, exists := g.client.SharedGameObjects().Actors.Get(owner_client_id); exists {
+			snapshot = append(snapshot, &packets.Packet{SenderId: owner_client_id, Msg: packets.NewActor(actor)})
+		}
 	}
+
+	snapshot = append(snapshot,
+		&packets.Packet{SenderId: g.client.Id(), Msg: packets.NewInventory(g.inventory)},
+		&packets.Packet{SenderId: g.client.Id(), Msg: packets.NewSkillsXp(g.player.SkillsXp)},
+	)
+
+	g.logger.Printf("Sending level snapshot of %d packets to client", len(snapshot))
+	g.client.SocketSendBatch(snapshot...)
 }
 
-func (g *InGame) sendLevel() {
+// Builds the packets describing the level and everything in it, in the order the client needs them
+func (g *InGame) levelSnapshot() []*packets.Packet {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	snapshot := make([]*packets.Packet, 0)
+	add := func(message packets.Msg) {
+		snapshot = append(snapshot, &packets.Packet{SenderId: g.client.Id(), Msg: message})
+	}
+
 	levelTscnData, err := g.queries.GetLevelTscnDataByLevelId(ctx, g.levelId)
 	if err != nil {
 		g.logger.Printf("Failed to get level tscn data for level %d: %v", g.levelId, err)
-		return
+		return snapshot
 	}
+	add(packets.NewLevelDownload(levelTscnData.TscnData))
 
-	g.logger.Printf("Sending level data...")
-	g.client.SocketSend(packets.NewLevelDownload(levelTscnData.TscnData))
-
-	g.logger.Printf("Sending shared game objects...")
 	g.client.SharedGameObjects().GroundItems.ForEach(func(id uint32, groundItem *objs.GroundItem) {
 		if groundItem.LevelId == g.levelId {
-			go g.client.SocketSend(packets.NewGroundItem(id, groundItem, g.levelId))
+			add(packets.NewGroundItem(id, groundItem, g.levelId))
 		}
 	})
 	g.client.SharedGameObjects().Doors.ForEach(func(id uint32, door *objs.Door) {
 		if door.LevelId != g.levelId {
 			return
 		}
-		destinationGdResPath, err := g.queries.GetLevelById(context.Background(), door.DestinationLevelId)
+		destinationGdResPath, err := g.queries.GetLevelById(ctx, door.DestinationLevelId)
 		if err != nil {
 			g.logger.Printf("Failed to get destination level gd res path for door: %v", err)
 			return
 		}
-		go g.client.SocketSend(packets.NewDoor(id, door, destinationGdResPath.GdResPath))
+		add(packets.NewDoor(id, door, destinationGdResPath.GdResPath))
 	})
 	g.client.SharedGameObjects().Shrubs.ForEach(func(id uint32, shrub *objs.Shrub) {
 		if shrub.LevelId == g.levelId {
-			go g.client.SocketSend(packets.NewShrub(id, shrub))
+			add(packets.NewShrub(id, shrub))
 		}
 	})
 	g.client.SharedGameObjects().Ores.ForEach(func(id uint32, ore *objs.Ore) {
 		if ore.LevelId == g.levelId {
-			go g.client.SocketSend(packets.NewOre(id, ore))
+			add(packets.NewOre(id, ore))
 		}
 	})
+
+	return snapshot
 }
 
 func (g *InGame) loadInventory() {
@@ -1168,12 +1576,6 @@ func (g *InGame) loadInventory() {
 	g.logger.Printf("Loaded inventory with %d rows", g.inventory.GetNumRows())
 }
 
-func (g *InGame) sendInventory() {
-	g.logger.Println("Sending inventory to client")
-	g.client.SocketSend(packets.NewInventory(g.inventory))
-	g.logger.Println("Sent inventory to client")
-}
-
 func (g *InGame) loadSkillsXp() {
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
@@ -1210,10 +1612,22 @@ func (g *InGame) loadIsVip() {
 	})
 }
 
-func (g *InGame) sendSkillsXp() {
-	g.logger.Println("Sending skills XP to client")
-	g.client.SocketSend(packets.NewSkillsXp(g.player.SkillsXp))
-	g.logger.Println("Sent skills XP to client")
+// Loads what we need to know about the account the player belongs to, e.g. whether it's muted
+func (g *InGame) loadAccount() {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	user, err := g.queries.GetUserByActorId(ctx, g.player.DbId)
+	if err != nil {
+		g.logger.Printf("Failed to get user for actor %d: %v", g.player.DbId, err)
+		return
+	}
+	g.userId = user.ID
+
+	g.mute, err = activeMute(ctx, g.queries, user.ID)
+	if err != nil {
+		g.logger.Printf("Failed to get mute for user %d: %v", user.ID, err)
+	}
 }
 
 func (g *InGame) playerUpdateLoop(ctx context.Context) {
@@ -1224,7 +1638,10 @@ func (g *InGame) playerUpdateLoop(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			g.syncPlayerLocation(1 * time.Second)
+			g.client.Post(func() {
+				g.syncPlayerLocation(1 * time.Second)
+				g.sendPartyPosition()
+			})
 		case <-ctx.Done():
 			return
 		}
@@ -1232,32 +1649,48 @@ func (g *InGame) playerUpdateLoop(ctx context.Context) {
 }
 
 // TODO: Remove this when removing debug chat command
-func (g *InGame) switchLevel(newLevelId int32) {
-	g.queries.UpdateActorLevel(context.Background(), db.UpdateActorLevelParams{
-		ID:      g.player.DbId,
-		LevelID: pgtype.Int4{Int32: newLevelId, Valid: true},
-	})
-	g.client.SetState(&InGame{
-		levelId:   newLevelId,
-		player:    g.player,
-		inventory: g.inventory,
-	})
+// Moves the player straight to the given tile, in this level or another
+func (g *InGame) teleport(levelId int32, x int32, y int32) {
+	g.maybeCancelHarvestTimer()
+
+	if levelId == g.levelId {
+		g.player.X = x
+		g.player.Y = y
+		g.syncPlayerLocation(500 * time.Millisecond)
+		g.client.SocketSend(packets.NewActor(g.player))
+		g.client.Level().MarkActorChanged(g.client.Id())
+		return
+	}
+
+	g.moveToLevel(levelId, x, y)
 }
 
 func (g *InGame) enterDoor(door *objs.Door) {
-	g.player.X = door.DestinationX
-	g.player.Y = door.DestinationY
-	g.player.LevelId = door.DestinationLevelId
-	go g.syncPlayerLocation(500 * time.Millisecond)
+	g.moveToLevel(door.DestinationLevelId, door.DestinationX, door.DestinationY)
+}
+
+func (g *InGame) moveToLevel(levelId int32, x int32, y int32) {
+	g.player.X = x
+	g.player.Y = y
+	g.player.LevelId = levelId
+	g.player.Animation = emotes.Idle // Everyone in the next level sees us arrive on our feet
+	g.player.Tick = g.client.Level().Tick()
+	g.syncPlayerLocation(500 * time.Millisecond)
 	go g.queries.UpdateActorLevel(context.Background(), db.UpdateActorLevelParams{
 		ID:      g.player.DbId,
-		LevelID: pgtype.Int4{Int32: door.DestinationLevelId, Valid: true},
+		LevelID: pgtype.Int4{Int32: levelId, Valid: true},
 	})
 
-	g.client.SetState(&InGame{
-		levelId:   door.DestinationLevelId,
-		player:    g.player,
-		inventory: g.inventory,
+	// Hand ourselves off to the destination level's simulation, and only enter the game there once it picks us up
+	g.client.EnterLevel(levelId, func() {
+		g.changingLevel = true
+		g.client.SetState(&InGame{
+			levelId:       levelId,
+			player:        g.player,
+			inventory:     g.inventory,
+			changingLevel: true,
+			lastReportAt:  g.lastReportAt, // Or walking through a door would get around the report cooldown
+		})
 	})
 }
 
@@ -1274,16 +1707,41 @@ func (g *InGame) hasKey(keyId int32) bool {
 	return keyInInv
 }
 
-func (g *InGame) isAdmin() bool {
-	_, err := g.queries.IsActorAdmin(context.Background(), g.player.DbId)
-	if err == nil {
-		return true
-	} else if err == pgx.ErrNoRows {
-		return false
-	} else {
-		g.logger.Printf("Failed to check if actor is admin: %v", err)
-		return false
+func (g *InGame) loadRoles() {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	userRoles, err := g.queries.GetRolesByActorId(ctx, g.player.DbId)
+	if err != nil {
+		g.logger.Printf("Failed to get roles for actor: %v", err)
+		return
 	}
+	g.roles = userRoles
+}
+
+func (g *InGame) userRoles() []string {
+	return g.roles
+}
+
+// An admin has granted or revoked one of our roles, which takes effect straight away
+func (g *InGame) handleAdminRoleResponse(senderId uint32, message *packets.Packet_AdminRoleResponse) {
+	if senderId == g.client.Id() {
+		g.logger.Println("Received a role change from ourselves, ignoring")
+		return
+	}
+
+	g.roles = message.AdminRoleResponse.Roles
+	g.logger.Printf("Roles changed to %v", g.roles)
+	g.sendCommandList()
+}
+
+// Whether the player has any role at all
+func (g *InGame) isAdmin() bool {
+	return len(g.userRoles()) > 0
+}
+
+func (g *InGame) hasPermission(permission roles.Permission) bool {
+	return roles.Has(g.userRoles(), permission)
 }
 
 func (g *InGame) isOtherKnown(otherId uint32) bool {
@@ -1300,18 +1758,19 @@ func (g *InGame) addInventoryItem(item objs.Item, quantity uint32, addToDb bool)
 
 	if item.GrantsVip {
 		g.player.IsVip = true
-		go g.client.SocketSend(packets.NewActor(g.player))
+		g.client.SocketSend(packets.NewActor(g.player))
 	}
 
 	if addToDb {
+		params := db.AddActorInventoryItemParams{
+			ActorID:  g.player.DbId,
+			ItemID:   item.DbId,
+			Quantity: int32(quantity),
+		}
 		go func() {
 			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 			defer cancel()
-			g.queries.AddActorInventoryItem(ctx, db.AddActorInventoryItemParams{
-				ActorID:  g.player.DbId,
-				ItemID:   item.DbId,
-				Quantity: int32(quantity),
-			})
+			g.queries.AddActorInventoryItem(ctx, params)
 		}()
 	}
 }
@@ -1321,21 +1780,22 @@ func (g *InGame) removeInventoryItem(item objs.Item, quantity uint32) {
 
 	if item.GrantsVip {
 		g.player.IsVip = false
-		go g.client.SocketSend(packets.NewActor(g.player))
+		g.client.SocketSend(packets.NewActor(g.player))
 	}
 
+	actorId := g.player.DbId
 	go func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
 		if qtyRemaining <= 0 {
 			g.queries.RemoveActorInventoryItem(ctx, db.RemoveActorInventoryItemParams{
-				ActorID: g.player.DbId,
+				ActorID: actorId,
 				ItemID:  item.DbId,
 			})
 		} else {
 			g.queries.UpsertActorInventoryItem(ctx, db.UpsertActorInventoryItemParams{
-				ActorID:  g.player.DbId,
+				ActorID:  actorId,
 				ItemID:   item.DbId,
 				Quantity: qtyRemaining,
 			})
@@ -1355,21 +1815,22 @@ func (g *InGame) syncInventory() {
 }
 
 func (g *InGame) awardPlayerXp(skill skills.Skill, xp uint32) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
+	g.player.SkillsXp[skill] += xp
 	g.client.SocketSend(packets.NewXpReward(skill, xp))
 
-	err := g.queries.AddActorXp(ctx, db.AddActorXpParams{
+	params := db.AddActorXpParams{
 		ActorID: g.player.DbId,
 		Skill:   int32(skill),
 		Xp:      int32(xp),
-	})
-	if err != nil {
-		g.logger.Printf("Failed to add XP to actor in database: %v", err)
 	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
 
-	g.player.SkillsXp[skill] += xp
+		if err := g.queries.AddActorXp(ctx, params); err != nil {
+			g.logger.Printf("Failed to add XP to actor in database: %v", err)
+		}
+	}()
 }
 
 func (g *InGame) maybeCancelHarvestTimer() {
@@ -1377,6 +1838,9 @@ func (g *InGame) maybeCancelHarvestTimer() {
 		g.cancelHarvestTimer()
 		g.cancelHarvestTimer = nil
 	}
+	if !g.player.Animation.IsEmote() {
+		g.setAnimation(emotes.Idle)
+	}
 }
 
 func abs(x int32) int32 {
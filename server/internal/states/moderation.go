@@ -0,0 +1,132 @@
+package states
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/db"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/roles"
+)
+
+// A ban or mute, as far as the player it applies to is concerned
+type restriction struct {
+	reason    string
+	expiresAt time.Time // Zero if it never expires
+}
+
+func newRestriction(reason string, expiresAt pgtype.Timestamp) *restriction {
+	r := &restriction{reason: reason}
+	if expiresAt.Valid {
+		r.expiresAt = expiresAt.Time
+	}
+	return r
+}
+
+func (r *restriction) active() bool {
+	return r != nil && (r.expiresAt.IsZero() || time.Now().Before(r.expiresAt))
+}
+
+// E.g. "until 2006-01-02 15:04 UTC (spamming)"
+func (r *restriction) describe() string {
+	until := "permanently"
+	if !r.expiresAt.IsZero() {
+		until = "until " + r.expiresAt.UTC().Format("2006-01-02 15:04 MST")
+	}
+	if r.reason == "" {
+		return until
+	}
+	return fmt.Sprintf("%s (%s)", until, r.reason)
+}
+
+// When a ban or mute of the given length should expire, where 0 means never
+func expiryFromMinutes(minutes uint32) pgtype.Timestamp {
+	if minutes == 0 {
+		return pgtype.Timestamp{}
+	}
+	return pgtype.Timestamp{Time: time.Now().UTC().Add(time.Duration(minutes) * time.Minute), Valid: true}
+}
+
+// Finds the client playing one of the user's characters, if any
+func findUserInGame(client central.ClientInterfacer, queries *db.Queries, userId int32) (uint32, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	characters, err := queries.GetActorsByUserId(ctx, userId)
+	if err != nil {
+		return 0, false, err
+	}
+
+	for _, character := range characters {
+		if clientId, found := characterClientId(client, character.ID); found {
+			return clientId, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// Refuses to let an admin with the given roles act on a user who can do anything they can't, e.g. a moderator
+// resetting a superadmin's password to take over their account
+func checkCanActOn(ctx context.Context, queries *db.Queries, adminRoles []string, user db.User) error {
+	userRoles, err := queries.GetRolesByUserId(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get %s's roles: %v", user.Username, err)
+	}
+	if !roles.CanActOn(adminRoles, userRoles) {
+		return fmt.Errorf("%s's roles %v outrank yours", user.Username, userRoles)
+	}
+	return nil
+}
+
+// Records something an admin did, in the background
+func writeAuditLog(queries *db.Queries, logger *log.Logger, adminUserId int32, action, target, details string) {
+	writeReportAuditLog(queries, logger, adminUserId, action, target, details, pgtype.Int4{})
+}
+
+// Records something an admin did about a player's report, in the background
+func writeReportAuditLog(queries *db.Queries, logger *log.Logger, adminUserId int32, action, target, details string, reportId pgtype.Int4) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		err := queries.CreateAdminAuditLogEntry(ctx, db.CreateAdminAuditLogEntryParams{
+			AdminUserID: pgtype.Int4{Int32: adminUserId, Valid: true},
+			Action:      action,
+			Target:      target,
+			Details:     details,
+			ReportID:    reportId,
+		})
+		if err != nil {
+			logger.Printf("Failed to write %s of %s to the audit log: %v", action, target, err)
+		}
+	}()
+}
+
+// Returns the user's ban if they have one that hasn't expired or been lifted, or nil
+func activeBan(ctx context.Context, queries *db.Queries, userId int32) (*restriction, error) {
+	ban, err := queries.GetActiveBan(ctx, userId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newRestriction(ban.Reason, ban.ExpiresAt), nil
+}
+
+// Returns the user's mute if they have one that hasn't expired or been lifted, or nil
+func activeMute(ctx context.Context, queries *db.Queries, userId int32) (*restriction, error) {
+	mute, err := queries.GetActiveMute(ctx, userId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newRestriction(mute.Reason, mute.ExpiresAt), nil
+}
@@ -23,6 +23,8 @@ type NpcWithDialogue struct {
 	initialY       int32
 	logger         *log.Logger
 	cancelMoveLoop context.CancelFunc
+	previousDx     int32
+	previousDy     int32
 }
 
 func (n *NpcWithDialogue) Name() string {
@@ -62,10 +64,12 @@ func (n *NpcWithDialogue) OnEnter() {
 
 	n.client.SharedGameObjects().Actors.Add(n.Npc.Actor, n.client.Id())
 
-	// Collect info about all the other actors in the level
+	// Collect info about all the other actors in the level. They're all simulated on this goroutine, so it's safe to
+	// read their actors here.
 	ourActorInfo := packets.NewActor(n.Npc.Actor)
-	n.client.SharedGameObjects().Actors.ForEach(func(owner_client_id uint32, actor *objs.Actor) {
-		if actor.LevelId == n.Npc.LevelId && !actor.IsNpc {
+	n.client.Level().Clients.ForEach(func(owner_client_id uint32, _ central.ClientInterfacer) {
+		actor, exists := n.client.SharedGameObjects().Actors.Get(owner_client_id)
+		if exists && actor.LevelId == n.Npc.LevelId && !actor.IsNpc {
 			n.othersInLevel = append(n.othersInLevel, owner_client_id)
 		}
 	})
@@ -157,31 +161,14 @@ func (n *NpcWithDialogue) isOtherKnown(otherId uint32) bool {
 // TODO: This is duplicate code from npc_merchant.go. Refactor this into a common place.
 func (n *NpcWithDialogue) moveLoop(ctx context.Context) {
 	sleepTime := 2 * time.Second
-	previousDx := int32(0)
-	previousDy := int32(0)
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case <-time.After(sleepTime):
-			dx := rand.Int32N(3) - 1
-			dy := rand.Int32N(3) - 1
-
 			// If it hasn't been long since the last move, we want to try and keep moving in the same direction
 			// to avoid it looking too erratic
-			if sleepTime < 500*time.Millisecond {
-				dx = previousDx
-				dy = previousDy
-			}
-
-			if dx != 0 && dy != 0 {
-				// Choose one direction to move in, can't move diagonally
-				if rand.Int32N(2) == 0 {
-					dx = 0
-				} else {
-					dy = 0
-				}
-			}
+			keepDirection := sleepTime < 500*time.Millisecond
 
 			// Determine how long to wait before moving again
 			if rand.IntN(5) == 0 {
@@ -192,58 +179,78 @@ func (n *NpcWithDialogue) moveLoop(ctx context.Context) {
 				sleepTime = time.Duration(1+rand.IntN(5)) * time.Second // Otherwise, wait between 200ms and 1s
 			}
 
-			// Don't move if it's going to cause them to stray too far from their initial position
-			if n.Npc.Actor.X+dx < n.initialX-5 || n.Npc.Actor.X+dx > n.initialX+5 {
-				dx = 0
-			}
-			if n.Npc.Actor.Y+dy < n.initialY-5 || n.Npc.Actor.Y+dy > n.initialY+5 {
-				dy = 0
-			}
+			// This loop only keeps time; the step itself touches our actor so it has to happen on our own goroutine
+			n.client.Post(func() {
+				if ctx.Err() == nil {
+					n.wander(keepDirection)
+				}
+			})
+		}
+	}
+}
 
-			if dx == 0 && dy == 0 {
-				continue
-			}
+// Takes a random step, unless a player is nearby. Must be run on the client's own goroutine.
+func (n *NpcWithDialogue) wander(keepDirection bool) {
+	dx := rand.Int32N(3) - 1
+	dy := rand.Int32N(3) - 1
 
-			// Don't move if there is a player within 3 squares of the NPC because they could be trying to interact
-			playerTooClose := false
-			for _, id := range n.othersInLevel {
-				actor, exists := n.client.SharedGameObjects().Actors.Get(id)
-				if !exists {
-					continue
-				}
+	if keepDirection {
+		dx = n.previousDx
+		dy = n.previousDy
+	}
 
-				// Don't care about NPCs
-				if actor.IsNpc {
-					continue
-				}
+	if dx != 0 && dy != 0 {
+		// Choose one direction to move in, can't move diagonally
+		if rand.Int32N(2) == 0 {
+			dx = 0
+		} else {
+			dy = 0
+		}
+	}
 
-				if actor.LevelId != n.Npc.LevelId { // This should never happen since we're looping through the othersInLevel map but it doesn't hurt to check
-					continue
-				}
+	// Don't move if it's going to cause them to stray too far from their initial position
+	if n.Npc.Actor.X+dx < n.initialX-5 || n.Npc.Actor.X+dx > n.initialX+5 {
+		dx = 0
+	}
+	if n.Npc.Actor.Y+dy < n.initialY-5 || n.Npc.Actor.Y+dy > n.initialY+5 {
+		dy = 0
+	}
 
-				if actor.X > n.Npc.Actor.X-3 && actor.X < n.Npc.Actor.X+3 && actor.Y > n.Npc.Actor.Y-3 && actor.Y < n.Npc.Actor.Y+3 {
-					playerTooClose = true
-					break
-				}
-			}
+	if dx == 0 && dy == 0 {
+		return
+	}
 
-			if playerTooClose {
-				n.logger.Printf("Player is too close to NPC, not moving")
-				continue
-			}
+	// Don't move if there is a player within 3 squares of the NPC because they could be trying to interact
+	for _, id := range n.othersInLevel {
+		actor, exists := n.client.SharedGameObjects().Actors.Get(id)
+		if !exists {
+			continue
+		}
 
-			n.move(dx, dy)
-			previousDx = dx
-			previousDy = dy
+		// Don't care about NPCs
+		if actor.IsNpc {
+			continue
+		}
 
-			// Check if we are all alone. If so, we can stop the move loop (it will start again if someone joins the level)
-			if len(n.othersInLevel) <= 0 {
-				n.cancelMoveLoop()
-				n.cancelMoveLoop = nil
-				return
-			}
+		if actor.LevelId != n.Npc.LevelId { // This should never happen since we're looping through the othersInLevel map but it doesn't hurt to check
+			continue
+		}
+
+		if actor.X > n.Npc.Actor.X-3 && actor.X < n.Npc.Actor.X+3 && actor.Y > n.Npc.Actor.Y-3 && actor.Y < n.Npc.Actor.Y+3 {
+			n.logger.Printf("Player is too close to NPC, not moving")
+			return
 		}
 	}
+
+	n.move(dx, dy)
+	n.previousDx = dx
+	n.previousDy = dy
+
+	// Check if we are all alone. If so, we can stop the move loop (it will start again if someone joins the level)
+	if len(n.othersInLevel) <= 0 {
+		n.cancelMoveLoop()
+		n.cancelMoveLoop = nil
+	}
 }
 
 func (n *NpcWithDialogue) move(dx, dy int32) {
@@ -259,8 +266,9 @@ func (n *NpcWithDialogue) move(dx, dy int32) {
 
 	n.Npc.Actor.X = targetX
 	n.Npc.Actor.Y = targetY
+	n.Npc.Actor.Face(dx, dy)
 
 	// n.logger.Printf("Actor moved to (%d, %d)", n.Npc.Actor.X, n.Npc.Actor.Y)
 
-	n.client.Broadcast(packets.NewActor(n.Npc.Actor), n.othersInLevel)
+	n.client.Level().MarkActorChanged(n.client.Id())
 }
@@ -0,0 +1,274 @@
+package states
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/db"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/skills"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+)
+
+// Parties for a player in the game. The parties themselves live in the hub, and each member's state keeps its own
+// client and party channel in step with them.
+
+const (
+	// How many tiles apart party members can be in the same level and still share XP and quest credit
+	partyShareDistance int32 = 10
+
+	// Party members get this fraction of each other's harvesting XP, on top of their own
+	partyXpShareDivisor uint32 = 4
+)
+
+func (g *InGame) handlePartyInvite(senderId uint32, message *packets.Packet_PartyInvite) {
+	if senderId != g.client.Id() {
+		if g.isIgnoring(senderId) {
+			g.logger.Printf("Dropping a party invitation from %s, who we're ignoring", message.PartyInvite.Name)
+			return
+		}
+		if g.client.ProtocolVersion() < packets.PartiesProtocolVersion {
+			g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("%s invited you to a party, but your game needs updating to join", message.PartyInvite.Name)))
+			return
+		}
+		g.client.SocketSend(message)
+		return
+	}
+
+	inviteeId, invitee, err := g.findPlayer(message.PartyInvite.Name)
+	if err == nil && inviteeId == g.client.Id() {
+		err = errors.New("you can't invite yourself")
+	}
+	if err == nil {
+		err = g.client.Parties().Invite(g.client.Id(), inviteeId)
+	}
+	if err != nil {
+		g.client.SocketSend(packets.NewPartyResponse(false, "invite", message.PartyInvite.Name, err))
+		return
+	}
+
+	g.client.PassToPeer(packets.NewPartyInvite(g.player.Name), inviteeId)
+	g.client.SocketSend(packets.NewPartyResponse(true, "invite", invitee.Name, nil))
+}
+
+func (g *InGame) handlePartyAccept(senderId uint32, message *packets.Packet_PartyAccept) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a party acceptance from another client (%d), ignoring", senderId)
+		return
+	}
+
+	inviterId, inviter, err := g.findPlayer(message.PartyAccept.Name)
+	if err == nil {
+		_, err = g.client.Parties().Accept(g.client.Id(), inviterId)
+	}
+	if err != nil {
+		g.client.SocketSend(packets.NewPartyResponse(false, "accept", message.PartyAccept.Name, err))
+		return
+	}
+
+	g.client.SocketSend(packets.NewPartyResponse(true, "accept", inviter.Name, nil))
+	g.syncParty()
+	if _, _, memberIds, inParty := g.client.Parties().Get(g.client.Id()); inParty {
+		g.notifyParty(memberIds)
+	}
+}
+
+func (g *InGame) handlePartyLeave(senderId uint32, _ *packets.Packet_PartyLeave) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a request to leave a party from another client (%d), ignoring", senderId)
+		return
+	}
+
+	remaining, wasInParty := g.client.Parties().Leave(g.client.Id())
+	if !wasInParty {
+		g.client.SocketSend(packets.NewPartyResponse(false, "leave", "", errors.New("you aren't in a party")))
+		return
+	}
+
+	g.client.SocketSend(packets.NewPartyResponse(true, "leave", "", nil))
+	g.syncParty()
+	g.notifyParty(remaining)
+}
+
+func (g *InGame) handlePartyKick(senderId uint32, message *packets.Packet_PartyKick) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a request to kick someone from a party from another client (%d), ignoring", senderId)
+		return
+	}
+
+	memberId, member, err := g.findPlayer(message.PartyKick.Name)
+	if err == nil && memberId == g.client.Id() {
+		err = errors.New("leave the party instead")
+	}
+	var remaining []uint32
+	if err == nil {
+		remaining, err = g.client.Parties().Kick(g.client.Id(), memberId)
+	}
+	if err != nil {
+		g.client.SocketSend(packets.NewPartyResponse(false, "kick", message.PartyKick.Name, err))
+		return
+	}
+
+	g.client.SocketSend(packets.NewPartyResponse(true, "kick", member.Name, nil))
+	g.syncParty()
+	g.notifyParty(append(remaining, memberId))
+}
+
+// Someone else changed the party we're in, or were in
+func (g *InGame) handleParty(senderId uint32, _ *packets.Packet_Party) {
+	if senderId == g.client.Id() {
+		g.logger.Println("Received a party update from ourselves, ignoring")
+		return
+	}
+	g.syncParty()
+}
+
+func (g *InGame) handlePartyMemberPosition(senderId uint32, message *packets.Packet_PartyMemberPosition) {
+	if senderId == g.client.Id() {
+		g.logger.Println("Received a party member's position from ourselves, ignoring")
+		return
+	}
+
+	if g.client.ProtocolVersion() >= packets.PartiesProtocolVersion {
+		g.client.SocketSend(message)
+	}
+}
+
+// Tells the party members their party has changed. Each of them looks it up again for themselves, in case it's changed
+// again by the time they hear about it.
+func (g *InGame) notifyParty(memberIds []uint32) {
+	g.client.Broadcast(packets.NewParty(nil, ""), memberIds)
+}
+
+// Takes the player out of their party when they leave the game
+func (g *InGame) leavePartyOnExit() {
+	if remaining, wasInParty := g.client.Parties().Leave(g.client.Id()); wasInParty {
+		g.notifyParty(remaining)
+	}
+}
+
+// Puts the player in their party's channel, or takes them out of their old one, then tells the client who's in the
+// party now and everyone else where we are
+func (g *InGame) syncParty() {
+	partyId, leaderId, memberIds, inParty := g.client.Parties().Get(g.client.Id())
+
+	channel := ""
+	if inParty {
+		channel = central.PartyChannel(partyId)
+	}
+
+	g.syncChannelOfKind(central.ChannelParty, channel)
+
+	if g.client.ProtocolVersion() >= packets.PartiesProtocolVersion {
+		members := make([]*packets.PartyMember, 0, len(memberIds))
+		for _, memberId := range memberIds {
+			if actor, exists := g.client.SharedGameObjects().Actors.Get(memberId); exists {
+				members = append(members, &packets.PartyMember{Name: actor.Name, Leader: memberId == leaderId})
+			}
+		}
+		g.client.SocketSend(packets.NewParty(members, channel))
+	}
+
+	g.lastPartyPosition = nil
+	g.sendPartyPosition()
+}
+
+// Tells the rest of the party where we are, if we've moved since we last did
+func (g *InGame) sendPartyPosition() {
+	_, _, memberIds, inParty := g.client.Parties().Get(g.client.Id())
+	if !inParty {
+		return
+	}
+
+	if last := g.lastPartyPosition; last != nil && last.LevelId == g.levelId && last.X == g.player.X && last.Y == g.player.Y {
+		return
+	}
+	g.lastPartyPosition = &packets.PartyMemberPosition{Name: g.player.Name, LevelId: g.levelId, X: g.player.X, Y: g.player.Y}
+	g.client.Broadcast(&packets.Packet_PartyMemberPosition{PartyMemberPosition: g.lastPartyPosition}, memberIds)
+}
+
+// The other party members in this level close enough to share in what we do. They're simulated on this goroutine, so
+// we can read where they are.
+func (g *InGame) nearbyPartyMembers() []uint32 {
+	_, _, memberIds, inParty := g.client.Parties().Get(g.client.Id())
+	if !inParty {
+		return nil
+	}
+
+	nearby := make([]uint32, 0, len(memberIds))
+	for _, memberId := range memberIds {
+		if memberId == g.client.Id() || !g.isOtherKnown(memberId) {
+			continue
+		}
+		actor, exists := g.client.SharedGameObjects().Actors.Get(memberId)
+		if !exists || actor.LevelId != g.levelId {
+			continue
+		}
+		if abs(actor.X-g.player.X) <= partyShareDistance && abs(actor.Y-g.player.Y) <= partyShareDistance {
+			nearby = append(nearby, memberId)
+		}
+	}
+	return nearby
+}
+
+// Gives nearby party members a share of the XP we've just earned harvesting
+func (g *InGame) sharePartyXp(skill skills.Skill, xp uint32) {
+	share := xp / partyXpShareDivisor
+	if share == 0 {
+		return
+	}
+	for _, memberId := range g.nearbyPartyMembers() {
+		g.client.PassToPeer(packets.NewXpReward(skill, share), memberId)
+	}
+}
+
+// Completes the quest for nearby party members too
+func (g *InGame) creditPartyQuest(questInfo *packets.QuestInfo) {
+	for _, memberId := range g.nearbyPartyMembers() {
+		g.client.PassToPeer(packets.NewPartyQuestCredit(g.player.Name, questInfo), memberId)
+	}
+}
+
+func (g *InGame) handlePartyQuestCredit(senderId uint32, message *packets.Packet_PartyQuestCredit) {
+	if senderId == g.client.Id() {
+		g.logger.Println("Received party quest credit from ourselves, ignoring")
+		return
+	}
+
+	if _, _, memberIds, inParty := g.client.Parties().Get(g.client.Id()); !inParty || !slices.Contains(memberIds, senderId) {
+		g.logger.Printf("Received party quest credit from client %d, who isn't in our party", senderId)
+		return
+	}
+
+	questInfo, err := g.questFromMessage(message.PartyQuestCredit.Quest)
+	if err != nil {
+		g.logger.Printf("Failed to get quest from party quest credit: %v", err)
+		return
+	}
+
+	completed, err := g.hasCompletedQuest(questInfo.DbId)
+	if err != nil {
+		g.logger.Printf("Failed to get actor quests: %v", err)
+		return
+	}
+	if completed {
+		return
+	}
+
+	go g.queries.UpsertActorQuest(context.Background(), db.UpsertActorQuestParams{
+		ActorID:   g.player.DbId,
+		QuestID:   questInfo.DbId,
+		Completed: true,
+	})
+
+	if g.client.ProtocolVersion() >= packets.PartiesProtocolVersion {
+		g.client.SocketSend(message)
+	} else {
+		g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("%s completed %s for the party", message.PartyQuestCredit.Name, questInfo.Name)))
+	}
+
+	g.addInventoryItem(*questInfo.RewardItem, 1, true)
+	g.client.SocketSend(packets.NewItemQuantity(questInfo.RewardItem, 1))
+}
@@ -0,0 +1,382 @@
+package states
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/db"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+)
+
+// Players reporting each other, and moderators working through the reports. Each report keeps a copy of what was
+// said around the reporter shortly before, from the chat log of the level they were in.
+
+const (
+	// How long a player has to wait between reports
+	reportCooldown = time.Minute
+
+	// How far back the chat attached to a report goes, and the most lines attached
+	reportChatWindow   = 10 * time.Minute
+	maxReportChatLines = 30
+
+	maxReportReasonLength = 500
+
+	// The most reports sent to an admin at once, newest first
+	maxReportsListed = 50
+)
+
+// What the player could have seen said in their level recently: everything said out loud, messages in the channels
+// they're in, and whispers to or from them
+func (g *InGame) reportChat() []central.ChatLine {
+	level := g.client.Level()
+	if level == nil {
+		return nil
+	}
+
+	channels := g.client.Channels().Joined(g.client.Id())
+	since := time.Now().Add(-reportChatWindow)
+	lines := make([]central.ChatLine, 0)
+	for _, line := range level.ChatLog.Items() {
+		if line.SentAt.Before(since) {
+			continue
+		}
+		switch line.Kind {
+		case "whisper":
+			if line.SenderActorId != g.player.DbId && !strings.EqualFold(line.To, g.player.Name) {
+				continue
+			}
+		case "channel":
+			if !slices.Contains(channels, line.To) {
+				continue
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	if len(lines) > maxReportChatLines {
+		lines = lines[len(lines)-maxReportChatLines:]
+	}
+	return lines
+}
+
+func (g *InGame) handleReportPlayer(senderId uint32, message *packets.Packet_ReportPlayer) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received a report from another client (%d), ignoring", senderId)
+		return
+	}
+
+	if err := g.report(message.ReportPlayer.Name, message.ReportPlayer.Reason); err != nil {
+		g.sendReportResponse(false, message.ReportPlayer.Name, err)
+	}
+}
+
+// Files a report against the named character in the background, telling the player how it went. Returns an error
+// straight away if the report can't be made at all.
+func (g *InGame) report(name, reason string) error {
+	name = strings.TrimSpace(name)
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return errors.New("please say what they did")
+	}
+	if len(reason) > maxReportReasonLength {
+		return fmt.Errorf("the reason can't be longer than %d characters", maxReportReasonLength)
+	}
+	if strings.EqualFold(name, g.player.Name) {
+		return errors.New("you can't report yourself")
+	}
+	if wait := time.Until(g.lastReportAt.Add(reportCooldown)); wait > 0 {
+		return fmt.Errorf("please wait %d seconds before making another report", int(wait.Seconds())+1)
+	}
+	g.lastReportAt = time.Now()
+
+	reporterId, levelId := g.player.DbId, g.levelId
+	chat := g.reportChat()
+	g.logger.Printf("Reporting %s with %d lines of chat: %s", name, len(chat), reason)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		reported, err := g.queries.GetActorByName(ctx, name)
+		if err != nil {
+			g.client.Post(func() {
+				g.sendReportResponse(false, name, fmt.Errorf("there's nobody called %s", name))
+			})
+			return
+		}
+
+		reportId, err := g.queries.CreateReport(ctx, db.CreateReportParams{
+			ReporterActorID: pgtype.Int4{Int32: reporterId, Valid: true},
+			ReportedActorID: pgtype.Int4{Int32: reported.ID, Valid: true},
+			ReportedName:    reported.Name,
+			ReportedUserID:  pgtype.Int4{Int32: reported.UserID, Valid: true},
+			LevelID:         levelId,
+			Reason:          reason,
+		})
+		if err == nil {
+			g.saveReportChat(ctx, reportId, chat)
+		}
+
+		g.client.Post(func() {
+			if err != nil {
+				g.logger.Printf("Failed to report %s: %v", reported.Name, err)
+				g.sendReportResponse(false, reported.Name, errors.New("couldn't save the report, please try again later"))
+				return
+			}
+			g.logger.Printf("Saved report %d of %s", reportId, reported.Name)
+			g.sendReportResponse(true, reported.Name, nil)
+		})
+	}()
+	return nil
+}
+
+// Attaches the chat to the report. The report's still worth having without it, so failures are only logged.
+func (g *InGame) saveReportChat(ctx context.Context, reportId int32, chat []central.ChatLine) {
+	for _, line := range chat {
+		err := g.queries.CreateReportChatLine(ctx, db.CreateReportChatLineParams{
+			ReportID:   reportId,
+			SenderName: line.SenderName,
+			Kind:       line.Kind,
+			Recipient:  line.To,
+			Msg:        line.Msg,
+			SentAt:     pgtype.Timestamp{Time: line.SentAt.UTC(), Valid: true},
+		})
+		if err != nil {
+			g.logger.Printf("Failed to save chat for report %d: %v", reportId, err)
+			return
+		}
+	}
+}
+
+func (g *InGame) sendReportResponse(success bool, name string, err error) {
+	if g.client.ProtocolVersion() >= packets.ReportsProtocolVersion {
+		g.client.SocketSend(packets.NewReportResponse(success, name, err))
+		return
+	}
+	if err != nil {
+		g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("Couldn't report %s: %v", name, err)))
+		return
+	}
+	g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("Thanks, a moderator will look into your report of %s", name)))
+}
+
+func runReportCommand(g *InGame, args commandArgs) error {
+	return g.report(args["player"], args["reason"])
+}
+
+func (a *Admin) handleAdminListReports(senderId uint32, message *packets.Packet_AdminListReports) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to list reports from another client (%d)", senderId)
+		return
+	}
+
+	includeResolved := message.AdminListReports.IncludeResolved
+	go func() {
+		reports, err := a.listReports(includeResolved)
+		a.client.Post(func() {
+			if err != nil {
+				a.logger.Printf("Failed to list reports: %v", err)
+				a.client.SocketSend(packets.NewServerMessage("Couldn't list the reports"))
+				return
+			}
+			a.client.SocketSend(packets.NewAdminReportList(reports))
+		})
+	}()
+}
+
+// The most recent reports, with the chat attached to them and the moderation actions taken over them
+func (a *Admin) listReports(includeResolved bool) ([]*packets.ReportInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	rows, err := a.queries.GetReports(ctx, db.GetReportsParams{
+		IncludeResolved: includeResolved,
+		MaxReports:      maxReportsListed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*packets.ReportInfo, len(rows))
+	byId := make(map[int32]*packets.ReportInfo, len(rows))
+	ids := make([]int32, len(rows))
+	for i, row := range rows {
+		reports[i] = &packets.ReportInfo{
+			Id:               row.ID,
+			Reporter:         row.ReporterName,
+			Reported:         row.ReportedName,
+			ReportedUsername: row.ReportedUsername,
+			Reason:           row.Reason,
+			LevelId:          row.LevelID,
+			Status:           row.Status,
+			ClaimedBy:        row.ClaimedBy,
+			Resolution:       row.Resolution,
+			CreatedAt:        row.CreatedAt.Time.Unix(),
+		}
+		byId[row.ID] = reports[i]
+		ids[i] = row.ID
+	}
+
+	lines, err := a.queries.GetReportChatLines(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range lines {
+		report := byId[line.ReportID]
+		report.Chat = append(report.Chat, &packets.ReportChatLine{
+			SenderName: line.SenderName,
+			Kind:       line.Kind,
+			To:         line.Recipient,
+			Msg:        line.Msg,
+			SentAt:     line.SentAt.Time.Unix(),
+		})
+	}
+
+	actions, err := a.queries.GetReportActions(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, action := range actions {
+		report := byId[action.ReportID.Int32]
+		if action.Details == "" {
+			report.Actions = append(report.Actions, action.Action)
+		} else {
+			report.Actions = append(report.Actions, action.Action+": "+action.Details)
+		}
+	}
+
+	return reports, nil
+}
+
+func (a *Admin) handleAdminClaimReport(senderId uint32, message *packets.Packet_AdminClaimReport) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to claim a report from another client (%d)", senderId)
+		return
+	}
+
+	reportId := message.AdminClaimReport.ReportId
+	adminUserId := a.user.ID
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		claimed, err := a.queries.ClaimReport(ctx, db.ClaimReportParams{
+			UserID: pgtype.Int4{Int32: adminUserId, Valid: true},
+			ID:     reportId,
+		})
+		if err == nil && claimed == 0 {
+			err = fmt.Errorf("report %d doesn't exist or isn't open", reportId)
+		}
+		if err == nil {
+			writeReportAuditLog(a.queries, a.logger, adminUserId, "claim_report", "", "", pgtype.Int4{Int32: reportId, Valid: true})
+		}
+
+		a.client.Post(func() {
+			if err != nil {
+				a.logger.Printf("Failed to claim report %d: %v", reportId, err)
+			}
+			a.client.SocketSend(packets.NewAdminReportResponse(err == nil, "claim", reportId, err))
+		})
+	}()
+}
+
+func (a *Admin) handleAdminResolveReport(senderId uint32, message *packets.Packet_AdminResolveReport) {
+	if senderId != a.client.Id() {
+		a.logger.Printf("Received request to resolve a report from another client (%d)", senderId)
+		return
+	}
+
+	resolve := message.AdminResolveReport
+	reportId := resolve.ReportId
+	resolution := strings.TrimSpace(resolve.Resolution)
+	fail := func(err error) {
+		a.client.SocketSend(packets.NewAdminReportResponse(false, "resolve", reportId, err))
+	}
+	if resolution == "" {
+		fail(errors.New("say how the report was resolved"))
+		return
+	}
+
+	// The resolution doubles as the reason given to the reported player
+	var m *moderation
+	switch resolve.Action {
+	case "":
+	case "kick":
+		kick := kickUser(resolution)
+		m = &kick
+	case "ban":
+		ban := a.banUser(resolution, resolve.DurationMinutes)
+		m = &ban
+	case "mute":
+		mute := a.muteUser(resolution, resolve.DurationMinutes)
+		m = &mute
+	default:
+		fail(fmt.Errorf("unknown action %s", resolve.Action))
+		return
+	}
+
+	a.logger.Printf("Received request to resolve report %d: %s", reportId, resolution)
+	adminUserId, adminRoles := a.user.ID, a.roles
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		err := a.resolveReport(ctx, adminUserId, adminRoles, reportId, resolution, resolve.Action, m)
+		a.client.Post(func() {
+			if err != nil {
+				a.logger.Printf("Failed to resolve report %d: %v", reportId, err)
+			}
+			a.client.SocketSend(packets.NewAdminReportResponse(err == nil, "resolve", reportId, err))
+		})
+	}()
+}
+
+// Takes the moderation action, if any, against the reported player's account, then closes the report. Only the admin
+// who claimed the report can resolve it, unless nobody has.
+func (a *Admin) resolveReport(ctx context.Context, adminUserId int32, adminRoles []string, reportId int32, resolution, action string, m *moderation) error {
+	report, err := a.queries.GetReport(ctx, reportId)
+	if err != nil {
+		return fmt.Errorf("no report with ID %d", reportId)
+	}
+	if report.Status == "resolved" {
+		return fmt.Errorf("report %d is already resolved", reportId)
+	}
+	if report.ClaimedByUserID.Valid && report.ClaimedByUserID.Int32 != adminUserId {
+		return fmt.Errorf("report %d has been claimed by someone else", reportId)
+	}
+
+	linkedReport := pgtype.Int4{Int32: reportId, Valid: true}
+	if m != nil {
+		if report.ReportedUsername == "" {
+			return fmt.Errorf("%s's account no longer exists, so there's nobody to %s", report.ReportedName, action)
+		}
+		if err := a.applyModeration(ctx, adminUserId, adminRoles, action, report.ReportedUsername, *m, linkedReport); err != nil {
+			return fmt.Errorf("failed to %s %s: %w", action, report.ReportedUsername, err)
+		}
+	}
+
+	resolved, err := a.queries.ResolveReport(ctx, db.ResolveReportParams{
+		UserID:     pgtype.Int4{Int32: adminUserId, Valid: true},
+		Resolution: resolution,
+		ID:         reportId,
+	})
+	if err != nil {
+		return err
+	}
+	if resolved == 0 {
+		return fmt.Errorf("report %d was resolved or claimed by someone else in the meantime", reportId)
+	}
+
+	target := report.ReportedUsername
+	if target == "" {
+		target = report.ReportedName
+	}
+	writeReportAuditLog(a.queries, a.logger, adminUserId, "resolve_report", target, resolution, linkedReport)
+	return nil
+}
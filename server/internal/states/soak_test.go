@@ -0,0 +1,275 @@
+package states
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/db"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/objs"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/roles"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/ds"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+)
+
+// A client with no connection, which runs the real states and counts what they send it. Like a real client, its state
+// is deliberately left unsynchronized, so the race detector will complain if anything touches it from the wrong
+// goroutine.
+type soakClient struct {
+	id    atomic.Uint32
+	hub   *central.Hub
+	chans [packets.NumPriorities]chan *packets.Packet
+	dbTx  *central.DbTx
+	state central.ClientStateHandler
+	enter func(c *soakClient) // What to do once the hub has registered us
+	sent  map[string]int      // How many of each type of packet we'd have sent over the socket
+}
+
+func newSoakClient(hub *central.Hub, enter func(c *soakClient)) *soakClient {
+	c := &soakClient{
+		hub:   hub,
+		dbTx:  hub.NewDbTx(),
+		enter: enter,
+		sent:  make(map[string]int),
+	}
+	for i := range c.chans {
+		c.chans[i] = make(chan *packets.Packet, 64)
+	}
+	return c
+}
+
+// Has the client process the message on its next tick, as if it had come in over the socket. Drops the message if the
+// client is too far behind.
+func (c *soakClient) receive(message packets.Msg) {
+	select {
+	case c.chans[packets.PriorityOf(message)] <- &packets.Packet{SenderId: c.Id(), Msg: message}:
+	default:
+	}
+}
+
+func (c *soakClient) Id() uint32 { return c.id.Load() }
+func (c *soakClient) PacketsForProcessingChan(priority packets.Priority) chan *packets.Packet {
+	return c.chans[priority]
+}
+func (c *soakClient) RemoteIp() string { return "" }
+
+func (c *soakClient) Initialize(id uint32) {
+	c.id.Store(id)
+	c.enter(c)
+}
+
+func (c *soakClient) ProcessMessage(senderId uint32, message packets.Msg) {
+	if c.state != nil {
+		c.state.HandleMessage(senderId, message)
+	}
+}
+
+func (c *soakClient) SocketSend(message packets.Msg) { c.SocketSendAs(message, c.Id()) }
+func (c *soakClient) SocketSendAs(message packets.Msg, senderId uint32) {
+	c.sent[fmt.Sprintf("%T", message)]++
+}
+func (c *soakClient) SocketSendBatch(batch ...*packets.Packet) {
+	for _, packet := range batch {
+		c.SocketSendAs(packet.Msg, packet.SenderId)
+	}
+}
+func (c *soakClient) SetBatching(enabled bool)                          {}
+func (c *soakClient) SetCompression(enabled bool)                       {}
+func (c *soakClient) SetProtocol(version uint32, capabilities []string) {}
+func (c *soakClient) ProtocolVersion() uint32                           { return packets.ProtocolVersion }
+func (c *soakClient) HasCapability(capability string) bool              { return false }
+func (c *soakClient) StartSession() string                              { return "" }
+func (c *soakClient) EndSession()                                       {}
+func (c *soakClient) ResumeSession(token string) bool                   { return false }
+func (c *soakClient) PassToPeer(message packets.Msg, peerId uint32) {
+	c.hub.PassToPeer(c.Id(), message, peerId)
+}
+func (c *soakClient) EnterLevel(levelId int32, onArrival func()) {
+	c.hub.EnterLevel(c, levelId, onArrival)
+}
+func (c *soakClient) ExitLevel(onArrival func()) { c.hub.ExitLevel(c, onArrival) }
+func (c *soakClient) Broadcast(message packets.Msg, to ...[]uint32) {
+	c.hub.Broadcast(c.Id(), message, to...)
+}
+func (c *soakClient) Post(job func())                           { c.hub.PostToClient(c, job) }
+func (c *soakClient) PostToPeer(peerId uint32, job func()) bool { return c.hub.PostToPeer(peerId, job) }
+func (c *soakClient) PostToLevel(levelId int32, delay time.Duration, job func(level *central.Level)) {
+	c.hub.PostToLevel(levelId, delay, job)
+}
+func (c *soakClient) PostToEveryLevel(job func(level *central.Level), done func()) {
+	c.hub.PostToEveryLevel(job, done)
+}
+func (c *soakClient) Level() *central.Level                         { return c.hub.LevelOf(c) }
+func (c *soakClient) ReadPump()                                     {}
+func (c *soakClient) WritePump()                                    {}
+func (c *soakClient) DbTx() *central.DbTx                           { return c.dbTx }
+func (c *soakClient) RunSql(sql string) (*central.SqlResult, error) { return c.hub.RunSql(sql) }
+func (c *soakClient) UtilFunctions() *central.UtilFunctions         { return c.hub.UtilFunctions }
+func (c *soakClient) SharedGameObjects() *central.SharedGameObjects { return c.hub.SharedGameObjects }
+func (c *soakClient) GameData() *central.GameData                   { return c.hub.GameData }
+func (c *soakClient) LevelPointMaps() *central.LevelPointMaps       { return c.hub.LevelPointMaps }
+func (c *soakClient) AuthLimits() *central.AuthLimits               { return c.hub.AuthLimits }
+func (c *soakClient) AdminSessions() *ds.SharedCollection[int32]    { return c.hub.AdminSessions }
+func (c *soakClient) Channels() *central.Channels                   { return c.hub.Channels }
+func (c *soakClient) Parties() *central.Parties                     { return c.hub.Parties }
+func (c *soakClient) ChatArchive() *central.ChatArchive             { return c.hub.ChatArchive }
+func (c *soakClient) WorldClock() *central.WorldClock               { return c.hub.WorldClock }
+
+func (c *soakClient) SetState(state central.ClientStateHandler) {
+	if c.state != nil {
+		c.state.OnExit()
+	}
+	c.state = state
+	if c.state != nil {
+		c.state.SetClient(c)
+		c.state.OnEnter()
+	}
+}
+
+func (c *soakClient) Close(reason string) {
+	c.Post(func() {
+		c.SetState(nil)
+		c.hub.UnregisterChan <- c
+	})
+}
+
+// Runs players in the game across several levels, moving, whispering to each other and teleporting to each other, while
+// an admin keeps listing who's online and where and changing the players' roles. Nothing here touches the database, so
+// the states carry on without it. Only meaningful when run with -race.
+func TestInGameSoak(t *testing.T) {
+	duration := 2 * time.Second
+	if testing.Short() {
+		duration = 200 * time.Millisecond
+	}
+
+	// Every failed database call gets logged, which would drown out anything useful
+	defer log.SetOutput(log.Writer())
+	log.SetOutput(io.Discard)
+
+	// The pool connects lazily, and fails straight away whenever anything tries to use it
+	hub := central.NewHub(t.TempDir(), "host=127.0.0.1 port=1", central.SimulationConfig{TickRate: 200, PacketsPerTick: 4})
+	go hub.RunSimulation()
+
+	levelIds := []int32{1, 2, 3}
+	players := make([]*soakClient, 12)
+	names := make([]string, len(players))
+	for i := range players {
+		levelId := levelIds[i%len(levelIds)]
+		names[i] = fmt.Sprintf("Player%d", i)
+		player := objs.NewActor(levelId, 0, 0, names[i], 0, 0, int32(i+1))
+		players[i] = newSoakClient(hub, func(c *soakClient) {
+			c.EnterLevel(levelId, func() {
+				c.SetState(&InGame{levelId: levelId, player: player, inventory: ds.NewInventory()})
+			})
+		})
+		hub.RegisterChan <- players[i]
+	}
+
+	admin := newSoakClient(hub, func(c *soakClient) {
+		c.SetState(&Admin{user: db.User{ID: 1, Username: "admin"}, roles: []string{string(roles.Superadmin)}})
+	})
+	hub.RegisterChan <- admin
+
+	stop := make(chan struct{})
+	var workers sync.WaitGroup
+	work := func(do func()) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				do()
+				time.Sleep(time.Millisecond)
+			}
+		}()
+	}
+
+	// Stand in for each player's read pump
+	for _, player := range players {
+		work(func() {
+			other := names[rand.IntN(len(names))]
+			switch rand.IntN(8) {
+			case 0:
+				player.receive(packets.NewChat("/tp " + other))
+			case 1, 2:
+				player.receive(packets.NewWhisper(other, "", "hello", 0))
+			case 3:
+				player.receive(packets.NewChat("hello"))
+			default:
+				player.receive(&packets.Packet_ActorMove{ActorMove: &packets.ActorMove{Dx: rand.Int32N(3) - 1}})
+			}
+		})
+	}
+
+	// And for the admin's, including giving the players permission to teleport, since they can't load their roles
+	work(func() {
+		switch rand.IntN(3) {
+		case 0:
+			admin.receive(&packets.Packet_AdminListOnlinePlayers{AdminListOnlinePlayers: &packets.AdminListOnlinePlayers{}})
+		case 1:
+			admin.receive(&packets.Packet_AdminListLevels{AdminListLevels: &packets.AdminListLevels{}})
+		case 2:
+			i := rand.IntN(len(players))
+			roleChange := packets.NewAdminRoleResponse(true, names[i], string(roles.Moderator), []string{string(roles.Moderator)}, nil)
+			admin.Post(func() {
+				admin.PassToPeer(roleChange, players[i].Id())
+			})
+		}
+	})
+
+	time.Sleep(duration)
+	close(stop)
+	workers.Wait()
+
+	// Wait for everyone to settle and check their state from where it lives
+	var settled sync.WaitGroup
+	var whispers, onlineLists atomic.Int64
+	for _, client := range append(players, admin) {
+		settled.Add(1)
+		client.Post(func() {
+			defer settled.Done()
+			whispers.Add(int64(client.sent["*packets.Packet_Whisper"]))
+			onlineLists.Add(int64(client.sent["*packets.Packet_AdminOnlinePlayers"]))
+
+			inGame, isInGame := client.state.(*InGame)
+			if !isInGame {
+				return
+			}
+			if level := client.Level(); level == nil || level.Id != inGame.levelId {
+				t.Errorf("%s thinks they're in level %d but isn't being simulated there", inGame.player.Name, inGame.levelId)
+			}
+			if inGame.player.LevelId != inGame.levelId {
+				t.Errorf("%s's actor is in level %d but they're in level %d", inGame.player.Name, inGame.player.LevelId, inGame.levelId)
+			}
+		})
+	}
+
+	done := make(chan struct{})
+	go func() {
+		settled.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for clients to settle")
+	}
+
+	if whispers.Load() == 0 || onlineLists.Load() == 0 {
+		t.Errorf("Expected some work to be done, got %d whispers and %d lists of online players", whispers.Load(), onlineLists.Load())
+	}
+
+	for _, client := range append(players, admin) {
+		client.Close("soak test over")
+	}
+}
@@ -0,0 +1,328 @@
+package states
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/central/db"
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+)
+
+// Whispers, friends and ignore lists for a player in the game
+
+// Loads the player's friends and who they're ignoring
+func (g *InGame) loadSocial() {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	friends, err := g.queries.GetFriends(ctx, g.player.DbId)
+	if err != nil {
+		g.logger.Printf("Failed to get friends: %v", err)
+	}
+	ignores, err := g.queries.GetIgnores(ctx, g.player.DbId)
+	if err != nil {
+		g.logger.Printf("Failed to get ignores: %v", err)
+	}
+	g.setSocial(friends, ignores)
+}
+
+func (g *InGame) setSocial(friends []db.GetFriendsRow, ignores []db.GetIgnoresRow) {
+	g.friends = friends
+	g.ignored = make(map[int32]string, len(ignores))
+	for _, ignore := range ignores {
+		g.ignored[ignore.ID] = ignore.Name
+	}
+}
+
+func (g *InGame) sendSocialLists() {
+	if g.client.ProtocolVersion() < packets.SocialProtocolVersion {
+		return
+	}
+
+	friends := make([]*packets.Friend, len(g.friends))
+	for i, friend := range g.friends {
+		friends[i] = &packets.Friend{
+			Name:     friend.Name,
+			Online:   characterInGame(g.client, friend.ID),
+			Pending:  !friend.Accepted,
+			Incoming: friend.Incoming,
+		}
+	}
+	g.client.SocketSend(packets.NewFriendList(friends))
+
+	ignored := make([]string, 0, len(g.ignored))
+	for _, name := range g.ignored {
+		ignored = append(ignored, name)
+	}
+	g.client.SocketSend(packets.NewIgnoreList(ignored))
+}
+
+// Lets any friends who are online know we've entered or left the game
+func (g *InGame) announcePresence(online bool) {
+	for _, friend := range g.friends {
+		if !friend.Accepted {
+			continue
+		}
+		if clientId, inGame := characterClientId(g.client, friend.ID); inGame {
+			g.client.PassToPeer(packets.NewFriendPresence(g.player.Name, online), clientId)
+		}
+	}
+}
+
+// Whether the player on the client is one we've chosen to ignore
+func (g *InGame) isIgnoring(clientId uint32) bool {
+	actor, exists := g.client.SharedGameObjects().Actors.Get(clientId)
+	if !exists || actor.IsNpc {
+		return false
+	}
+	_, ignored := g.ignored[actor.DbId]
+	return ignored
+}
+
+func (g *InGame) handleWhisper(senderId uint32, message *packets.Packet_Whisper) {
+	whisper := message.Whisper
+	if strings.TrimSpace(whisper.Msg) == "" {
+		g.logger.Println("Received a whisper with no content, ignoring")
+		return
+	}
+
+	if senderId != g.client.Id() {
+		if g.isIgnoring(senderId) {
+			g.logger.Printf("Dropping a whisper from %s, who we're ignoring", whisper.From)
+			return
+		}
+		// The sender logged it in their own level, but it should be in ours too in case we report them
+		if sender, exists := g.client.SharedGameObjects().Actors.Get(senderId); exists && whisper.FromLevelId != g.levelId {
+			g.logChatInLevel(central.ChatLine{
+				SenderActorId: sender.DbId,
+				SenderName:    whisper.From,
+				Kind:          "whisper",
+				To:            g.player.Name,
+				Msg:           whisper.Msg,
+				SentAt:        time.Now(),
+			})
+		}
+		if g.client.ProtocolVersion() < packets.SocialProtocolVersion {
+			g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("%s whispers: %s", whisper.From, whisper.Msg)))
+			return
+		}
+		g.client.SocketSendAs(packets.NewWhisper(whisper.To, whisper.From, whisper.Msg, 0), senderId)
+		return
+	}
+
+	if g.refuseIfMuted() {
+		return
+	}
+
+	recipientId, recipient, err := g.findPlayer(whisper.To)
+	if err == nil && recipientId == g.client.Id() {
+		err = errors.New("you can't whisper to yourself")
+	}
+	if err != nil {
+		g.client.SocketSend(packets.NewSocialResponse(false, "whisper", whisper.To, err))
+		return
+	}
+
+	msg, allowed := g.moderateChat(whisper.Msg)
+	if !allowed {
+		return
+	}
+
+	g.logChat("whisper", recipient.Name, whisper.Msg, msg)
+	g.client.PassToPeer(packets.NewWhisper(recipient.Name, g.player.Name, msg, g.levelId), recipientId)
+	g.client.SocketSend(packets.NewWhisper(recipient.Name, g.player.Name, msg, 0))
+}
+
+func (g *InGame) handleFriendRequest(senderId uint32, message *packets.Packet_FriendRequest) {
+	if senderId != g.client.Id() {
+		g.refreshSocial(message)
+		return
+	}
+
+	actorId, playerName := g.player.DbId, g.player.Name
+	g.changeSocial("friend_request", message.FriendRequest.Name, func(ctx context.Context, target db.Actor) (packets.Msg, error) {
+		friendship, err := g.queries.GetFriendship(ctx, db.GetFriendshipParams{ActorID: actorId, FriendActorID: target.ID})
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = g.queries.CreateFriendRequest(ctx, db.CreateFriendRequestParams{ActorID: actorId, FriendActorID: target.ID})
+			return packets.NewFriendRequest(playerName), err
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if friendship.Accepted {
+			return nil, fmt.Errorf("you're already friends with %s", target.Name)
+		}
+		if friendship.ActorID == actorId {
+			return nil, fmt.Errorf("you've already asked %s", target.Name)
+		}
+
+		// They've already asked us, so asking them back is as good as accepting
+		_, err = g.queries.AcceptFriendRequest(ctx, db.AcceptFriendRequestParams{ActorID: target.ID, FriendActorID: actorId})
+		return packets.NewAcceptFriendRequest(playerName), err
+	})
+}
+
+func (g *InGame) handleAcceptFriendRequest(senderId uint32, message *packets.Packet_AcceptFriendRequest) {
+	if senderId != g.client.Id() {
+		g.refreshSocial(message)
+		return
+	}
+
+	actorId, playerName := g.player.DbId, g.player.Name
+	g.changeSocial("accept_friend_request", message.AcceptFriendRequest.Name, func(ctx context.Context, target db.Actor) (packets.Msg, error) {
+		accepted, err := g.queries.AcceptFriendRequest(ctx, db.AcceptFriendRequestParams{ActorID: target.ID, FriendActorID: actorId})
+		if err != nil {
+			return nil, err
+		}
+		if accepted == 0 {
+			return nil, fmt.Errorf("no friend request from %s", target.Name)
+		}
+		return packets.NewAcceptFriendRequest(playerName), nil
+	})
+}
+
+func (g *InGame) handleRemoveFriend(senderId uint32, message *packets.Packet_RemoveFriend) {
+	if senderId != g.client.Id() {
+		g.refreshSocial(message)
+		return
+	}
+
+	actorId, playerName := g.player.DbId, g.player.Name
+	g.changeSocial("remove_friend", message.RemoveFriend.Name, func(ctx context.Context, target db.Actor) (packets.Msg, error) {
+		removed, err := g.queries.DeleteFriendship(ctx, db.DeleteFriendshipParams{ActorID: actorId, FriendActorID: target.ID})
+		if err != nil {
+			return nil, err
+		}
+		if removed == 0 {
+			return nil, fmt.Errorf("%s isn't your friend", target.Name)
+		}
+		return packets.NewRemoveFriend(playerName), nil
+	})
+}
+
+func (g *InGame) handleFriendPresence(senderId uint32, message *packets.Packet_FriendPresence) {
+	if senderId == g.client.Id() {
+		g.logger.Println("Received a friend presence message from ourselves, ignoring")
+		return
+	}
+
+	if g.client.ProtocolVersion() >= packets.SocialProtocolVersion {
+		g.client.SocketSend(message)
+	}
+}
+
+func (g *InGame) handleIgnorePlayer(senderId uint32, message *packets.Packet_IgnorePlayer) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received an ignore request from another client (%d), ignoring", senderId)
+		return
+	}
+
+	actorId := g.player.DbId
+	g.changeSocial("ignore", message.IgnorePlayer.Name, func(ctx context.Context, target db.Actor) (packets.Msg, error) {
+		added, err := g.queries.AddIgnore(ctx, db.AddIgnoreParams{ActorID: actorId, IgnoredActorID: target.ID})
+		if err != nil {
+			return nil, err
+		}
+		if added == 0 {
+			return nil, fmt.Errorf("you're already ignoring %s", target.Name)
+		}
+		return nil, nil
+	})
+}
+
+func (g *InGame) handleUnignorePlayer(senderId uint32, message *packets.Packet_UnignorePlayer) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received an unignore request from another client (%d), ignoring", senderId)
+		return
+	}
+
+	actorId := g.player.DbId
+	g.changeSocial("unignore", message.UnignorePlayer.Name, func(ctx context.Context, target db.Actor) (packets.Msg, error) {
+		removed, err := g.queries.RemoveIgnore(ctx, db.RemoveIgnoreParams{ActorID: actorId, IgnoredActorID: target.ID})
+		if err != nil {
+			return nil, err
+		}
+		if removed == 0 {
+			return nil, fmt.Errorf("you aren't ignoring %s", target.Name)
+		}
+		return nil, nil
+	})
+}
+
+// Changes our friends or ignores in the database, away from the level's goroutine, then comes back to tell the client
+// how it went. If it worked and the other character is online, they're passed the message apply returns, if any, so
+// they can refresh their own lists.
+func (g *InGame) changeSocial(action, name string, apply func(context.Context, db.Actor) (packets.Msg, error)) {
+	actorId := g.player.DbId
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		target, err := g.queries.GetActorByName(ctx, name)
+		if err != nil {
+			err = fmt.Errorf("no character named %s", name)
+		} else if target.ID == actorId {
+			err = errors.New("that's you")
+		}
+
+		var notify packets.Msg
+		if err == nil {
+			notify, err = apply(ctx, target)
+		}
+
+		var friends []db.GetFriendsRow
+		var ignores []db.GetIgnoresRow
+		if err == nil {
+			friends, err = g.queries.GetFriends(ctx, actorId)
+		}
+		if err == nil {
+			ignores, err = g.queries.GetIgnores(ctx, actorId)
+		}
+
+		g.client.Post(func() {
+			if err != nil {
+				g.logger.Printf("Failed to %s %s: %v", action, name, err)
+				g.client.SocketSend(packets.NewSocialResponse(false, action, name, err))
+				return
+			}
+
+			g.setSocial(friends, ignores)
+			if clientId, inGame := characterClientId(g.client, target.ID); inGame && notify != nil {
+				g.client.PassToPeer(notify, clientId)
+			}
+			g.client.SocketSend(packets.NewSocialResponse(true, action, target.Name, nil))
+			g.sendSocialLists()
+		})
+	}()
+}
+
+// Reloads our friends after another player changes them, then passes on what they did to the client
+func (g *InGame) refreshSocial(message packets.Msg) {
+	actorId := g.player.DbId
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		friends, err := g.queries.GetFriends(ctx, actorId)
+		g.client.Post(func() {
+			if err != nil {
+				g.logger.Printf("Failed to reload friends: %v", err)
+				return
+			}
+
+			g.friends = friends
+			if g.client.ProtocolVersion() >= packets.SocialProtocolVersion {
+				g.client.SocketSend(message)
+			}
+			g.sendSocialLists()
+		})
+	}()
+}
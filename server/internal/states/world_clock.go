@@ -0,0 +1,37 @@
+package states
+
+import (
+	"fmt"
+
+	"github.com/tristanbatchler/TwilightGroveOnline/server/pkg/packets"
+)
+
+// Keeping players' clocks in step with the time in the game world
+
+// The hub tells everyone the time every so often, so pass it on if the client knows what to do with it
+func (g *InGame) handleWorldTime(senderId uint32, message *packets.Packet_WorldTime) {
+	if senderId == g.client.Id() {
+		g.logger.Println("Received a world time message from ourselves, but only the server can say what time it is")
+		return
+	}
+
+	if g.client.ProtocolVersion() >= packets.WorldClockProtocolVersion {
+		g.client.SocketSend(message)
+	}
+}
+
+func (g *InGame) sendWorldTime() {
+	if g.client.ProtocolVersion() >= packets.WorldClockProtocolVersion {
+		g.client.SocketSend(g.client.WorldClock().Packet())
+	}
+}
+
+func runTimeCommand(g *InGame, _ commandArgs) error {
+	now := g.client.WorldClock().Now()
+	timeOfDay := "day"
+	if now.IsNight() {
+		timeOfDay = "night"
+	}
+	g.client.SocketSend(packets.NewServerMessage(fmt.Sprintf("It's %s (%s)", now, timeOfDay)))
+	return nil
+}
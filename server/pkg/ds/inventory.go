@@ -2,6 +2,7 @@ package ds
 
 import (
 	"hash/fnv"
+	"sync"
 
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/objs"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/props"
@@ -59,8 +60,10 @@ func NewInventoryRow(item objs.Item, quantity uint32) *InventoryRow {
 	}
 }
 
+// A thread-safe collection of items and their quantities.
 type Inventory struct {
 	rows map[int]*InventoryRow
+	mux  sync.RWMutex
 }
 
 func NewInventory() *Inventory {
@@ -70,6 +73,9 @@ func NewInventory() *Inventory {
 }
 
 func (i *Inventory) AddItem(item objs.Item, quantity uint32) {
+	i.mux.Lock()
+	defer i.mux.Unlock()
+
 	hash := HashItem(item)
 	if row, ok := i.rows[hash]; ok {
 		row.quantity += quantity
@@ -89,6 +95,9 @@ func NewInventoryWithItems(items []*InventoryRow) *Inventory {
 // RemoveItem removes a quantity of an item from the inventory. If the quantity is greater than the quantity of the item in the inventory, the item is removed from the inventory.
 // Returns the number of items remaining, or 0 if the item was removed, or -1 if the item was not found.
 func (i *Inventory) RemoveItem(item objs.Item, quantity uint32) int32 {
+	i.mux.Lock()
+	defer i.mux.Unlock()
+
 	hash := HashItem(item)
 	if row, ok := i.rows[hash]; ok {
 		row.quantity -= quantity
@@ -102,6 +111,9 @@ func (i *Inventory) RemoveItem(item objs.Item, quantity uint32) int32 {
 }
 
 func (i *Inventory) GetItemQuantity(item objs.Item) uint32 {
+	i.mux.RLock()
+	defer i.mux.RUnlock()
+
 	hash := HashItem(item)
 	if row, ok := i.rows[hash]; ok {
 		return row.quantity
@@ -109,20 +121,40 @@ func (i *Inventory) GetItemQuantity(item objs.Item) uint32 {
 	return 0
 }
 
+// Returns a snapshot of the rows in the inventory, so later changes to the inventory won't affect the result.
 func (i *Inventory) GetItems() []*InventoryRow {
+	i.mux.RLock()
+	defer i.mux.RUnlock()
+
 	items := make([]*InventoryRow, 0, len(i.rows))
 	for _, row := range i.rows {
-		items = append(items, row)
+		items = append(items, NewInventoryRow(row.item, row.quantity))
 	}
 	return items
 }
 
 func (i *Inventory) GetNumRows() int {
+	i.mux.RLock()
+	defer i.mux.RUnlock()
+
 	return len(i.rows)
 }
 
+// Calls f for each item in the inventory. Like SharedCollection.ForEach, the rows are copied while holding the lock
+// so f is free to modify the inventory. The item pointer refers to the item stored in the inventory.
 func (i *Inventory) ForEach(f func(item *objs.Item, quantity uint32)) {
+	i.mux.RLock()
+	type entry struct {
+		item     *objs.Item
+		quantity uint32
+	}
+	localCopy := make([]entry, 0, len(i.rows))
 	for _, row := range i.rows {
-		f(&row.item, row.quantity)
+		localCopy = append(localCopy, entry{&row.item, row.quantity})
+	}
+	i.mux.RUnlock()
+
+	for _, e := range localCopy {
+		f(e.item, e.quantity)
 	}
 }
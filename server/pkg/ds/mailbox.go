@@ -0,0 +1,55 @@
+package ds
+
+import "sync"
+
+// Mailbox is a thread-safe, unbounded FIFO queue.
+// Pushing never blocks, so any goroutine can safely post to a mailbox owned by another goroutine without risking a
+// deadlock, even if the two goroutines are posting to each other at the same time.
+type Mailbox[T any] struct {
+	mux   sync.Mutex
+	items []T
+	ready chan struct{}
+}
+
+// NewMailbox creates a new, empty Mailbox.
+func NewMailbox[T any]() *Mailbox[T] {
+	return &Mailbox[T]{
+		items: make([]T, 0),
+		ready: make(chan struct{}, 1),
+	}
+}
+
+// Push appends items to the back of the mailbox and signals the Ready channel. Items pushed together are guaranteed to
+// be next to each other in the mailbox, even if other goroutines are pushing at the same time.
+func (m *Mailbox[T]) Push(items ...T) {
+	m.mux.Lock()
+	m.items = append(m.items, items...)
+	m.mux.Unlock()
+
+	// Non-blocking signal, since one pending signal is enough to wake the receiver up to drain everything
+	select {
+	case m.ready <- struct{}{}:
+	default:
+	}
+}
+
+// Ready returns a channel which receives a value whenever there might be items waiting in the mailbox.
+func (m *Mailbox[T]) Ready() <-chan struct{} {
+	return m.ready
+}
+
+// Drain removes and returns all the items currently in the mailbox, in the order they were pushed.
+func (m *Mailbox[T]) Drain() []T {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	items := m.items
+	m.items = make([]T, 0, len(items))
+	return items
+}
+
+// Len returns the number of items currently waiting in the mailbox.
+func (m *Mailbox[T]) Len() int {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	return len(m.items)
+}
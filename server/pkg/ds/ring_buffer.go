@@ -0,0 +1,44 @@
+package ds
+
+import "sync"
+
+// RingBuffer is a thread-safe, fixed-size buffer which keeps the most recent items pushed to it, overwriting the oldest
+// once it's full.
+type RingBuffer[T any] struct {
+	mux   sync.Mutex
+	items []T
+	next  int // Where the next item goes
+	full  bool
+}
+
+// NewRingBuffer creates a new, empty RingBuffer which holds up to capacity items.
+func NewRingBuffer[T any](capacity int) *RingBuffer[T] {
+	return &RingBuffer[T]{
+		items: make([]T, capacity),
+	}
+}
+
+// Push adds an item, overwriting the oldest one if the buffer is full.
+func (r *RingBuffer[T]) Push(item T) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.items[r.next] = item
+	r.next = (r.next + 1) % len(r.items)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// Items returns a copy of the items in the buffer, oldest first.
+func (r *RingBuffer[T]) Items() []T {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if !r.full {
+		return append([]T(nil), r.items[:r.next]...)
+	}
+	items := make([]T, 0, len(r.items))
+	items = append(items, r.items[r.next:]...)
+	return append(items, r.items[:r.next]...)
+}
@@ -81,8 +81,10 @@ func (s *SharedCollection[T]) Get(id uint32) (T, bool) {
 	return obj, ok
 }
 
-// Get the approximate number of objects in the map.
-// The reason this is approximate is because we don't lock the map to get the length.
+// Get the number of objects in the map.
 func (s *SharedCollection[T]) Len() int {
+	s.mapMux.RLock()
+	defer s.mapMux.RUnlock()
+
 	return len(s.objectsMap)
 }
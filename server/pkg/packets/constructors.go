@@ -1,6 +1,9 @@
 package packets
 
 import (
+	"time"
+
+	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/appearance"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/objs"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/props"
 	"github.com/tristanbatchler/TwilightGroveOnline/server/internal/quests"
@@ -25,13 +28,14 @@ func newOptionalResponse(err error) *Response_Msg {
 	}
 }
 
-func NewLoginResponse(success bool, err error) Msg {
+func NewLoginResponse(success bool, sessionToken string, err error) Msg {
 	return &Packet_LoginResponse{
 		LoginResponse: &LoginResponse{
 			Response: &Response{
 				Success:     success,
 				OptionalMsg: newOptionalResponse(err),
 			},
+			SessionToken: sessionToken,
 		},
 	}
 }
@@ -47,6 +51,29 @@ func NewRegisterResponse(success bool, err error) Msg {
 	}
 }
 
+func NewChangePasswordResponse(success bool, err error) Msg {
+	return &Packet_ChangePasswordResponse{
+		ChangePasswordResponse: &ChangePasswordResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+		},
+	}
+}
+
+func NewAdminResetPasswordResponse(success bool, temporaryPassword string, err error) Msg {
+	return &Packet_AdminResetPasswordResponse{
+		AdminResetPasswordResponse: &AdminResetPasswordResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+			TemporaryPassword: temporaryPassword,
+		},
+	}
+}
+
 func NewAdminJoinGameResponse(success bool, err error) Msg {
 	return &Packet_AdminJoinGameResponse{
 		AdminJoinGameResponse: &AdminJoinGameResponse{
@@ -67,6 +94,31 @@ func NewActor(actor *objs.Actor) Msg {
 			SpriteRegionX: actor.SpriteRegionX,
 			SpriteRegionY: actor.SpriteRegionY,
 			IsVip:         actor.IsVip,
+			Appearance:    NewAppearance(actor.Appearance),
+			GuildTag:      actor.GuildTag,
+			Animation:     uint32(actor.Animation),
+			Facing:        uint32(actor.Facing),
+			Tick:          actor.Tick,
+		},
+	}
+}
+
+func NewAppearance(a appearance.Appearance) *Appearance {
+	return &Appearance{
+		Body:    a.Body,
+		Hair:    a.Hair,
+		Outfit:  a.Outfit,
+		Palette: a.Palette,
+	}
+}
+
+func NewChangeAppearanceResponse(success bool, err error) Msg {
+	return &Packet_ChangeAppearanceResponse{
+		ChangeAppearanceResponse: &ChangeAppearanceResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
 		},
 	}
 }
@@ -197,15 +249,16 @@ func NewSqlRow(values []string) *SqlRow {
 	}
 }
 
-func NewSqlResponse(success bool, err error, columns []string, rows []*SqlRow) Msg {
+func NewSqlResponse(success bool, err error, columns []string, rows []*SqlRow, truncated bool) Msg {
 	return &Packet_SqlResponse{
 		SqlResponse: &SqlResponse{
 			Response: &Response{
 				Success:     success,
 				OptionalMsg: newOptionalResponse(err),
 			},
-			Columns: columns,
-			Rows:    rows,
+			Columns:   columns,
+			Rows:      rows,
+			Truncated: truncated,
 		},
 	}
 }
@@ -367,6 +420,24 @@ func NewYell(name string, isVip bool, msg string) Msg {
 	}
 }
 
+func NewEmote(name string) Msg {
+	return &Packet_Emote{
+		Emote: &Emote{
+			Name: name,
+		},
+	}
+}
+
+func NewWorldTime(day int64, minute int32, dayLengthSeconds uint32) Msg {
+	return &Packet_WorldTime{
+		WorldTime: &WorldTime{
+			Day:              day,
+			Minute:           minute,
+			DayLengthSeconds: dayLengthSeconds,
+		},
+	}
+}
+
 func NewInteractWithNpcResponse(success bool, actorId uint32, err error) Msg {
 	return &Packet_InteractWithNpcResponse{
 		InteractWithNpcResponse: &InteractWithNpcResponse{
@@ -433,3 +504,481 @@ func NewDespawnGroundItem(id uint32, levelId int32) Msg {
 		},
 	}
 }
+
+func NewBackpressure(droppedPackets uint32, retryAfterMs uint32) Msg {
+	return &Packet_Backpressure{
+		Backpressure: &Backpressure{
+			DroppedPackets: droppedPackets,
+			RetryAfterMs:   retryAfterMs,
+		},
+	}
+}
+
+func NewPacketBatch(batch []*Packet) Msg {
+	return &Packet_PacketBatch{
+		PacketBatch: &PacketBatch{
+			Packets: batch,
+		},
+	}
+}
+
+func NewHelloResponse(success bool, capabilities []string, tickRate uint32, tick uint64, err error) Msg {
+	return &Packet_HelloResponse{
+		HelloResponse: &HelloResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+			ProtocolVersion:    ProtocolVersion,
+			MinProtocolVersion: MinProtocolVersion,
+			Capabilities:       capabilities,
+			TickRate:           tickRate,
+			Tick:               tick,
+		},
+	}
+}
+
+func NewResumeSession(sessionToken string) Msg {
+	return &Packet_ResumeSession{
+		ResumeSession: &ResumeSession{
+			SessionToken: sessionToken,
+		},
+	}
+}
+
+func NewResumeSessionResponse(success bool, sessionToken string, err error) Msg {
+	return &Packet_ResumeSessionResponse{
+		ResumeSessionResponse: &ResumeSessionResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+			SessionToken: sessionToken,
+		},
+	}
+}
+
+func NewCharacterInfo(id int32, name string, spriteRegionX, spriteRegionY, levelId int32, a appearance.Appearance) *CharacterInfo {
+	return &CharacterInfo{
+		Id:            id,
+		Name:          name,
+		SpriteRegionX: spriteRegionX,
+		SpriteRegionY: spriteRegionY,
+		LevelId:       levelId,
+		Appearance:    NewAppearance(a),
+	}
+}
+
+func NewCharacterList(characters []*CharacterInfo, maxCharacters uint32) Msg {
+	return &Packet_CharacterList{
+		CharacterList: &CharacterList{
+			Characters:    characters,
+			MaxCharacters: maxCharacters,
+		},
+	}
+}
+
+func NewCreateCharacterResponse(success bool, err error) Msg {
+	return &Packet_CreateCharacterResponse{
+		CreateCharacterResponse: &CreateCharacterResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+		},
+	}
+}
+
+func NewDeleteCharacterResponse(success bool, err error) Msg {
+	return &Packet_DeleteCharacterResponse{
+		DeleteCharacterResponse: &DeleteCharacterResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+		},
+	}
+}
+
+func NewRenameCharacterResponse(success bool, err error) Msg {
+	return &Packet_RenameCharacterResponse{
+		RenameCharacterResponse: &RenameCharacterResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+		},
+	}
+}
+
+func NewSelectCharacterResponse(success bool, err error) Msg {
+	return &Packet_SelectCharacterResponse{
+		SelectCharacterResponse: &SelectCharacterResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+		},
+	}
+}
+
+func NewAdminModerationResponse(success bool, action string, username string, err error) Msg {
+	return &Packet_AdminModerationResponse{
+		AdminModerationResponse: &AdminModerationResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+			Action:   action,
+			Username: username,
+		},
+	}
+}
+
+// An expiry of the zero time means it never expires, or that it doesn't apply
+func NewModerationNotice(action string, reason string, expiresAt time.Time) Msg {
+	var expiresAtUnix int64
+	if !expiresAt.IsZero() {
+		expiresAtUnix = expiresAt.Unix()
+	}
+	return &Packet_ModerationNotice{
+		ModerationNotice: &ModerationNotice{
+			Action:    action,
+			Reason:    reason,
+			ExpiresAt: expiresAtUnix,
+		},
+	}
+}
+
+func NewAdminRoleResponse(success bool, username string, role string, roles []string, err error) Msg {
+	return &Packet_AdminRoleResponse{
+		AdminRoleResponse: &AdminRoleResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+			Username: username,
+			Role:     role,
+			Roles:    roles,
+		},
+	}
+}
+
+func NewAdminPlayerInfo(success bool, info *AdminPlayerInfo, err error) Msg {
+	if info == nil {
+		info = &AdminPlayerInfo{}
+	}
+	info.Response = &Response{
+		Success:     success,
+		OptionalMsg: newOptionalResponse(err),
+	}
+	return &Packet_AdminPlayerInfo{
+		AdminPlayerInfo: info,
+	}
+}
+
+func NewAdminOpResponse(success bool, op string, characterName string, err error) Msg {
+	return &Packet_AdminOpResponse{
+		AdminOpResponse: &AdminOpResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+			Op:            op,
+			CharacterName: characterName,
+		},
+	}
+}
+
+func NewAdminOnlinePlayers(players []*OnlinePlayer) Msg {
+	return &Packet_AdminOnlinePlayers{
+		AdminOnlinePlayers: &AdminOnlinePlayers{
+			Players: players,
+		},
+	}
+}
+
+func NewAdminLevelList(levels []*LevelInfo) Msg {
+	return &Packet_AdminLevelList{
+		AdminLevelList: &AdminLevelList{
+			Levels: levels,
+		},
+	}
+}
+
+func NewCommandList(commands []*CommandInfo) Msg {
+	return &Packet_CommandList{
+		CommandList: &CommandList{
+			Commands: commands,
+		},
+	}
+}
+
+func NewWhisper(to string, from string, msg string, fromLevelId int32) Msg {
+	return &Packet_Whisper{
+		Whisper: &Whisper{
+			To:          to,
+			From:        from,
+			Msg:         msg,
+			FromLevelId: fromLevelId,
+		},
+	}
+}
+
+func NewFriendRequest(name string) Msg {
+	return &Packet_FriendRequest{
+		FriendRequest: &FriendRequest{
+			Name: name,
+		},
+	}
+}
+
+func NewAcceptFriendRequest(name string) Msg {
+	return &Packet_AcceptFriendRequest{
+		AcceptFriendRequest: &AcceptFriendRequest{
+			Name: name,
+		},
+	}
+}
+
+func NewRemoveFriend(name string) Msg {
+	return &Packet_RemoveFriend{
+		RemoveFriend: &RemoveFriend{
+			Name: name,
+		},
+	}
+}
+
+func NewFriendList(friends []*Friend) Msg {
+	return &Packet_FriendList{
+		FriendList: &FriendList{
+			Friends: friends,
+		},
+	}
+}
+
+func NewFriendPresence(name string, online bool) Msg {
+	return &Packet_FriendPresence{
+		FriendPresence: &FriendPresence{
+			Name:   name,
+			Online: online,
+		},
+	}
+}
+
+func NewIgnoreList(names []string) Msg {
+	return &Packet_IgnoreList{
+		IgnoreList: &IgnoreList{
+			Names: names,
+		},
+	}
+}
+
+func NewSocialResponse(success bool, action string, name string, err error) Msg {
+	return &Packet_SocialResponse{
+		SocialResponse: &SocialResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+			Action: action,
+			Name:   name,
+		},
+	}
+}
+
+func NewChannelMessage(channel string, senderName string, msg string, sentAt time.Time) Msg {
+	return &Packet_ChannelMessage{
+		ChannelMessage: &ChannelMessage{
+			Channel:    channel,
+			SenderName: senderName,
+			Msg:        msg,
+			SentAt:     sentAt.Unix(),
+		},
+	}
+}
+
+func NewChannelJoined(name string, kind string, members []string, history []*ChannelMessage) Msg {
+	return &Packet_ChannelJoined{
+		ChannelJoined: &ChannelJoined{
+			Name:    name,
+			Kind:    kind,
+			Members: members,
+			History: history,
+		},
+	}
+}
+
+func NewChannelMemberUpdate(channel string, name string, joined bool) Msg {
+	return &Packet_ChannelMemberUpdate{
+		ChannelMemberUpdate: &ChannelMemberUpdate{
+			Channel: channel,
+			Name:    name,
+			Joined:  joined,
+		},
+	}
+}
+
+func NewChannelResponse(success bool, action string, channel string, err error) Msg {
+	return &Packet_ChannelResponse{
+		ChannelResponse: &ChannelResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+			Action:  action,
+			Channel: channel,
+		},
+	}
+}
+
+func NewChannelList(channels []*ChannelInfo) Msg {
+	return &Packet_ChannelList{
+		ChannelList: &ChannelList{
+			Channels: channels,
+		},
+	}
+}
+
+func NewPartyInvite(name string) Msg {
+	return &Packet_PartyInvite{
+		PartyInvite: &PartyInvite{
+			Name: name,
+		},
+	}
+}
+
+func NewParty(members []*PartyMember, channel string) Msg {
+	return &Packet_Party{
+		Party: &Party{
+			Members: members,
+			Channel: channel,
+		},
+	}
+}
+
+func NewPartyQuestCredit(name string, quest *QuestInfo) Msg {
+	return &Packet_PartyQuestCredit{
+		PartyQuestCredit: &PartyQuestCredit{
+			Name:  name,
+			Quest: quest,
+		},
+	}
+}
+
+func NewPartyResponse(success bool, action string, name string, err error) Msg {
+	return &Packet_PartyResponse{
+		PartyResponse: &PartyResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+			Action: action,
+			Name:   name,
+		},
+	}
+}
+
+func NewGuildInvite(name string, guildName string) Msg {
+	return &Packet_GuildInvite{
+		GuildInvite: &GuildInvite{
+			Name:      name,
+			GuildName: guildName,
+		},
+	}
+}
+
+func NewGuildInfo(name string, tag string, rank string, members []*GuildMember, channel string, invites []string) Msg {
+	return &Packet_GuildInfo{
+		GuildInfo: &GuildInfo{
+			Name:    name,
+			Tag:     tag,
+			Rank:    rank,
+			Members: members,
+			Channel: channel,
+			Invites: invites,
+		},
+	}
+}
+
+func NewGuildBank(bank *ds.Inventory) Msg {
+	itemQtys := make([]*ItemQuantity, 0)
+	bank.ForEach(func(itemObj *objs.Item, quantity uint32) {
+		itemQtys = append(itemQtys, &ItemQuantity{
+			Item:     NewItem(itemObj).(*Packet_Item).Item,
+			Quantity: int32(quantity),
+		})
+	})
+	return &Packet_GuildBank{
+		GuildBank: &GuildBank{
+			ItemsQuantities: itemQtys,
+		},
+	}
+}
+
+func NewGuildBankLog(entries []*GuildBankLogEntry) Msg {
+	return &Packet_GuildBankLog{
+		GuildBankLog: &GuildBankLog{
+			Entries: entries,
+		},
+	}
+}
+
+func NewGuildResponse(success bool, action string, name string, err error) Msg {
+	return &Packet_GuildResponse{
+		GuildResponse: &GuildResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+			Action: action,
+			Name:   name,
+		},
+	}
+}
+
+func NewReportResponse(success bool, name string, err error) Msg {
+	return &Packet_ReportResponse{
+		ReportResponse: &ReportResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+			Name: name,
+		},
+	}
+}
+
+func NewAdminReportList(reports []*ReportInfo) Msg {
+	return &Packet_AdminReportList{
+		AdminReportList: &AdminReportList{
+			Reports: reports,
+		},
+	}
+}
+
+func NewAdminReportResponse(success bool, op string, reportId int32, err error) Msg {
+	return &Packet_AdminReportResponse{
+		AdminReportResponse: &AdminReportResponse{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+			Op:       op,
+			ReportId: reportId,
+		},
+	}
+}
+
+func NewAdminChatLog(success bool, entries []*ChatLogEntry, truncated bool, err error) Msg {
+	return &Packet_AdminChatLog{
+		AdminChatLog: &AdminChatLog{
+			Response: &Response{
+				Success:     success,
+				OptionalMsg: newOptionalResponse(err),
+			},
+			Entries:   entries,
+			Truncated: truncated,
+		},
+	}
+}
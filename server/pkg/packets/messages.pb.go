@@ -240,8 +240,10 @@ func (x *LoginRequest) GetPassword() string {
 }
 
 type LoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Response *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// Present the token in a ResumeSession after reconnecting to pick up where you left off
+	SessionToken  string `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -283,12 +285,20 @@ func (x *LoginResponse) GetResponse() *Response {
 	return nil
 }
 
+func (x *LoginResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
 type RegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	SpriteRegionX int32                  `protobuf:"varint,3,opt,name=sprite_region_x,json=spriteRegionX,proto3" json:"sprite_region_x,omitempty"`
-	SpriteRegionY int32                  `protobuf:"varint,4,opt,name=sprite_region_y,json=spriteRegionY,proto3" json:"sprite_region_y,omitempty"`
+	SpriteRegionX int32                  `protobuf:"varint,3,opt,name=sprite_region_x,json=spriteRegionX,proto3" json:"sprite_region_x,omitempty"` // Only used if there's no appearance
+	SpriteRegionY int32                  `protobuf:"varint,4,opt,name=sprite_region_y,json=spriteRegionY,proto3" json:"sprite_region_y,omitempty"` // Only used if there's no appearance
+	Appearance    *Appearance            `protobuf:"bytes,5,opt,name=appearance,proto3" json:"appearance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -351,6 +361,13 @@ func (x *RegisterRequest) GetSpriteRegionY() int32 {
 	return 0
 }
 
+func (x *RegisterRequest) GetAppearance() *Appearance {
+	if x != nil {
+		return x.Appearance
+	}
+	return nil
+}
+
 type RegisterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
@@ -535,6 +552,75 @@ func (x *Yell) GetMsg() string {
 	return ""
 }
 
+// How an actor looks, as indices into the server's lists of bodies, hairs, outfits and palettes
+type Appearance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Body          int32                  `protobuf:"varint,1,opt,name=body,proto3" json:"body,omitempty"`
+	Hair          int32                  `protobuf:"varint,2,opt,name=hair,proto3" json:"hair,omitempty"`
+	Outfit        int32                  `protobuf:"varint,3,opt,name=outfit,proto3" json:"outfit,omitempty"`
+	Palette       int32                  `protobuf:"varint,4,opt,name=palette,proto3" json:"palette,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Appearance) Reset() {
+	*x = Appearance{}
+	mi := &file_messages_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Appearance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Appearance) ProtoMessage() {}
+
+func (x *Appearance) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Appearance.ProtoReflect.Descriptor instead.
+func (*Appearance) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Appearance) GetBody() int32 {
+	if x != nil {
+		return x.Body
+	}
+	return 0
+}
+
+func (x *Appearance) GetHair() int32 {
+	if x != nil {
+		return x.Hair
+	}
+	return 0
+}
+
+func (x *Appearance) GetOutfit() int32 {
+	if x != nil {
+		return x.Outfit
+	}
+	return 0
+}
+
+func (x *Appearance) GetPalette() int32 {
+	if x != nil {
+		return x.Palette
+	}
+	return 0
+}
+
 type Actor struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -544,13 +630,18 @@ type Actor struct {
 	SpriteRegionX int32                  `protobuf:"varint,6,opt,name=sprite_region_x,json=spriteRegionX,proto3" json:"sprite_region_x,omitempty"`
 	SpriteRegionY int32                  `protobuf:"varint,7,opt,name=sprite_region_y,json=spriteRegionY,proto3" json:"sprite_region_y,omitempty"`
 	IsVip         bool                   `protobuf:"varint,8,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
+	Appearance    *Appearance            `protobuf:"bytes,9,opt,name=appearance,proto3" json:"appearance,omitempty"`
+	GuildTag      string                 `protobuf:"bytes,10,opt,name=guild_tag,json=guildTag,proto3" json:"guild_tag,omitempty"` // Empty if they aren't in a guild
+	Animation     uint32                 `protobuf:"varint,11,opt,name=animation,proto3" json:"animation,omitempty"`              // What they're doing, e.g. idle, chopping or sitting (see the emotes package)
+	Facing        uint32                 `protobuf:"varint,12,opt,name=facing,proto3" json:"facing,omitempty"`                    // 0 = down, 1 = up, 2 = left, 3 = right
+	Tick          uint64                 `protobuf:"varint,13,opt,name=tick,proto3" json:"tick,omitempty"`                        // The server tick when they last changed, to interpolate between updates and put them in order
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Actor) Reset() {
 	*x = Actor{}
-	mi := &file_messages_proto_msgTypes[9]
+	mi := &file_messages_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -562,7 +653,7 @@ func (x *Actor) String() string {
 func (*Actor) ProtoMessage() {}
 
 func (x *Actor) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[9]
+	mi := &file_messages_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,7 +666,7 @@ func (x *Actor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Actor.ProtoReflect.Descriptor instead.
 func (*Actor) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{9}
+	return file_messages_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Actor) GetId() uint32 {
@@ -627,6 +718,41 @@ func (x *Actor) GetIsVip() bool {
 	return false
 }
 
+func (x *Actor) GetAppearance() *Appearance {
+	if x != nil {
+		return x.Appearance
+	}
+	return nil
+}
+
+func (x *Actor) GetGuildTag() string {
+	if x != nil {
+		return x.GuildTag
+	}
+	return ""
+}
+
+func (x *Actor) GetAnimation() uint32 {
+	if x != nil {
+		return x.Animation
+	}
+	return 0
+}
+
+func (x *Actor) GetFacing() uint32 {
+	if x != nil {
+		return x.Facing
+	}
+	return 0
+}
+
+func (x *Actor) GetTick() uint64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
 type ActorMove struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Dx            int32                  `protobuf:"varint,2,opt,name=dx,proto3" json:"dx,omitempty"`
@@ -637,7 +763,7 @@ type ActorMove struct {
 
 func (x *ActorMove) Reset() {
 	*x = ActorMove{}
-	mi := &file_messages_proto_msgTypes[10]
+	mi := &file_messages_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -649,7 +775,7 @@ func (x *ActorMove) String() string {
 func (*ActorMove) ProtoMessage() {}
 
 func (x *ActorMove) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[10]
+	mi := &file_messages_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -662,7 +788,7 @@ func (x *ActorMove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActorMove.ProtoReflect.Descriptor instead.
 func (*ActorMove) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{10}
+	return file_messages_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ActorMove) GetDx() int32 {
@@ -688,7 +814,7 @@ type Motd struct {
 
 func (x *Motd) Reset() {
 	*x = Motd{}
-	mi := &file_messages_proto_msgTypes[11]
+	mi := &file_messages_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -700,7 +826,7 @@ func (x *Motd) String() string {
 func (*Motd) ProtoMessage() {}
 
 func (x *Motd) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[11]
+	mi := &file_messages_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -713,7 +839,7 @@ func (x *Motd) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Motd.ProtoReflect.Descriptor instead.
 func (*Motd) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{11}
+	return file_messages_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Motd) GetMsg() string {
@@ -731,7 +857,7 @@ type Disconnect struct {
 
 func (x *Disconnect) Reset() {
 	*x = Disconnect{}
-	mi := &file_messages_proto_msgTypes[12]
+	mi := &file_messages_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -743,7 +869,7 @@ func (x *Disconnect) String() string {
 func (*Disconnect) ProtoMessage() {}
 
 func (x *Disconnect) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[12]
+	mi := &file_messages_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,7 +882,7 @@ func (x *Disconnect) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Disconnect.ProtoReflect.Descriptor instead.
 func (*Disconnect) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{12}
+	return file_messages_proto_rawDescGZIP(), []int{13}
 }
 
 type AdminLoginGranted struct {
@@ -767,7 +893,7 @@ type AdminLoginGranted struct {
 
 func (x *AdminLoginGranted) Reset() {
 	*x = AdminLoginGranted{}
-	mi := &file_messages_proto_msgTypes[13]
+	mi := &file_messages_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -779,7 +905,7 @@ func (x *AdminLoginGranted) String() string {
 func (*AdminLoginGranted) ProtoMessage() {}
 
 func (x *AdminLoginGranted) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[13]
+	mi := &file_messages_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,7 +918,7 @@ func (x *AdminLoginGranted) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminLoginGranted.ProtoReflect.Descriptor instead.
 func (*AdminLoginGranted) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{13}
+	return file_messages_proto_rawDescGZIP(), []int{14}
 }
 
 type SqlQuery struct {
@@ -804,7 +930,7 @@ type SqlQuery struct {
 
 func (x *SqlQuery) Reset() {
 	*x = SqlQuery{}
-	mi := &file_messages_proto_msgTypes[14]
+	mi := &file_messages_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -816,7 +942,7 @@ func (x *SqlQuery) String() string {
 func (*SqlQuery) ProtoMessage() {}
 
 func (x *SqlQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[14]
+	mi := &file_messages_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,7 +955,7 @@ func (x *SqlQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SqlQuery.ProtoReflect.Descriptor instead.
 func (*SqlQuery) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{14}
+	return file_messages_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SqlQuery) GetQuery() string {
@@ -848,7 +974,7 @@ type SqlRow struct {
 
 func (x *SqlRow) Reset() {
 	*x = SqlRow{}
-	mi := &file_messages_proto_msgTypes[15]
+	mi := &file_messages_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -860,7 +986,7 @@ func (x *SqlRow) String() string {
 func (*SqlRow) ProtoMessage() {}
 
 func (x *SqlRow) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[15]
+	mi := &file_messages_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,7 +999,7 @@ func (x *SqlRow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SqlRow.ProtoReflect.Descriptor instead.
 func (*SqlRow) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{15}
+	return file_messages_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SqlRow) GetValues() []string {
@@ -888,13 +1014,14 @@ type SqlResponse struct {
 	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 	Columns       []string               `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
 	Rows          []*SqlRow              `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"`
+	Truncated     bool                   `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"` // Whether there were more rows than the server is willing to send
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SqlResponse) Reset() {
 	*x = SqlResponse{}
-	mi := &file_messages_proto_msgTypes[16]
+	mi := &file_messages_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -906,7 +1033,7 @@ func (x *SqlResponse) String() string {
 func (*SqlResponse) ProtoMessage() {}
 
 func (x *SqlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[16]
+	mi := &file_messages_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,7 +1046,7 @@ func (x *SqlResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SqlResponse.ProtoReflect.Descriptor instead.
 func (*SqlResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{16}
+	return file_messages_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SqlResponse) GetResponse() *Response {
@@ -943,6 +1070,13 @@ func (x *SqlResponse) GetRows() []*SqlRow {
 	return nil
 }
 
+func (x *SqlResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
 type CollisionPoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
@@ -953,7 +1087,7 @@ type CollisionPoint struct {
 
 func (x *CollisionPoint) Reset() {
 	*x = CollisionPoint{}
-	mi := &file_messages_proto_msgTypes[17]
+	mi := &file_messages_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -965,7 +1099,7 @@ func (x *CollisionPoint) String() string {
 func (*CollisionPoint) ProtoMessage() {}
 
 func (x *CollisionPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[17]
+	mi := &file_messages_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,7 +1112,7 @@ func (x *CollisionPoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollisionPoint.ProtoReflect.Descriptor instead.
 func (*CollisionPoint) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{17}
+	return file_messages_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CollisionPoint) GetX() int32 {
@@ -1007,7 +1141,7 @@ type Shrub struct {
 
 func (x *Shrub) Reset() {
 	*x = Shrub{}
-	mi := &file_messages_proto_msgTypes[18]
+	mi := &file_messages_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1019,7 +1153,7 @@ func (x *Shrub) String() string {
 func (*Shrub) ProtoMessage() {}
 
 func (x *Shrub) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[18]
+	mi := &file_messages_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,7 +1166,7 @@ func (x *Shrub) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Shrub.ProtoReflect.Descriptor instead.
 func (*Shrub) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{18}
+	return file_messages_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *Shrub) GetId() uint32 {
@@ -1075,7 +1209,7 @@ type Ore struct {
 
 func (x *Ore) Reset() {
 	*x = Ore{}
-	mi := &file_messages_proto_msgTypes[19]
+	mi := &file_messages_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1087,7 +1221,7 @@ func (x *Ore) String() string {
 func (*Ore) ProtoMessage() {}
 
 func (x *Ore) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[19]
+	mi := &file_messages_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1100,7 +1234,7 @@ func (x *Ore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ore.ProtoReflect.Descriptor instead.
 func (*Ore) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{19}
+	return file_messages_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Ore) GetId() uint32 {
@@ -1146,7 +1280,7 @@ type Door struct {
 
 func (x *Door) Reset() {
 	*x = Door{}
-	mi := &file_messages_proto_msgTypes[20]
+	mi := &file_messages_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1158,7 +1292,7 @@ func (x *Door) String() string {
 func (*Door) ProtoMessage() {}
 
 func (x *Door) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[20]
+	mi := &file_messages_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1171,7 +1305,7 @@ func (x *Door) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Door.ProtoReflect.Descriptor instead.
 func (*Door) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{20}
+	return file_messages_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Door) GetId() uint32 {
@@ -1235,7 +1369,7 @@ type ToolProps struct {
 
 func (x *ToolProps) Reset() {
 	*x = ToolProps{}
-	mi := &file_messages_proto_msgTypes[21]
+	mi := &file_messages_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1247,7 +1381,7 @@ func (x *ToolProps) String() string {
 func (*ToolProps) ProtoMessage() {}
 
 func (x *ToolProps) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[21]
+	mi := &file_messages_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1260,7 +1394,7 @@ func (x *ToolProps) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolProps.ProtoReflect.Descriptor instead.
 func (*ToolProps) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{21}
+	return file_messages_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ToolProps) GetStrength() int32 {
@@ -1307,7 +1441,7 @@ type Item struct {
 
 func (x *Item) Reset() {
 	*x = Item{}
-	mi := &file_messages_proto_msgTypes[22]
+	mi := &file_messages_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1319,7 +1453,7 @@ func (x *Item) String() string {
 func (*Item) ProtoMessage() {}
 
 func (x *Item) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[22]
+	mi := &file_messages_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1332,7 +1466,7 @@ func (x *Item) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Item.ProtoReflect.Descriptor instead.
 func (*Item) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{22}
+	return file_messages_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Item) GetName() string {
@@ -1406,7 +1540,7 @@ type GroundItem struct {
 
 func (x *GroundItem) Reset() {
 	*x = GroundItem{}
-	mi := &file_messages_proto_msgTypes[23]
+	mi := &file_messages_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1418,7 +1552,7 @@ func (x *GroundItem) String() string {
 func (*GroundItem) ProtoMessage() {}
 
 func (x *GroundItem) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[23]
+	mi := &file_messages_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1431,7 +1565,7 @@ func (x *GroundItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroundItem.ProtoReflect.Descriptor instead.
 func (*GroundItem) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{23}
+	return file_messages_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GroundItem) GetId() uint32 {
@@ -1498,7 +1632,7 @@ type LevelUpload struct {
 
 func (x *LevelUpload) Reset() {
 	*x = LevelUpload{}
-	mi := &file_messages_proto_msgTypes[24]
+	mi := &file_messages_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1510,7 +1644,7 @@ func (x *LevelUpload) String() string {
 func (*LevelUpload) ProtoMessage() {}
 
 func (x *LevelUpload) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[24]
+	mi := &file_messages_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1523,7 +1657,7 @@ func (x *LevelUpload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LevelUpload.ProtoReflect.Descriptor instead.
 func (*LevelUpload) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{24}
+	return file_messages_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *LevelUpload) GetGdResPath() string {
@@ -1586,7 +1720,7 @@ type LevelUploadResponse struct {
 
 func (x *LevelUploadResponse) Reset() {
 	*x = LevelUploadResponse{}
-	mi := &file_messages_proto_msgTypes[25]
+	mi := &file_messages_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1598,7 +1732,7 @@ func (x *LevelUploadResponse) String() string {
 func (*LevelUploadResponse) ProtoMessage() {}
 
 func (x *LevelUploadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[25]
+	mi := &file_messages_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1611,7 +1745,7 @@ func (x *LevelUploadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LevelUploadResponse.ProtoReflect.Descriptor instead.
 func (*LevelUploadResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{25}
+	return file_messages_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *LevelUploadResponse) GetDbLevelId() int32 {
@@ -1644,7 +1778,7 @@ type LevelDownload struct {
 
 func (x *LevelDownload) Reset() {
 	*x = LevelDownload{}
-	mi := &file_messages_proto_msgTypes[26]
+	mi := &file_messages_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1656,7 +1790,7 @@ func (x *LevelDownload) String() string {
 func (*LevelDownload) ProtoMessage() {}
 
 func (x *LevelDownload) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[26]
+	mi := &file_messages_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1669,7 +1803,7 @@ func (x *LevelDownload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LevelDownload.ProtoReflect.Descriptor instead.
 func (*LevelDownload) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{26}
+	return file_messages_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *LevelDownload) GetData() []byte {
@@ -1687,7 +1821,7 @@ type AdminJoinGameRequest struct {
 
 func (x *AdminJoinGameRequest) Reset() {
 	*x = AdminJoinGameRequest{}
-	mi := &file_messages_proto_msgTypes[27]
+	mi := &file_messages_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1699,7 +1833,7 @@ func (x *AdminJoinGameRequest) String() string {
 func (*AdminJoinGameRequest) ProtoMessage() {}
 
 func (x *AdminJoinGameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[27]
+	mi := &file_messages_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1712,7 +1846,7 @@ func (x *AdminJoinGameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminJoinGameRequest.ProtoReflect.Descriptor instead.
 func (*AdminJoinGameRequest) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{27}
+	return file_messages_proto_rawDescGZIP(), []int{28}
 }
 
 type AdminJoinGameResponse struct {
@@ -1724,7 +1858,7 @@ type AdminJoinGameResponse struct {
 
 func (x *AdminJoinGameResponse) Reset() {
 	*x = AdminJoinGameResponse{}
-	mi := &file_messages_proto_msgTypes[28]
+	mi := &file_messages_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1736,7 +1870,7 @@ func (x *AdminJoinGameResponse) String() string {
 func (*AdminJoinGameResponse) ProtoMessage() {}
 
 func (x *AdminJoinGameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[28]
+	mi := &file_messages_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1749,7 +1883,7 @@ func (x *AdminJoinGameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminJoinGameResponse.ProtoReflect.Descriptor instead.
 func (*AdminJoinGameResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{28}
+	return file_messages_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AdminJoinGameResponse) GetResponse() *Response {
@@ -1768,7 +1902,7 @@ type ServerMessage struct {
 
 func (x *ServerMessage) Reset() {
 	*x = ServerMessage{}
-	mi := &file_messages_proto_msgTypes[29]
+	mi := &file_messages_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1780,7 +1914,7 @@ func (x *ServerMessage) String() string {
 func (*ServerMessage) ProtoMessage() {}
 
 func (x *ServerMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[29]
+	mi := &file_messages_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1793,7 +1927,7 @@ func (x *ServerMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerMessage.ProtoReflect.Descriptor instead.
 func (*ServerMessage) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{29}
+	return file_messages_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ServerMessage) GetMsg() string {
@@ -1812,7 +1946,7 @@ type PickupGroundItemRequest struct {
 
 func (x *PickupGroundItemRequest) Reset() {
 	*x = PickupGroundItemRequest{}
-	mi := &file_messages_proto_msgTypes[30]
+	mi := &file_messages_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1824,7 +1958,7 @@ func (x *PickupGroundItemRequest) String() string {
 func (*PickupGroundItemRequest) ProtoMessage() {}
 
 func (x *PickupGroundItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[30]
+	mi := &file_messages_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1837,7 +1971,7 @@ func (x *PickupGroundItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PickupGroundItemRequest.ProtoReflect.Descriptor instead.
 func (*PickupGroundItemRequest) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{30}
+	return file_messages_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *PickupGroundItemRequest) GetGroundItemId() uint32 {
@@ -1857,7 +1991,7 @@ type PickupGroundItemResponse struct {
 
 func (x *PickupGroundItemResponse) Reset() {
 	*x = PickupGroundItemResponse{}
-	mi := &file_messages_proto_msgTypes[31]
+	mi := &file_messages_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1869,7 +2003,7 @@ func (x *PickupGroundItemResponse) String() string {
 func (*PickupGroundItemResponse) ProtoMessage() {}
 
 func (x *PickupGroundItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[31]
+	mi := &file_messages_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1882,7 +2016,7 @@ func (x *PickupGroundItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PickupGroundItemResponse.ProtoReflect.Descriptor instead.
 func (*PickupGroundItemResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{31}
+	return file_messages_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *PickupGroundItemResponse) GetGroundItem() *GroundItem {
@@ -1909,7 +2043,7 @@ type DropItemRequest struct {
 
 func (x *DropItemRequest) Reset() {
 	*x = DropItemRequest{}
-	mi := &file_messages_proto_msgTypes[32]
+	mi := &file_messages_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1921,7 +2055,7 @@ func (x *DropItemRequest) String() string {
 func (*DropItemRequest) ProtoMessage() {}
 
 func (x *DropItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[32]
+	mi := &file_messages_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1934,7 +2068,7 @@ func (x *DropItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropItemRequest.ProtoReflect.Descriptor instead.
 func (*DropItemRequest) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{32}
+	return file_messages_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *DropItemRequest) GetItem() *Item {
@@ -1962,7 +2096,7 @@ type DropItemResponse struct {
 
 func (x *DropItemResponse) Reset() {
 	*x = DropItemResponse{}
-	mi := &file_messages_proto_msgTypes[33]
+	mi := &file_messages_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1974,7 +2108,7 @@ func (x *DropItemResponse) String() string {
 func (*DropItemResponse) ProtoMessage() {}
 
 func (x *DropItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[33]
+	mi := &file_messages_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1987,7 +2121,7 @@ func (x *DropItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropItemResponse.ProtoReflect.Descriptor instead.
 func (*DropItemResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{33}
+	return file_messages_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DropItemResponse) GetItem() *Item {
@@ -2021,7 +2155,7 @@ type ItemQuantity struct {
 
 func (x *ItemQuantity) Reset() {
 	*x = ItemQuantity{}
-	mi := &file_messages_proto_msgTypes[34]
+	mi := &file_messages_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2033,7 +2167,7 @@ func (x *ItemQuantity) String() string {
 func (*ItemQuantity) ProtoMessage() {}
 
 func (x *ItemQuantity) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[34]
+	mi := &file_messages_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2046,7 +2180,7 @@ func (x *ItemQuantity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ItemQuantity.ProtoReflect.Descriptor instead.
 func (*ItemQuantity) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{34}
+	return file_messages_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ItemQuantity) GetItem() *Item {
@@ -2072,7 +2206,7 @@ type ActorInventory struct {
 
 func (x *ActorInventory) Reset() {
 	*x = ActorInventory{}
-	mi := &file_messages_proto_msgTypes[35]
+	mi := &file_messages_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2084,7 +2218,7 @@ func (x *ActorInventory) String() string {
 func (*ActorInventory) ProtoMessage() {}
 
 func (x *ActorInventory) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[35]
+	mi := &file_messages_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2097,7 +2231,7 @@ func (x *ActorInventory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActorInventory.ProtoReflect.Descriptor instead.
 func (*ActorInventory) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{35}
+	return file_messages_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ActorInventory) GetItemsQuantities() []*ItemQuantity {
@@ -2116,7 +2250,7 @@ type ChopShrubRequest struct {
 
 func (x *ChopShrubRequest) Reset() {
 	*x = ChopShrubRequest{}
-	mi := &file_messages_proto_msgTypes[36]
+	mi := &file_messages_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2128,7 +2262,7 @@ func (x *ChopShrubRequest) String() string {
 func (*ChopShrubRequest) ProtoMessage() {}
 
 func (x *ChopShrubRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[36]
+	mi := &file_messages_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2141,7 +2275,7 @@ func (x *ChopShrubRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChopShrubRequest.ProtoReflect.Descriptor instead.
 func (*ChopShrubRequest) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{36}
+	return file_messages_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ChopShrubRequest) GetShrubId() uint32 {
@@ -2161,7 +2295,7 @@ type ChopShrubResponse struct {
 
 func (x *ChopShrubResponse) Reset() {
 	*x = ChopShrubResponse{}
-	mi := &file_messages_proto_msgTypes[37]
+	mi := &file_messages_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2173,7 +2307,7 @@ func (x *ChopShrubResponse) String() string {
 func (*ChopShrubResponse) ProtoMessage() {}
 
 func (x *ChopShrubResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[37]
+	mi := &file_messages_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2186,7 +2320,7 @@ func (x *ChopShrubResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChopShrubResponse.ProtoReflect.Descriptor instead.
 func (*ChopShrubResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{37}
+	return file_messages_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ChopShrubResponse) GetShrubId() uint32 {
@@ -2212,7 +2346,7 @@ type MineOreRequest struct {
 
 func (x *MineOreRequest) Reset() {
 	*x = MineOreRequest{}
-	mi := &file_messages_proto_msgTypes[38]
+	mi := &file_messages_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2224,7 +2358,7 @@ func (x *MineOreRequest) String() string {
 func (*MineOreRequest) ProtoMessage() {}
 
 func (x *MineOreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[38]
+	mi := &file_messages_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2237,7 +2371,7 @@ func (x *MineOreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MineOreRequest.ProtoReflect.Descriptor instead.
 func (*MineOreRequest) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{38}
+	return file_messages_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *MineOreRequest) GetOreId() uint32 {
@@ -2257,7 +2391,7 @@ type MineOreResponse struct {
 
 func (x *MineOreResponse) Reset() {
 	*x = MineOreResponse{}
-	mi := &file_messages_proto_msgTypes[39]
+	mi := &file_messages_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2269,7 +2403,7 @@ func (x *MineOreResponse) String() string {
 func (*MineOreResponse) ProtoMessage() {}
 
 func (x *MineOreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[39]
+	mi := &file_messages_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2282,7 +2416,7 @@ func (x *MineOreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MineOreResponse.ProtoReflect.Descriptor instead.
 func (*MineOreResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{39}
+	return file_messages_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *MineOreResponse) GetOreId() uint32 {
@@ -2309,7 +2443,7 @@ type XpReward struct {
 
 func (x *XpReward) Reset() {
 	*x = XpReward{}
-	mi := &file_messages_proto_msgTypes[40]
+	mi := &file_messages_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2321,7 +2455,7 @@ func (x *XpReward) String() string {
 func (*XpReward) ProtoMessage() {}
 
 func (x *XpReward) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[40]
+	mi := &file_messages_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2334,7 +2468,7 @@ func (x *XpReward) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use XpReward.ProtoReflect.Descriptor instead.
 func (*XpReward) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{40}
+	return file_messages_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *XpReward) GetSkill() uint32 {
@@ -2360,7 +2494,7 @@ type SkillsXp struct {
 
 func (x *SkillsXp) Reset() {
 	*x = SkillsXp{}
-	mi := &file_messages_proto_msgTypes[41]
+	mi := &file_messages_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2372,7 +2506,7 @@ func (x *SkillsXp) String() string {
 func (*SkillsXp) ProtoMessage() {}
 
 func (x *SkillsXp) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[41]
+	mi := &file_messages_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2385,7 +2519,7 @@ func (x *SkillsXp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SkillsXp.ProtoReflect.Descriptor instead.
 func (*SkillsXp) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{41}
+	return file_messages_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *SkillsXp) GetXpRewards() []*XpReward {
@@ -2404,7 +2538,7 @@ type InteractWithNpcRequest struct {
 
 func (x *InteractWithNpcRequest) Reset() {
 	*x = InteractWithNpcRequest{}
-	mi := &file_messages_proto_msgTypes[42]
+	mi := &file_messages_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2416,7 +2550,7 @@ func (x *InteractWithNpcRequest) String() string {
 func (*InteractWithNpcRequest) ProtoMessage() {}
 
 func (x *InteractWithNpcRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[42]
+	mi := &file_messages_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2429,7 +2563,7 @@ func (x *InteractWithNpcRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InteractWithNpcRequest.ProtoReflect.Descriptor instead.
 func (*InteractWithNpcRequest) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{42}
+	return file_messages_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *InteractWithNpcRequest) GetActorId() uint32 {
@@ -2449,7 +2583,7 @@ type InteractWithNpcResponse struct {
 
 func (x *InteractWithNpcResponse) Reset() {
 	*x = InteractWithNpcResponse{}
-	mi := &file_messages_proto_msgTypes[43]
+	mi := &file_messages_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2461,7 +2595,7 @@ func (x *InteractWithNpcResponse) String() string {
 func (*InteractWithNpcResponse) ProtoMessage() {}
 
 func (x *InteractWithNpcResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[43]
+	mi := &file_messages_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2474,7 +2608,7 @@ func (x *InteractWithNpcResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InteractWithNpcResponse.ProtoReflect.Descriptor instead.
 func (*InteractWithNpcResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{43}
+	return file_messages_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *InteractWithNpcResponse) GetActorId() uint32 {
@@ -2501,7 +2635,7 @@ type NpcDialogue struct {
 
 func (x *NpcDialogue) Reset() {
 	*x = NpcDialogue{}
-	mi := &file_messages_proto_msgTypes[44]
+	mi := &file_messages_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2513,7 +2647,7 @@ func (x *NpcDialogue) String() string {
 func (*NpcDialogue) ProtoMessage() {}
 
 func (x *NpcDialogue) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[44]
+	mi := &file_messages_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2526,7 +2660,7 @@ func (x *NpcDialogue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NpcDialogue.ProtoReflect.Descriptor instead.
 func (*NpcDialogue) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{44}
+	return file_messages_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *NpcDialogue) GetActorId() uint32 {
@@ -2554,7 +2688,7 @@ type BuyRequest struct {
 
 func (x *BuyRequest) Reset() {
 	*x = BuyRequest{}
-	mi := &file_messages_proto_msgTypes[45]
+	mi := &file_messages_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2566,7 +2700,7 @@ func (x *BuyRequest) String() string {
 func (*BuyRequest) ProtoMessage() {}
 
 func (x *BuyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[45]
+	mi := &file_messages_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2579,7 +2713,7 @@ func (x *BuyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuyRequest.ProtoReflect.Descriptor instead.
 func (*BuyRequest) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{45}
+	return file_messages_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *BuyRequest) GetShopOwnerActorId() uint32 {
@@ -2614,7 +2748,7 @@ type BuyResponse struct {
 
 func (x *BuyResponse) Reset() {
 	*x = BuyResponse{}
-	mi := &file_messages_proto_msgTypes[46]
+	mi := &file_messages_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2626,7 +2760,7 @@ func (x *BuyResponse) String() string {
 func (*BuyResponse) ProtoMessage() {}
 
 func (x *BuyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[46]
+	mi := &file_messages_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2639,7 +2773,7 @@ func (x *BuyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuyResponse.ProtoReflect.Descriptor instead.
 func (*BuyResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{46}
+	return file_messages_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *BuyResponse) GetShopOwnerActorId() uint32 {
@@ -2674,7 +2808,7 @@ type SellRequest struct {
 
 func (x *SellRequest) Reset() {
 	*x = SellRequest{}
-	mi := &file_messages_proto_msgTypes[47]
+	mi := &file_messages_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2686,7 +2820,7 @@ func (x *SellRequest) String() string {
 func (*SellRequest) ProtoMessage() {}
 
 func (x *SellRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[47]
+	mi := &file_messages_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2699,7 +2833,7 @@ func (x *SellRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SellRequest.ProtoReflect.Descriptor instead.
 func (*SellRequest) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{47}
+	return file_messages_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *SellRequest) GetShopOwnerActorId() uint32 {
@@ -2734,7 +2868,7 @@ type SellResponse struct {
 
 func (x *SellResponse) Reset() {
 	*x = SellResponse{}
-	mi := &file_messages_proto_msgTypes[48]
+	mi := &file_messages_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2746,7 +2880,7 @@ func (x *SellResponse) String() string {
 func (*SellResponse) ProtoMessage() {}
 
 func (x *SellResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[48]
+	mi := &file_messages_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2759,7 +2893,7 @@ func (x *SellResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SellResponse.ProtoReflect.Descriptor instead.
 func (*SellResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{48}
+	return file_messages_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *SellResponse) GetShopOwnerActorId() uint32 {
@@ -2793,7 +2927,7 @@ type LevelMetadata struct {
 
 func (x *LevelMetadata) Reset() {
 	*x = LevelMetadata{}
-	mi := &file_messages_proto_msgTypes[49]
+	mi := &file_messages_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2805,7 +2939,7 @@ func (x *LevelMetadata) String() string {
 func (*LevelMetadata) ProtoMessage() {}
 
 func (x *LevelMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[49]
+	mi := &file_messages_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2818,7 +2952,7 @@ func (x *LevelMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LevelMetadata.ProtoReflect.Descriptor instead.
 func (*LevelMetadata) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{49}
+	return file_messages_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *LevelMetadata) GetGdResPath() string {
@@ -2848,7 +2982,7 @@ type QuestInfo struct {
 
 func (x *QuestInfo) Reset() {
 	*x = QuestInfo{}
-	mi := &file_messages_proto_msgTypes[50]
+	mi := &file_messages_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2860,7 +2994,7 @@ func (x *QuestInfo) String() string {
 func (*QuestInfo) ProtoMessage() {}
 
 func (x *QuestInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[50]
+	mi := &file_messages_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2873,7 +3007,7 @@ func (x *QuestInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuestInfo.ProtoReflect.Descriptor instead.
 func (*QuestInfo) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{50}
+	return file_messages_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *QuestInfo) GetName() string {
@@ -2921,7 +3055,7 @@ type DespawnGroundItem struct {
 
 func (x *DespawnGroundItem) Reset() {
 	*x = DespawnGroundItem{}
-	mi := &file_messages_proto_msgTypes[51]
+	mi := &file_messages_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2933,7 +3067,7 @@ func (x *DespawnGroundItem) String() string {
 func (*DespawnGroundItem) ProtoMessage() {}
 
 func (x *DespawnGroundItem) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[51]
+	mi := &file_messages_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2946,7 +3080,7 @@ func (x *DespawnGroundItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DespawnGroundItem.ProtoReflect.Descriptor instead.
 func (*DespawnGroundItem) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{51}
+	return file_messages_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *DespawnGroundItem) GetGroundItemId() uint32 {
@@ -2963,79 +3097,31 @@ func (x *DespawnGroundItem) GetLevelId() int32 {
 	return 0
 }
 
-type Packet struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	SenderId uint32                 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	// Types that are valid to be assigned to Msg:
-	//
-	//	*Packet_ClientId
-	//	*Packet_LoginRequest
-	//	*Packet_LoginResponse
-	//	*Packet_RegisterRequest
-	//	*Packet_RegisterResponse
-	//	*Packet_Logout
-	//	*Packet_Chat
-	//	*Packet_Yell
-	//	*Packet_Actor
-	//	*Packet_ActorMove
-	//	*Packet_Motd
-	//	*Packet_Disconnect
-	//	*Packet_AdminLoginGranted
-	//	*Packet_SqlQuery
-	//	*Packet_SqlResponse
-	//	*Packet_LevelUpload
-	//	*Packet_LevelUploadResponse
-	//	*Packet_LevelDownload
-	//	*Packet_AdminJoinGameRequest
-	//	*Packet_AdminJoinGameResponse
-	//	*Packet_ServerMessage
-	//	*Packet_PickupGroundItemRequest
-	//	*Packet_PickupGroundItemResponse
-	//	*Packet_Shrub
-	//	*Packet_Ore
-	//	*Packet_Door
-	//	*Packet_Item
-	//	*Packet_GroundItem
-	//	*Packet_ActorInventory
-	//	*Packet_DropItemRequest
-	//	*Packet_DropItemResponse
-	//	*Packet_ChopShrubRequest
-	//	*Packet_ChopShrubResponse
-	//	*Packet_MineOreRequest
-	//	*Packet_MineOreResponse
-	//	*Packet_ItemQuantity
-	//	*Packet_XpReward
-	//	*Packet_SkillsXp
-	//	*Packet_InteractWithNpcResponse
-	//	*Packet_InteractWithNpcRequest
-	//	*Packet_NpcDialogue
-	//	*Packet_BuyRequest
-	//	*Packet_BuyResponse
-	//	*Packet_SellRequest
-	//	*Packet_SellResponse
-	//	*Packet_LevelMetadata
-	//	*Packet_QuestInfo
-	//	*Packet_DespawnGroundItem
-	Msg           isPacket_Msg `protobuf_oneof:"msg"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Sent to a client when the server had to drop some of its packets because it was sending them faster than they could
+// be processed. The client should slow down and resend anything important after the given delay.
+type Backpressure struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DroppedPackets uint32                 `protobuf:"varint,1,opt,name=dropped_packets,json=droppedPackets,proto3" json:"dropped_packets,omitempty"`
+	RetryAfterMs   uint32                 `protobuf:"varint,2,opt,name=retry_after_ms,json=retryAfterMs,proto3" json:"retry_after_ms,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Packet) Reset() {
-	*x = Packet{}
-	mi := &file_messages_proto_msgTypes[52]
+func (x *Backpressure) Reset() {
+	*x = Backpressure{}
+	mi := &file_messages_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) String() string {
+func (x *Backpressure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Packet) ProtoMessage() {}
+func (*Backpressure) ProtoMessage() {}
 
-func (x *Packet) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[52]
+func (x *Backpressure) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3046,651 +3132,7916 @@ func (x *Packet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
-func (*Packet) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use Backpressure.ProtoReflect.Descriptor instead.
+func (*Backpressure) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *Packet) GetSenderId() uint32 {
+func (x *Backpressure) GetDroppedPackets() uint32 {
 	if x != nil {
-		return x.SenderId
+		return x.DroppedPackets
 	}
 	return 0
 }
 
-func (x *Packet) GetMsg() isPacket_Msg {
+func (x *Backpressure) GetRetryAfterMs() uint32 {
 	if x != nil {
-		return x.Msg
+		return x.RetryAfterMs
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetClientId() *ClientId {
+// Sent by the client when it first connects, saying which version of this protocol it speaks and which optional
+// capabilities (e.g. "batching") it would like. Clients that never say hello are treated as protocol version 1.
+type Hello struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProtocolVersion uint32                 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	Capabilities    []string               `protobuf:"bytes,2,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Hello) Reset() {
+	*x = Hello{}
+	mi := &file_messages_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hello) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hello) ProtoMessage() {}
+
+func (x *Hello) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[54]
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_ClientId); ok {
-			return x.ClientId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Packet) GetLoginRequest() *LoginRequest {
+// Deprecated: Use Hello.ProtoReflect.Descriptor instead.
+func (*Hello) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Hello) GetProtocolVersion() uint32 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_LoginRequest); ok {
-			return x.LoginRequest
-		}
+		return x.ProtocolVersion
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetLoginResponse() *LoginResponse {
+func (x *Hello) GetCapabilities() []string {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_LoginResponse); ok {
-			return x.LoginResponse
-		}
+		return x.Capabilities
 	}
 	return nil
 }
 
-func (x *Packet) GetRegisterRequest() *RegisterRequest {
+// The server's answer to Hello. If the client's version isn't supported, success is false, the message explains why,
+// and the connection is closed. Otherwise, capabilities are the ones the server has turned on for this client.
+type HelloResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Response           *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	ProtocolVersion    uint32                 `protobuf:"varint,2,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	MinProtocolVersion uint32                 `protobuf:"varint,3,opt,name=min_protocol_version,json=minProtocolVersion,proto3" json:"min_protocol_version,omitempty"`
+	Capabilities       []string               `protobuf:"bytes,4,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	TickRate           uint32                 `protobuf:"varint,5,opt,name=tick_rate,json=tickRate,proto3" json:"tick_rate,omitempty"` // How many ticks the server simulates per second
+	Tick               uint64                 `protobuf:"varint,6,opt,name=tick,proto3" json:"tick,omitempty"`                         // The server's current tick
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *HelloResponse) Reset() {
+	*x = HelloResponse{}
+	mi := &file_messages_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HelloResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HelloResponse) ProtoMessage() {}
+
+func (x *HelloResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[55]
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_RegisterRequest); ok {
-			return x.RegisterRequest
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Packet) GetRegisterResponse() *RegisterResponse {
+// Deprecated: Use HelloResponse.ProtoReflect.Descriptor instead.
+func (*HelloResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *HelloResponse) GetResponse() *Response {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_RegisterResponse); ok {
-			return x.RegisterResponse
-		}
+		return x.Response
 	}
 	return nil
 }
 
-func (x *Packet) GetLogout() *Logout {
+func (x *HelloResponse) GetProtocolVersion() uint32 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Logout); ok {
-			return x.Logout
-		}
+		return x.ProtocolVersion
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetChat() *Chat {
+func (x *HelloResponse) GetMinProtocolVersion() uint32 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Chat); ok {
-			return x.Chat
-		}
+		return x.MinProtocolVersion
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetYell() *Yell {
+func (x *HelloResponse) GetCapabilities() []string {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Yell); ok {
-			return x.Yell
-		}
+		return x.Capabilities
 	}
 	return nil
 }
 
-func (x *Packet) GetActor() *Actor {
+func (x *HelloResponse) GetTickRate() uint32 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Actor); ok {
-			return x.Actor
-		}
+		return x.TickRate
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetActorMove() *ActorMove {
+func (x *HelloResponse) GetTick() uint64 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_ActorMove); ok {
-			return x.ActorMove
-		}
+		return x.Tick
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetMotd() *Motd {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_Motd); ok {
-			return x.Motd
-		}
-	}
-	return nil
+// Sent instead of logging in after a dropped connection, to be re-attached to the game you were in
+type ResumeSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Packet) GetDisconnect() *Disconnect {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_Disconnect); ok {
-			return x.Disconnect
-		}
-	}
-	return nil
+func (x *ResumeSession) Reset() {
+	*x = ResumeSession{}
+	mi := &file_messages_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) GetAdminLoginGranted() *AdminLoginGranted {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_AdminLoginGranted); ok {
-			return x.AdminLoginGranted
-		}
-	}
-	return nil
+func (x *ResumeSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Packet) GetSqlQuery() *SqlQuery {
+func (*ResumeSession) ProtoMessage() {}
+
+func (x *ResumeSession) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[56]
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SqlQuery); ok {
-			return x.SqlQuery
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Packet) GetSqlResponse() *SqlResponse {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_SqlResponse); ok {
-			return x.SqlResponse
-		}
-	}
-	return nil
+// Deprecated: Use ResumeSession.ProtoReflect.Descriptor instead.
+func (*ResumeSession) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *Packet) GetLevelUpload() *LevelUpload {
+func (x *ResumeSession) GetSessionToken() string {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_LevelUpload); ok {
-			return x.LevelUpload
-		}
+		return x.SessionToken
 	}
-	return nil
+	return ""
 }
 
-func (x *Packet) GetLevelUploadResponse() *LevelUploadResponse {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_LevelUploadResponse); ok {
-			return x.LevelUploadResponse
-		}
-	}
-	return nil
+// If successful, followed by your client ID and a fresh snapshot of the level. The old token can't be used again, so
+// hold on to the new one.
+type ResumeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Packet) GetLevelDownload() *LevelDownload {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_LevelDownload); ok {
-			return x.LevelDownload
-		}
-	}
-	return nil
+func (x *ResumeSessionResponse) Reset() {
+	*x = ResumeSessionResponse{}
+	mi := &file_messages_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) GetAdminJoinGameRequest() *AdminJoinGameRequest {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_AdminJoinGameRequest); ok {
-			return x.AdminJoinGameRequest
-		}
-	}
-	return nil
+func (x *ResumeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Packet) GetAdminJoinGameResponse() *AdminJoinGameResponse {
+func (*ResumeSessionResponse) ProtoMessage() {}
+
+func (x *ResumeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[57]
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_AdminJoinGameResponse); ok {
-			return x.AdminJoinGameResponse
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Packet) GetServerMessage() *ServerMessage {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_ServerMessage); ok {
-			return x.ServerMessage
-		}
-	}
-	return nil
+// Deprecated: Use ResumeSessionResponse.ProtoReflect.Descriptor instead.
+func (*ResumeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *Packet) GetPickupGroundItemRequest() *PickupGroundItemRequest {
+func (x *ResumeSessionResponse) GetResponse() *Response {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_PickupGroundItemRequest); ok {
-			return x.PickupGroundItemRequest
-		}
+		return x.Response
 	}
 	return nil
 }
 
-func (x *Packet) GetPickupGroundItemResponse() *PickupGroundItemResponse {
+func (x *ResumeSessionResponse) GetSessionToken() string {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_PickupGroundItemResponse); ok {
-			return x.PickupGroundItemResponse
-		}
+		return x.SessionToken
 	}
-	return nil
+	return ""
 }
 
-func (x *Packet) GetShrub() *Shrub {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_Shrub); ok {
-			return x.Shrub
-		}
-	}
-	return nil
+// Sets a new password. When logged in, the username is ignored and the change is for your own account. Otherwise, the
+// username is needed, e.g. to replace a temporary password from an admin, which can't be used to log in directly.
+type ChangePassword struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	OldPassword   string                 `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Packet) GetOre() *Ore {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_Ore); ok {
-			return x.Ore
-		}
-	}
-	return nil
+func (x *ChangePassword) Reset() {
+	*x = ChangePassword{}
+	mi := &file_messages_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) GetDoor() *Door {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_Door); ok {
-			return x.Door
-		}
-	}
-	return nil
+func (x *ChangePassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Packet) GetItem() *Item {
+func (*ChangePassword) ProtoMessage() {}
+
+func (x *ChangePassword) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[58]
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Item); ok {
-			return x.Item
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Packet) GetGroundItem() *GroundItem {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_GroundItem); ok {
-			return x.GroundItem
-		}
-	}
-	return nil
+// Deprecated: Use ChangePassword.ProtoReflect.Descriptor instead.
+func (*ChangePassword) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *Packet) GetActorInventory() *ActorInventory {
+func (x *ChangePassword) GetUsername() string {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_ActorInventory); ok {
-			return x.ActorInventory
-		}
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *Packet) GetDropItemRequest() *DropItemRequest {
+func (x *ChangePassword) GetOldPassword() string {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_DropItemRequest); ok {
-			return x.DropItemRequest
-		}
+		return x.OldPassword
 	}
-	return nil
+	return ""
 }
 
-func (x *Packet) GetDropItemResponse() *DropItemResponse {
+func (x *ChangePassword) GetNewPassword() string {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_DropItemResponse); ok {
-			return x.DropItemResponse
-		}
+		return x.NewPassword
 	}
-	return nil
+	return ""
 }
 
-func (x *Packet) GetChopShrubRequest() *ChopShrubRequest {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_ChopShrubRequest); ok {
-			return x.ChopShrubRequest
-		}
-	}
-	return nil
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Packet) GetChopShrubResponse() *ChopShrubResponse {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_ChopShrubResponse); ok {
-			return x.ChopShrubResponse
-		}
-	}
-	return nil
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_messages_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) GetMineOreRequest() *MineOreRequest {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_MineOreRequest); ok {
-			return x.MineOreRequest
-		}
-	}
-	return nil
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Packet) GetMineOreResponse() *MineOreResponse {
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[59]
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_MineOreResponse); ok {
-			return x.MineOreResponse
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Packet) GetItemQuantity() *ItemQuantity {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_ItemQuantity); ok {
-			return x.ItemQuantity
-		}
-	}
-	return nil
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *Packet) GetXpReward() *XpReward {
+func (x *ChangePasswordResponse) GetResponse() *Response {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_XpReward); ok {
-			return x.XpReward
-		}
+		return x.Response
 	}
 	return nil
 }
 
-func (x *Packet) GetSkillsXp() *SkillsXp {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_SkillsXp); ok {
-			return x.SkillsXp
-		}
-	}
-	return nil
+// Sent by an admin to reset a user's password to a temporary one, which the user has to change before they can log in
+type AdminResetPassword struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Packet) GetInteractWithNpcResponse() *InteractWithNpcResponse {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_InteractWithNpcResponse); ok {
-			return x.InteractWithNpcResponse
-		}
-	}
-	return nil
+func (x *AdminResetPassword) Reset() {
+	*x = AdminResetPassword{}
+	mi := &file_messages_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) GetInteractWithNpcRequest() *InteractWithNpcRequest {
+func (x *AdminResetPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminResetPassword) ProtoMessage() {}
+
+func (x *AdminResetPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[60]
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_InteractWithNpcRequest); ok {
-			return x.InteractWithNpcRequest
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Packet) GetNpcDialogue() *NpcDialogue {
+// Deprecated: Use AdminResetPassword.ProtoReflect.Descriptor instead.
+func (*AdminResetPassword) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AdminResetPassword) GetUsername() string {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_NpcDialogue); ok {
-			return x.NpcDialogue
+		return x.Username
+	}
+	return ""
+}
+
+type AdminResetPasswordResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Response          *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	TemporaryPassword string                 `protobuf:"bytes,2,opt,name=temporary_password,json=temporaryPassword,proto3" json:"temporary_password,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AdminResetPasswordResponse) Reset() {
+	*x = AdminResetPasswordResponse{}
+	mi := &file_messages_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminResetPasswordResponse) ProtoMessage() {}
+
+func (x *AdminResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*AdminResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AdminResetPasswordResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
 	}
 	return nil
 }
 
-func (x *Packet) GetBuyRequest() *BuyRequest {
+func (x *AdminResetPasswordResponse) GetTemporaryPassword() string {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_BuyRequest); ok {
-			return x.BuyRequest
+		return x.TemporaryPassword
+	}
+	return ""
+}
+
+// One of the characters on your account, as shown on the character select screen
+type CharacterInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SpriteRegionX int32                  `protobuf:"varint,3,opt,name=sprite_region_x,json=spriteRegionX,proto3" json:"sprite_region_x,omitempty"`
+	SpriteRegionY int32                  `protobuf:"varint,4,opt,name=sprite_region_y,json=spriteRegionY,proto3" json:"sprite_region_y,omitempty"`
+	LevelId       int32                  `protobuf:"varint,5,opt,name=level_id,json=levelId,proto3" json:"level_id,omitempty"`
+	Appearance    *Appearance            `protobuf:"bytes,6,opt,name=appearance,proto3" json:"appearance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterInfo) Reset() {
+	*x = CharacterInfo{}
+	mi := &file_messages_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterInfo) ProtoMessage() {}
+
+func (x *CharacterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterInfo.ProtoReflect.Descriptor instead.
+func (*CharacterInfo) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CharacterInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CharacterInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetSpriteRegionX() int32 {
+	if x != nil {
+		return x.SpriteRegionX
+	}
+	return 0
+}
+
+func (x *CharacterInfo) GetSpriteRegionY() int32 {
+	if x != nil {
+		return x.SpriteRegionY
+	}
+	return 0
+}
+
+func (x *CharacterInfo) GetLevelId() int32 {
+	if x != nil {
+		return x.LevelId
+	}
+	return 0
+}
+
+func (x *CharacterInfo) GetAppearance() *Appearance {
+	if x != nil {
+		return x.Appearance
 	}
 	return nil
 }
 
-func (x *Packet) GetBuyResponse() *BuyResponse {
+// Sent after logging in (from protocol version 3), and again whenever a character is created, deleted or renamed
+type CharacterList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Characters    []*CharacterInfo       `protobuf:"bytes,1,rep,name=characters,proto3" json:"characters,omitempty"`
+	MaxCharacters uint32                 `protobuf:"varint,2,opt,name=max_characters,json=maxCharacters,proto3" json:"max_characters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterList) Reset() {
+	*x = CharacterList{}
+	mi := &file_messages_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterList) ProtoMessage() {}
+
+func (x *CharacterList) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[63]
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_BuyResponse); ok {
-			return x.BuyResponse
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterList.ProtoReflect.Descriptor instead.
+func (*CharacterList) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CharacterList) GetCharacters() []*CharacterInfo {
+	if x != nil {
+		return x.Characters
 	}
 	return nil
 }
 
-func (x *Packet) GetSellRequest() *SellRequest {
+func (x *CharacterList) GetMaxCharacters() uint32 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SellRequest); ok {
-			return x.SellRequest
+		return x.MaxCharacters
+	}
+	return 0
+}
+
+type CreateCharacterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SpriteRegionX int32                  `protobuf:"varint,2,opt,name=sprite_region_x,json=spriteRegionX,proto3" json:"sprite_region_x,omitempty"` // Only used if there's no appearance
+	SpriteRegionY int32                  `protobuf:"varint,3,opt,name=sprite_region_y,json=spriteRegionY,proto3" json:"sprite_region_y,omitempty"` // Only used if there's no appearance
+	Appearance    *Appearance            `protobuf:"bytes,4,opt,name=appearance,proto3" json:"appearance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCharacterRequest) Reset() {
+	*x = CreateCharacterRequest{}
+	mi := &file_messages_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCharacterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCharacterRequest) ProtoMessage() {}
+
+func (x *CreateCharacterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCharacterRequest.ProtoReflect.Descriptor instead.
+func (*CreateCharacterRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateCharacterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCharacterRequest) GetSpriteRegionX() int32 {
+	if x != nil {
+		return x.SpriteRegionX
+	}
+	return 0
+}
+
+func (x *CreateCharacterRequest) GetSpriteRegionY() int32 {
+	if x != nil {
+		return x.SpriteRegionY
+	}
+	return 0
+}
+
+func (x *CreateCharacterRequest) GetAppearance() *Appearance {
+	if x != nil {
+		return x.Appearance
 	}
 	return nil
 }
 
-func (x *Packet) GetSellResponse() *SellResponse {
+type CreateCharacterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCharacterResponse) Reset() {
+	*x = CreateCharacterResponse{}
+	mi := &file_messages_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCharacterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCharacterResponse) ProtoMessage() {}
+
+func (x *CreateCharacterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[65]
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SellResponse); ok {
-			return x.SellResponse
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCharacterResponse.ProtoReflect.Descriptor instead.
+func (*CreateCharacterResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateCharacterResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
 	}
 	return nil
 }
 
-func (x *Packet) GetLevelMetadata() *LevelMetadata {
+type DeleteCharacterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   int32                  `protobuf:"varint,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCharacterRequest) Reset() {
+	*x = DeleteCharacterRequest{}
+	mi := &file_messages_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCharacterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCharacterRequest) ProtoMessage() {}
+
+func (x *DeleteCharacterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[66]
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_LevelMetadata); ok {
-			return x.LevelMetadata
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCharacterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCharacterRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteCharacterRequest) GetCharacterId() int32 {
+	if x != nil {
+		return x.CharacterId
+	}
+	return 0
+}
+
+type DeleteCharacterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCharacterResponse) Reset() {
+	*x = DeleteCharacterResponse{}
+	mi := &file_messages_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCharacterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCharacterResponse) ProtoMessage() {}
+
+func (x *DeleteCharacterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCharacterResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCharacterResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteCharacterResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type RenameCharacterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   int32                  `protobuf:"varint,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCharacterRequest) Reset() {
+	*x = RenameCharacterRequest{}
+	mi := &file_messages_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCharacterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCharacterRequest) ProtoMessage() {}
+
+func (x *RenameCharacterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCharacterRequest.ProtoReflect.Descriptor instead.
+func (*RenameCharacterRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RenameCharacterRequest) GetCharacterId() int32 {
+	if x != nil {
+		return x.CharacterId
+	}
+	return 0
+}
+
+func (x *RenameCharacterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameCharacterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCharacterResponse) Reset() {
+	*x = RenameCharacterResponse{}
+	mi := &file_messages_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCharacterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCharacterResponse) ProtoMessage() {}
+
+func (x *RenameCharacterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCharacterResponse.ProtoReflect.Descriptor instead.
+func (*RenameCharacterResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RenameCharacterResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// Enters the game as the given character. If successful, the level snapshot follows, just like after logging in used to.
+type SelectCharacterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   int32                  `protobuf:"varint,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectCharacterRequest) Reset() {
+	*x = SelectCharacterRequest{}
+	mi := &file_messages_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectCharacterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectCharacterRequest) ProtoMessage() {}
+
+func (x *SelectCharacterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectCharacterRequest.ProtoReflect.Descriptor instead.
+func (*SelectCharacterRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SelectCharacterRequest) GetCharacterId() int32 {
+	if x != nil {
+		return x.CharacterId
+	}
+	return 0
+}
+
+type SelectCharacterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectCharacterResponse) Reset() {
+	*x = SelectCharacterResponse{}
+	mi := &file_messages_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectCharacterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectCharacterResponse) ProtoMessage() {}
+
+func (x *SelectCharacterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectCharacterResponse.ProtoReflect.Descriptor instead.
+func (*SelectCharacterResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SelectCharacterResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// Changes how your character looks. Only allowed while standing next to a wardrobe NPC, given by their actor ID.
+type ChangeAppearanceRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WardrobeActorId uint32                 `protobuf:"varint,1,opt,name=wardrobe_actor_id,json=wardrobeActorId,proto3" json:"wardrobe_actor_id,omitempty"`
+	Appearance      *Appearance            `protobuf:"bytes,2,opt,name=appearance,proto3" json:"appearance,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangeAppearanceRequest) Reset() {
+	*x = ChangeAppearanceRequest{}
+	mi := &file_messages_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeAppearanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAppearanceRequest) ProtoMessage() {}
+
+func (x *ChangeAppearanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAppearanceRequest.ProtoReflect.Descriptor instead.
+func (*ChangeAppearanceRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ChangeAppearanceRequest) GetWardrobeActorId() uint32 {
+	if x != nil {
+		return x.WardrobeActorId
+	}
+	return 0
+}
+
+func (x *ChangeAppearanceRequest) GetAppearance() *Appearance {
+	if x != nil {
+		return x.Appearance
+	}
+	return nil
+}
+
+type ChangeAppearanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeAppearanceResponse) Reset() {
+	*x = ChangeAppearanceResponse{}
+	mi := &file_messages_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeAppearanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAppearanceResponse) ProtoMessage() {}
+
+func (x *ChangeAppearanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAppearanceResponse.ProtoReflect.Descriptor instead.
+func (*ChangeAppearanceResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ChangeAppearanceResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// Sent by an admin to disconnect a player who's in the game
+type AdminKick struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminKick) Reset() {
+	*x = AdminKick{}
+	mi := &file_messages_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminKick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminKick) ProtoMessage() {}
+
+func (x *AdminKick) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminKick.ProtoReflect.Descriptor instead.
+func (*AdminKick) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AdminKick) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminKick) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Sent by an admin to stop a user logging in, kicking them if they're in the game. A duration of 0 means forever.
+type AdminBan struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Username        string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Reason          string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	DurationMinutes uint32                 `protobuf:"varint,3,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AdminBan) Reset() {
+	*x = AdminBan{}
+	mi := &file_messages_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminBan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBan) ProtoMessage() {}
+
+func (x *AdminBan) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBan.ProtoReflect.Descriptor instead.
+func (*AdminBan) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AdminBan) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminBan) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminBan) GetDurationMinutes() uint32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+type AdminUnban struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUnban) Reset() {
+	*x = AdminUnban{}
+	mi := &file_messages_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUnban) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUnban) ProtoMessage() {}
+
+func (x *AdminUnban) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUnban.ProtoReflect.Descriptor instead.
+func (*AdminUnban) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AdminUnban) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// Sent by an admin to stop a user chatting or yelling. A duration of 0 means forever.
+type AdminMute struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Username        string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Reason          string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	DurationMinutes uint32                 `protobuf:"varint,3,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AdminMute) Reset() {
+	*x = AdminMute{}
+	mi := &file_messages_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminMute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminMute) ProtoMessage() {}
+
+func (x *AdminMute) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminMute.ProtoReflect.Descriptor instead.
+func (*AdminMute) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AdminMute) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminMute) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminMute) GetDurationMinutes() uint32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+type AdminUnmute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUnmute) Reset() {
+	*x = AdminUnmute{}
+	mi := &file_messages_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUnmute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUnmute) ProtoMessage() {}
+
+func (x *AdminUnmute) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUnmute.ProtoReflect.Descriptor instead.
+func (*AdminUnmute) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AdminUnmute) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// The answer to any of the admin moderation packets above
+type AdminModerationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // "kick", "ban", "unban", "mute" or "unmute"
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminModerationResponse) Reset() {
+	*x = AdminModerationResponse{}
+	mi := &file_messages_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminModerationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminModerationResponse) ProtoMessage() {}
+
+func (x *AdminModerationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminModerationResponse.ProtoReflect.Descriptor instead.
+func (*AdminModerationResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AdminModerationResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *AdminModerationResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AdminModerationResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// Tells a player an admin has done something to their account, e.g. muted them. Expires at is a Unix timestamp in
+// seconds, or 0 if it never expires or doesn't apply.
+type ModerationNotice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerationNotice) Reset() {
+	*x = ModerationNotice{}
+	mi := &file_messages_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationNotice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationNotice) ProtoMessage() {}
+
+func (x *ModerationNotice) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationNotice.ProtoReflect.Descriptor instead.
+func (*ModerationNotice) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ModerationNotice) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ModerationNotice) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ModerationNotice) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// Role names are from internal/roles on the server, e.g. "moderator" or "superadmin"
+type AdminGrantRole struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGrantRole) Reset() {
+	*x = AdminGrantRole{}
+	mi := &file_messages_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGrantRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGrantRole) ProtoMessage() {}
+
+func (x *AdminGrantRole) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGrantRole.ProtoReflect.Descriptor instead.
+func (*AdminGrantRole) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AdminGrantRole) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminGrantRole) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AdminRevokeRole struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminRevokeRole) Reset() {
+	*x = AdminRevokeRole{}
+	mi := &file_messages_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRevokeRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRevokeRole) ProtoMessage() {}
+
+func (x *AdminRevokeRole) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRevokeRole.ProtoReflect.Descriptor instead.
+func (*AdminRevokeRole) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AdminRevokeRole) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminRevokeRole) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AdminRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Roles         []string               `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"` // Everything the user has now, if it worked
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminRoleResponse) Reset() {
+	*x = AdminRoleResponse{}
+	mi := &file_messages_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRoleResponse) ProtoMessage() {}
+
+func (x *AdminRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRoleResponse.ProtoReflect.Descriptor instead.
+func (*AdminRoleResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AdminRoleResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *AdminRoleResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminRoleResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AdminRoleResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// Looks up an account by its username or the name of one of its characters
+type AdminLookupPlayer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminLookupPlayer) Reset() {
+	*x = AdminLookupPlayer{}
+	mi := &file_messages_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminLookupPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLookupPlayer) ProtoMessage() {}
+
+func (x *AdminLookupPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLookupPlayer.ProtoReflect.Descriptor instead.
+func (*AdminLookupPlayer) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AdminLookupPlayer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AdminPlayerInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Roles         []string               `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	Characters    []*CharacterInfo       `protobuf:"bytes,4,rep,name=characters,proto3" json:"characters,omitempty"`
+	OnlineAs      string                 `protobuf:"bytes,5,opt,name=online_as,json=onlineAs,proto3" json:"online_as,omitempty"` // The character they're playing right now, or empty if they're offline
+	Ban           string                 `protobuf:"bytes,6,opt,name=ban,proto3" json:"ban,omitempty"`                           // How long they're banned for and why, or empty if they aren't
+	Mute          string                 `protobuf:"bytes,7,opt,name=mute,proto3" json:"mute,omitempty"`                         // Likewise for a mute
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminPlayerInfo) Reset() {
+	*x = AdminPlayerInfo{}
+	mi := &file_messages_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminPlayerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminPlayerInfo) ProtoMessage() {}
+
+func (x *AdminPlayerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminPlayerInfo.ProtoReflect.Descriptor instead.
+func (*AdminPlayerInfo) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AdminPlayerInfo) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *AdminPlayerInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminPlayerInfo) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *AdminPlayerInfo) GetCharacters() []*CharacterInfo {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *AdminPlayerInfo) GetOnlineAs() string {
+	if x != nil {
+		return x.OnlineAs
+	}
+	return ""
+}
+
+func (x *AdminPlayerInfo) GetBan() string {
+	if x != nil {
+		return x.Ban
+	}
+	return ""
+}
+
+func (x *AdminPlayerInfo) GetMute() string {
+	if x != nil {
+		return x.Mute
+	}
+	return ""
+}
+
+// A positive quantity gives the character that many of the item, a negative one takes them away
+type AdminEditInventory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterName string                 `protobuf:"bytes,1,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
+	ItemName      string                 `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminEditInventory) Reset() {
+	*x = AdminEditInventory{}
+	mi := &file_messages_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminEditInventory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminEditInventory) ProtoMessage() {}
+
+func (x *AdminEditInventory) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminEditInventory.ProtoReflect.Descriptor instead.
+func (*AdminEditInventory) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *AdminEditInventory) GetCharacterName() string {
+	if x != nil {
+		return x.CharacterName
+	}
+	return ""
+}
+
+func (x *AdminEditInventory) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *AdminEditInventory) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type AdminGrantXp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterName string                 `protobuf:"bytes,1,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
+	Skill         uint32                 `protobuf:"varint,2,opt,name=skill,proto3" json:"skill,omitempty"`
+	Xp            uint32                 `protobuf:"varint,3,opt,name=xp,proto3" json:"xp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGrantXp) Reset() {
+	*x = AdminGrantXp{}
+	mi := &file_messages_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGrantXp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGrantXp) ProtoMessage() {}
+
+func (x *AdminGrantXp) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGrantXp.ProtoReflect.Descriptor instead.
+func (*AdminGrantXp) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *AdminGrantXp) GetCharacterName() string {
+	if x != nil {
+		return x.CharacterName
+	}
+	return ""
+}
+
+func (x *AdminGrantXp) GetSkill() uint32 {
+	if x != nil {
+		return x.Skill
+	}
+	return 0
+}
+
+func (x *AdminGrantXp) GetXp() uint32 {
+	if x != nil {
+		return x.Xp
+	}
+	return 0
+}
+
+type AdminTeleport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterName string                 `protobuf:"bytes,1,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
+	LevelId       int32                  `protobuf:"varint,2,opt,name=level_id,json=levelId,proto3" json:"level_id,omitempty"`
+	X             int32                  `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminTeleport) Reset() {
+	*x = AdminTeleport{}
+	mi := &file_messages_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminTeleport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminTeleport) ProtoMessage() {}
+
+func (x *AdminTeleport) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminTeleport.ProtoReflect.Descriptor instead.
+func (*AdminTeleport) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *AdminTeleport) GetCharacterName() string {
+	if x != nil {
+		return x.CharacterName
+	}
+	return ""
+}
+
+func (x *AdminTeleport) GetLevelId() int32 {
+	if x != nil {
+		return x.LevelId
+	}
+	return 0
+}
+
+func (x *AdminTeleport) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *AdminTeleport) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// The outcome of an admin operation on a character, e.g. "edit_inventory", "grant_xp" or "teleport"
+type AdminOpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Op            string                 `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	CharacterName string                 `protobuf:"bytes,3,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminOpResponse) Reset() {
+	*x = AdminOpResponse{}
+	mi := &file_messages_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminOpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminOpResponse) ProtoMessage() {}
+
+func (x *AdminOpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminOpResponse.ProtoReflect.Descriptor instead.
+func (*AdminOpResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *AdminOpResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *AdminOpResponse) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *AdminOpResponse) GetCharacterName() string {
+	if x != nil {
+		return x.CharacterName
+	}
+	return ""
+}
+
+type AdminListOnlinePlayers struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListOnlinePlayers) Reset() {
+	*x = AdminListOnlinePlayers{}
+	mi := &file_messages_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListOnlinePlayers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListOnlinePlayers) ProtoMessage() {}
+
+func (x *AdminListOnlinePlayers) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListOnlinePlayers.ProtoReflect.Descriptor instead.
+func (*AdminListOnlinePlayers) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{90}
+}
+
+type OnlinePlayer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LevelId       int32                  `protobuf:"varint,2,opt,name=level_id,json=levelId,proto3" json:"level_id,omitempty"`
+	X             int32                  `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnlinePlayer) Reset() {
+	*x = OnlinePlayer{}
+	mi := &file_messages_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnlinePlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnlinePlayer) ProtoMessage() {}
+
+func (x *OnlinePlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnlinePlayer.ProtoReflect.Descriptor instead.
+func (*OnlinePlayer) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *OnlinePlayer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OnlinePlayer) GetLevelId() int32 {
+	if x != nil {
+		return x.LevelId
+	}
+	return 0
+}
+
+func (x *OnlinePlayer) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *OnlinePlayer) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type AdminOnlinePlayers struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Players       []*OnlinePlayer        `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminOnlinePlayers) Reset() {
+	*x = AdminOnlinePlayers{}
+	mi := &file_messages_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminOnlinePlayers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminOnlinePlayers) ProtoMessage() {}
+
+func (x *AdminOnlinePlayers) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminOnlinePlayers.ProtoReflect.Descriptor instead.
+func (*AdminOnlinePlayers) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *AdminOnlinePlayers) GetPlayers() []*OnlinePlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type AdminListLevels struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListLevels) Reset() {
+	*x = AdminListLevels{}
+	mi := &file_messages_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListLevels) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListLevels) ProtoMessage() {}
+
+func (x *AdminListLevels) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListLevels.ProtoReflect.Descriptor instead.
+func (*AdminListLevels) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{93}
+}
+
+type LevelInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GdResPath     string                 `protobuf:"bytes,2,opt,name=gd_res_path,json=gdResPath,proto3" json:"gd_res_path,omitempty"`
+	Players       uint32                 `protobuf:"varint,3,opt,name=players,proto3" json:"players,omitempty"` // How many are in the level right now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LevelInfo) Reset() {
+	*x = LevelInfo{}
+	mi := &file_messages_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LevelInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LevelInfo) ProtoMessage() {}
+
+func (x *LevelInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LevelInfo.ProtoReflect.Descriptor instead.
+func (*LevelInfo) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *LevelInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LevelInfo) GetGdResPath() string {
+	if x != nil {
+		return x.GdResPath
+	}
+	return ""
+}
+
+func (x *LevelInfo) GetPlayers() uint32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+type AdminLevelList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Levels        []*LevelInfo           `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminLevelList) Reset() {
+	*x = AdminLevelList{}
+	mi := &file_messages_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminLevelList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLevelList) ProtoMessage() {}
+
+func (x *AdminLevelList) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLevelList.ProtoReflect.Descriptor instead.
+func (*AdminLevelList) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *AdminLevelList) GetLevels() []*LevelInfo {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+// Describes a chat command the player may use, so the client can offer help and autocompletion
+type CommandArg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // "text", "int", "player", "item", "skill", "destination" or "message" (the rest of the line)
+	Optional      bool                   `protobuf:"varint,3,opt,name=optional,proto3" json:"optional,omitempty"`
+	Options       []string               `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"` // The values it can take, if there's a fixed set of them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandArg) Reset() {
+	*x = CommandArg{}
+	mi := &file_messages_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandArg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandArg) ProtoMessage() {}
+
+func (x *CommandArg) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandArg.ProtoReflect.Descriptor instead.
+func (*CommandArg) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CommandArg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CommandArg) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CommandArg) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+func (x *CommandArg) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type CommandInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Aliases       []string               `protobuf:"bytes,2,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Usage         string                 `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	Args          []*CommandArg          `protobuf:"bytes,5,rep,name=args,proto3" json:"args,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandInfo) Reset() {
+	*x = CommandInfo{}
+	mi := &file_messages_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandInfo) ProtoMessage() {}
+
+func (x *CommandInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandInfo.ProtoReflect.Descriptor instead.
+func (*CommandInfo) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CommandInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CommandInfo) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *CommandInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CommandInfo) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *CommandInfo) GetArgs() []*CommandArg {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type CommandList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Commands      []*CommandInfo         `protobuf:"bytes,1,rep,name=commands,proto3" json:"commands,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandList) Reset() {
+	*x = CommandList{}
+	mi := &file_messages_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandList) ProtoMessage() {}
+
+func (x *CommandList) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandList.ProtoReflect.Descriptor instead.
+func (*CommandList) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *CommandList) GetCommands() []*CommandInfo {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+// A private message. The client fills in who it's to, and the server fills in who it's from when it's delivered.
+type Whisper struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	To    string                 `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	From  string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Msg   string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Which level the sender was in, so the recipient knows whether it needs logging in their level too. Only used
+	// while the server passes the whisper on, and left as 0 when it's sent to a client.
+	FromLevelId   int32 `protobuf:"varint,4,opt,name=from_level_id,json=fromLevelId,proto3" json:"from_level_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Whisper) Reset() {
+	*x = Whisper{}
+	mi := &file_messages_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Whisper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Whisper) ProtoMessage() {}
+
+func (x *Whisper) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Whisper.ProtoReflect.Descriptor instead.
+func (*Whisper) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Whisper) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Whisper) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Whisper) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *Whisper) GetFromLevelId() int32 {
+	if x != nil {
+		return x.FromLevelId
+	}
+	return 0
+}
+
+// Sent by the client to ask a character to be friends, and by the server to tell it someone has asked
+type FriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendRequest) Reset() {
+	*x = FriendRequest{}
+	mi := &file_messages_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendRequest) ProtoMessage() {}
+
+func (x *FriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendRequest.ProtoReflect.Descriptor instead.
+func (*FriendRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *FriendRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Likewise for accepting a friend request
+type AcceptFriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptFriendRequest) Reset() {
+	*x = AcceptFriendRequest{}
+	mi := &file_messages_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptFriendRequest) ProtoMessage() {}
+
+func (x *AcceptFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptFriendRequest.ProtoReflect.Descriptor instead.
+func (*AcceptFriendRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *AcceptFriendRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Likewise for no longer being friends, or declining or withdrawing a request
+type RemoveFriend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFriend) Reset() {
+	*x = RemoveFriend{}
+	mi := &file_messages_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFriend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriend) ProtoMessage() {}
+
+func (x *RemoveFriend) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriend.ProtoReflect.Descriptor instead.
+func (*RemoveFriend) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *RemoveFriend) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Friend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Online        bool                   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	Pending       bool                   `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`   // Whether the request hasn't been accepted yet
+	Incoming      bool                   `protobuf:"varint,4,opt,name=incoming,proto3" json:"incoming,omitempty"` // Whether the request was to us, so we're the ones who can accept it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Friend) Reset() {
+	*x = Friend{}
+	mi := &file_messages_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Friend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Friend) ProtoMessage() {}
+
+func (x *Friend) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Friend.ProtoReflect.Descriptor instead.
+func (*Friend) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *Friend) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Friend) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *Friend) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *Friend) GetIncoming() bool {
+	if x != nil {
+		return x.Incoming
+	}
+	return false
+}
+
+type FriendList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Friends       []*Friend              `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendList) Reset() {
+	*x = FriendList{}
+	mi := &file_messages_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendList) ProtoMessage() {}
+
+func (x *FriendList) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendList.ProtoReflect.Descriptor instead.
+func (*FriendList) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *FriendList) GetFriends() []*Friend {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+// Tells the client a friend has entered or left the game
+type FriendPresence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Online        bool                   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendPresence) Reset() {
+	*x = FriendPresence{}
+	mi := &file_messages_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendPresence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendPresence) ProtoMessage() {}
+
+func (x *FriendPresence) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendPresence.ProtoReflect.Descriptor instead.
+func (*FriendPresence) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *FriendPresence) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FriendPresence) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+type IgnorePlayer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IgnorePlayer) Reset() {
+	*x = IgnorePlayer{}
+	mi := &file_messages_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IgnorePlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IgnorePlayer) ProtoMessage() {}
+
+func (x *IgnorePlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IgnorePlayer.ProtoReflect.Descriptor instead.
+func (*IgnorePlayer) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *IgnorePlayer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UnignorePlayer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnignorePlayer) Reset() {
+	*x = UnignorePlayer{}
+	mi := &file_messages_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnignorePlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnignorePlayer) ProtoMessage() {}
+
+func (x *UnignorePlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnignorePlayer.ProtoReflect.Descriptor instead.
+func (*UnignorePlayer) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *UnignorePlayer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type IgnoreList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IgnoreList) Reset() {
+	*x = IgnoreList{}
+	mi := &file_messages_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IgnoreList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IgnoreList) ProtoMessage() {}
+
+func (x *IgnoreList) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IgnoreList.ProtoReflect.Descriptor instead.
+func (*IgnoreList) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *IgnoreList) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+// The outcome of a friend or ignore request, e.g. with action "friend_request" or "ignore"
+type SocialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SocialResponse) Reset() {
+	*x = SocialResponse{}
+	mi := &file_messages_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SocialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SocialResponse) ProtoMessage() {}
+
+func (x *SocialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SocialResponse.ProtoReflect.Descriptor instead.
+func (*SocialResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *SocialResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *SocialResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SocialResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Sent by the client to join a chat channel, which is made for it if no channel has that name yet
+type JoinChannel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinChannel) Reset() {
+	*x = JoinChannel{}
+	mi := &file_messages_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinChannel) ProtoMessage() {}
+
+func (x *JoinChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinChannel.ProtoReflect.Descriptor instead.
+func (*JoinChannel) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *JoinChannel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type LeaveChannel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveChannel) Reset() {
+	*x = LeaveChannel{}
+	mi := &file_messages_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveChannel) ProtoMessage() {}
+
+func (x *LeaveChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveChannel.ProtoReflect.Descriptor instead.
+func (*LeaveChannel) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *LeaveChannel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// A message in a chat channel. The client fills in the channel and message, and the server fills in the rest when
+// it's delivered.
+type ChannelMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	SenderName    string                 `protobuf:"bytes,2,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	SentAt        int64                  `protobuf:"varint,4,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"` // Unix seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelMessage) Reset() {
+	*x = ChannelMessage{}
+	mi := &file_messages_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelMessage) ProtoMessage() {}
+
+func (x *ChannelMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelMessage.ProtoReflect.Descriptor instead.
+func (*ChannelMessage) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ChannelMessage) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ChannelMessage) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *ChannelMessage) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ChannelMessage) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
+}
+
+// Tells the client it's in a channel, who else is, and what was said there recently
+type ChannelJoined struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // "system", "custom", "party" or "guild"
+	Members       []string               `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	History       []*ChannelMessage      `protobuf:"bytes,4,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelJoined) Reset() {
+	*x = ChannelJoined{}
+	mi := &file_messages_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelJoined) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelJoined) ProtoMessage() {}
+
+func (x *ChannelJoined) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelJoined.ProtoReflect.Descriptor instead.
+func (*ChannelJoined) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ChannelJoined) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChannelJoined) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ChannelJoined) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ChannelJoined) GetHistory() []*ChannelMessage {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// Tells the client someone has joined or left a channel it's in. If it's the client's own name leaving, it was removed.
+type ChannelMemberUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Joined        bool                   `protobuf:"varint,3,opt,name=joined,proto3" json:"joined,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelMemberUpdate) Reset() {
+	*x = ChannelMemberUpdate{}
+	mi := &file_messages_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelMemberUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelMemberUpdate) ProtoMessage() {}
+
+func (x *ChannelMemberUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelMemberUpdate.ProtoReflect.Descriptor instead.
+func (*ChannelMemberUpdate) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ChannelMemberUpdate) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ChannelMemberUpdate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChannelMemberUpdate) GetJoined() bool {
+	if x != nil {
+		return x.Joined
+	}
+	return false
+}
+
+// Sent by a channel's owner or a moderator, with action "mute", "unmute", "kick", "ban" or "unban"
+type ChannelModerate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelModerate) Reset() {
+	*x = ChannelModerate{}
+	mi := &file_messages_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelModerate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelModerate) ProtoMessage() {}
+
+func (x *ChannelModerate) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelModerate.ProtoReflect.Descriptor instead.
+func (*ChannelModerate) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ChannelModerate) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ChannelModerate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChannelModerate) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+// The outcome of a channel request, e.g. with action "join" or "ban"
+type ChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelResponse) Reset() {
+	*x = ChannelResponse{}
+	mi := &file_messages_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelResponse) ProtoMessage() {}
+
+func (x *ChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelResponse.ProtoReflect.Descriptor instead.
+func (*ChannelResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ChannelResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ChannelResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ChannelResponse) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type ListChannels struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannels) Reset() {
+	*x = ListChannels{}
+	mi := &file_messages_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannels) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannels) ProtoMessage() {}
+
+func (x *ListChannels) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannels.ProtoReflect.Descriptor instead.
+func (*ListChannels) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{117}
+}
+
+type ChannelInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Members       uint32                 `protobuf:"varint,3,opt,name=members,proto3" json:"members,omitempty"`
+	Joined        bool                   `protobuf:"varint,4,opt,name=joined,proto3" json:"joined,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelInfo) Reset() {
+	*x = ChannelInfo{}
+	mi := &file_messages_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelInfo) ProtoMessage() {}
+
+func (x *ChannelInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelInfo.ProtoReflect.Descriptor instead.
+func (*ChannelInfo) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ChannelInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChannelInfo) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ChannelInfo) GetMembers() uint32 {
+	if x != nil {
+		return x.Members
+	}
+	return 0
+}
+
+func (x *ChannelInfo) GetJoined() bool {
+	if x != nil {
+		return x.Joined
+	}
+	return false
+}
+
+type ChannelList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []*ChannelInfo         `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelList) Reset() {
+	*x = ChannelList{}
+	mi := &file_messages_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelList) ProtoMessage() {}
+
+func (x *ChannelList) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelList.ProtoReflect.Descriptor instead.
+func (*ChannelList) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ChannelList) GetChannels() []*ChannelInfo {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+// Sent by the client to invite a player to its party, or start one with them, and by the server to tell the client
+// who has invited it
+type PartyInvite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyInvite) Reset() {
+	*x = PartyInvite{}
+	mi := &file_messages_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyInvite) ProtoMessage() {}
+
+func (x *PartyInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyInvite.ProtoReflect.Descriptor instead.
+func (*PartyInvite) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *PartyInvite) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Accepts an invitation from the named player
+type PartyAccept struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyAccept) Reset() {
+	*x = PartyAccept{}
+	mi := &file_messages_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyAccept) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyAccept) ProtoMessage() {}
+
+func (x *PartyAccept) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyAccept.ProtoReflect.Descriptor instead.
+func (*PartyAccept) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *PartyAccept) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PartyLeave struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyLeave) Reset() {
+	*x = PartyLeave{}
+	mi := &file_messages_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyLeave) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyLeave) ProtoMessage() {}
+
+func (x *PartyLeave) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyLeave.ProtoReflect.Descriptor instead.
+func (*PartyLeave) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{122}
+}
+
+// Sent by the party leader to take someone out of the party
+type PartyKick struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyKick) Reset() {
+	*x = PartyKick{}
+	mi := &file_messages_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyKick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyKick) ProtoMessage() {}
+
+func (x *PartyKick) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyKick.ProtoReflect.Descriptor instead.
+func (*PartyKick) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *PartyKick) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PartyMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Leader        bool                   `protobuf:"varint,2,opt,name=leader,proto3" json:"leader,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyMember) Reset() {
+	*x = PartyMember{}
+	mi := &file_messages_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyMember) ProtoMessage() {}
+
+func (x *PartyMember) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyMember.ProtoReflect.Descriptor instead.
+func (*PartyMember) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *PartyMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PartyMember) GetLeader() bool {
+	if x != nil {
+		return x.Leader
+	}
+	return false
+}
+
+// Who's in the client's party, sent whenever that changes. No members means the client isn't in a party any more.
+// Party chat goes through the party's channel.
+type Party struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*PartyMember         `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Party) Reset() {
+	*x = Party{}
+	mi := &file_messages_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Party) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Party) ProtoMessage() {}
+
+func (x *Party) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Party.ProtoReflect.Descriptor instead.
+func (*Party) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *Party) GetMembers() []*PartyMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Party) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+// Where a party member is, sent when they enter a level and every few seconds while they move around
+type PartyMemberPosition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LevelId       int32                  `protobuf:"varint,2,opt,name=level_id,json=levelId,proto3" json:"level_id,omitempty"`
+	X             int32                  `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyMemberPosition) Reset() {
+	*x = PartyMemberPosition{}
+	mi := &file_messages_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyMemberPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyMemberPosition) ProtoMessage() {}
+
+func (x *PartyMemberPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyMemberPosition.ProtoReflect.Descriptor instead.
+func (*PartyMemberPosition) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *PartyMemberPosition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PartyMemberPosition) GetLevelId() int32 {
+	if x != nil {
+		return x.LevelId
+	}
+	return 0
+}
+
+func (x *PartyMemberPosition) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PartyMemberPosition) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// Tells the client a party member has completed a quest nearby, so it's been completed for the client too
+type PartyQuestCredit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Quest         *QuestInfo             `protobuf:"bytes,2,opt,name=quest,proto3" json:"quest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyQuestCredit) Reset() {
+	*x = PartyQuestCredit{}
+	mi := &file_messages_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyQuestCredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyQuestCredit) ProtoMessage() {}
+
+func (x *PartyQuestCredit) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyQuestCredit.ProtoReflect.Descriptor instead.
+func (*PartyQuestCredit) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *PartyQuestCredit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PartyQuestCredit) GetQuest() *QuestInfo {
+	if x != nil {
+		return x.Quest
+	}
+	return nil
+}
+
+// The outcome of a party request, e.g. with action "invite" or "kick"
+type PartyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyResponse) Reset() {
+	*x = PartyResponse{}
+	mi := &file_messages_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyResponse) ProtoMessage() {}
+
+func (x *PartyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyResponse.ProtoReflect.Descriptor instead.
+func (*PartyResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *PartyResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *PartyResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PartyResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Starts a guild with the client as its leader, which costs gold bars
+type CreateGuild struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuild) Reset() {
+	*x = CreateGuild{}
+	mi := &file_messages_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuild) ProtoMessage() {}
+
+func (x *CreateGuild) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuild.ProtoReflect.Descriptor instead.
+func (*CreateGuild) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *CreateGuild) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGuild) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// Sent by the client to invite a character to its guild, and by the server to tell the client who has invited it to
+// which guild. Invitations are saved, so characters can be invited while they're offline.
+type GuildInvite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	GuildName     string                 `protobuf:"bytes,2,opt,name=guild_name,json=guildName,proto3" json:"guild_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildInvite) Reset() {
+	*x = GuildInvite{}
+	mi := &file_messages_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildInvite) ProtoMessage() {}
+
+func (x *GuildInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildInvite.ProtoReflect.Descriptor instead.
+func (*GuildInvite) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GuildInvite) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildInvite) GetGuildName() string {
+	if x != nil {
+		return x.GuildName
+	}
+	return ""
+}
+
+type AcceptGuildInvite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildName     string                 `protobuf:"bytes,1,opt,name=guild_name,json=guildName,proto3" json:"guild_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptGuildInvite) Reset() {
+	*x = AcceptGuildInvite{}
+	mi := &file_messages_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptGuildInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptGuildInvite) ProtoMessage() {}
+
+func (x *AcceptGuildInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptGuildInvite.ProtoReflect.Descriptor instead.
+func (*AcceptGuildInvite) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *AcceptGuildInvite) GetGuildName() string {
+	if x != nil {
+		return x.GuildName
+	}
+	return ""
+}
+
+type LeaveGuild struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveGuild) Reset() {
+	*x = LeaveGuild{}
+	mi := &file_messages_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveGuild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGuild) ProtoMessage() {}
+
+func (x *LeaveGuild) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGuild.ProtoReflect.Descriptor instead.
+func (*LeaveGuild) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{132}
+}
+
+type GuildKick struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildKick) Reset() {
+	*x = GuildKick{}
+	mi := &file_messages_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildKick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildKick) ProtoMessage() {}
+
+func (x *GuildKick) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildKick.ProtoReflect.Descriptor instead.
+func (*GuildKick) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GuildKick) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Sent by the guild leader to change a member's rank. Making someone else the leader hands leadership over to them.
+type GuildSetRank struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rank          string                 `protobuf:"bytes,2,opt,name=rank,proto3" json:"rank,omitempty"` // "recruit", "member", "officer" or "leader"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildSetRank) Reset() {
+	*x = GuildSetRank{}
+	mi := &file_messages_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildSetRank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildSetRank) ProtoMessage() {}
+
+func (x *GuildSetRank) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildSetRank.ProtoReflect.Descriptor instead.
+func (*GuildSetRank) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *GuildSetRank) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildSetRank) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+type GuildMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rank          string                 `protobuf:"bytes,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Online        bool                   `protobuf:"varint,3,opt,name=online,proto3" json:"online,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildMember) Reset() {
+	*x = GuildMember{}
+	mi := &file_messages_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildMember) ProtoMessage() {}
+
+func (x *GuildMember) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildMember.ProtoReflect.Descriptor instead.
+func (*GuildMember) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *GuildMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildMember) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *GuildMember) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+// The client's guild and everyone in it, sent whenever that changes. No name means the client isn't in a guild, in
+// which case invites lists the guilds it's been invited to. Guild chat goes through the guild's channel.
+type GuildInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Rank          string                 `protobuf:"bytes,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Members       []*GuildMember         `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	Channel       string                 `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"`
+	Invites       []string               `protobuf:"bytes,6,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildInfo) Reset() {
+	*x = GuildInfo{}
+	mi := &file_messages_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildInfo) ProtoMessage() {}
+
+func (x *GuildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildInfo.ProtoReflect.Descriptor instead.
+func (*GuildInfo) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *GuildInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildInfo) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GuildInfo) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *GuildInfo) GetMembers() []*GuildMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *GuildInfo) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *GuildInfo) GetInvites() []string {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type GuildBankDeposit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildBankDeposit) Reset() {
+	*x = GuildBankDeposit{}
+	mi := &file_messages_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildBankDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildBankDeposit) ProtoMessage() {}
+
+func (x *GuildBankDeposit) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildBankDeposit.ProtoReflect.Descriptor instead.
+func (*GuildBankDeposit) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GuildBankDeposit) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *GuildBankDeposit) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type GuildBankWithdraw struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildBankWithdraw) Reset() {
+	*x = GuildBankWithdraw{}
+	mi := &file_messages_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildBankWithdraw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildBankWithdraw) ProtoMessage() {}
+
+func (x *GuildBankWithdraw) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildBankWithdraw.ProtoReflect.Descriptor instead.
+func (*GuildBankWithdraw) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GuildBankWithdraw) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *GuildBankWithdraw) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type RequestGuildBank struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestGuildBank) Reset() {
+	*x = RequestGuildBank{}
+	mi := &file_messages_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestGuildBank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestGuildBank) ProtoMessage() {}
+
+func (x *RequestGuildBank) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestGuildBank.ProtoReflect.Descriptor instead.
+func (*RequestGuildBank) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{139}
+}
+
+type GuildBank struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ItemsQuantities []*ItemQuantity        `protobuf:"bytes,1,rep,name=items_quantities,json=itemsQuantities,proto3" json:"items_quantities,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GuildBank) Reset() {
+	*x = GuildBank{}
+	mi := &file_messages_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildBank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildBank) ProtoMessage() {}
+
+func (x *GuildBank) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildBank.ProtoReflect.Descriptor instead.
+func (*GuildBank) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GuildBank) GetItemsQuantities() []*ItemQuantity {
+	if x != nil {
+		return x.ItemsQuantities
+	}
+	return nil
+}
+
+type RequestGuildBankLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestGuildBankLog) Reset() {
+	*x = RequestGuildBankLog{}
+	mi := &file_messages_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestGuildBankLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestGuildBankLog) ProtoMessage() {}
+
+func (x *RequestGuildBankLog) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestGuildBankLog.ProtoReflect.Descriptor instead.
+func (*RequestGuildBankLog) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{141}
+}
+
+type GuildBankLogEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Item          *Item                  `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                    // Positive for deposits, negative for withdrawals
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildBankLogEntry) Reset() {
+	*x = GuildBankLogEntry{}
+	mi := &file_messages_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildBankLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildBankLogEntry) ProtoMessage() {}
+
+func (x *GuildBankLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildBankLogEntry.ProtoReflect.Descriptor instead.
+func (*GuildBankLogEntry) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *GuildBankLogEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildBankLogEntry) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *GuildBankLogEntry) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *GuildBankLogEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// The guild bank's most recent deposits and withdrawals, newest first
+type GuildBankLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*GuildBankLogEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildBankLog) Reset() {
+	*x = GuildBankLog{}
+	mi := &file_messages_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildBankLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildBankLog) ProtoMessage() {}
+
+func (x *GuildBankLog) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildBankLog.ProtoReflect.Descriptor instead.
+func (*GuildBankLog) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GuildBankLog) GetEntries() []*GuildBankLogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// The outcome of a guild request, e.g. with action "create" or "withdraw"
+type GuildResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildResponse) Reset() {
+	*x = GuildResponse{}
+	mi := &file_messages_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildResponse) ProtoMessage() {}
+
+func (x *GuildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildResponse.ProtoReflect.Descriptor instead.
+func (*GuildResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *GuildResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GuildResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *GuildResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Sent by a player to report another for breaking the rules. The server attaches what was recently said around them.
+type ReportPlayer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPlayer) Reset() {
+	*x = ReportPlayer{}
+	mi := &file_messages_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPlayer) ProtoMessage() {}
+
+func (x *ReportPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPlayer.ProtoReflect.Descriptor instead.
+func (*ReportPlayer) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ReportPlayer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReportPlayer) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportResponse) Reset() {
+	*x = ReportResponse{}
+	mi := &file_messages_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportResponse) ProtoMessage() {}
+
+func (x *ReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportResponse.ProtoReflect.Descriptor instead.
+func (*ReportResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *ReportResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ReportResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AdminListReports struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeResolved bool                   `protobuf:"varint,1,opt,name=include_resolved,json=includeResolved,proto3" json:"include_resolved,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AdminListReports) Reset() {
+	*x = AdminListReports{}
+	mi := &file_messages_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListReports) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListReports) ProtoMessage() {}
+
+func (x *AdminListReports) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListReports.ProtoReflect.Descriptor instead.
+func (*AdminListReports) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *AdminListReports) GetIncludeResolved() bool {
+	if x != nil {
+		return x.IncludeResolved
+	}
+	return false
+}
+
+// Something said shortly before a report was made. Kind is "chat", "yell", "whisper" or "channel", and to is who the
+// whisper was to or which channel it was in.
+type ReportChatLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderName    string                 `protobuf:"bytes,1,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Msg           string                 `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	SentAt        int64                  `protobuf:"varint,5,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportChatLine) Reset() {
+	*x = ReportChatLine{}
+	mi := &file_messages_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportChatLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportChatLine) ProtoMessage() {}
+
+func (x *ReportChatLine) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportChatLine.ProtoReflect.Descriptor instead.
+func (*ReportChatLine) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *ReportChatLine) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *ReportChatLine) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReportChatLine) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ReportChatLine) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ReportChatLine) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
+}
+
+type ReportInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reporter         string                 `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Reported         string                 `protobuf:"bytes,3,opt,name=reported,proto3" json:"reported,omitempty"`
+	ReportedUsername string                 `protobuf:"bytes,4,opt,name=reported_username,json=reportedUsername,proto3" json:"reported_username,omitempty"` // For the moderation packets, which act on accounts
+	Reason           string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	LevelId          int32                  `protobuf:"varint,6,opt,name=level_id,json=levelId,proto3" json:"level_id,omitempty"`
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // "open", "claimed" or "resolved"
+	ClaimedBy        string                 `protobuf:"bytes,8,opt,name=claimed_by,json=claimedBy,proto3" json:"claimed_by,omitempty"`
+	Resolution       string                 `protobuf:"bytes,9,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	Actions          []string               `protobuf:"bytes,10,rep,name=actions,proto3" json:"actions,omitempty"` // The moderation actions taken because of the report, e.g. "mute: until ... (spam)"
+	CreatedAt        int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Chat             []*ReportChatLine      `protobuf:"bytes,12,rep,name=chat,proto3" json:"chat,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReportInfo) Reset() {
+	*x = ReportInfo{}
+	mi := &file_messages_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportInfo) ProtoMessage() {}
+
+func (x *ReportInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportInfo.ProtoReflect.Descriptor instead.
+func (*ReportInfo) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ReportInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReportInfo) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
+}
+
+func (x *ReportInfo) GetReported() string {
+	if x != nil {
+		return x.Reported
+	}
+	return ""
+}
+
+func (x *ReportInfo) GetReportedUsername() string {
+	if x != nil {
+		return x.ReportedUsername
+	}
+	return ""
+}
+
+func (x *ReportInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReportInfo) GetLevelId() int32 {
+	if x != nil {
+		return x.LevelId
+	}
+	return 0
+}
+
+func (x *ReportInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReportInfo) GetClaimedBy() string {
+	if x != nil {
+		return x.ClaimedBy
+	}
+	return ""
+}
+
+func (x *ReportInfo) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *ReportInfo) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *ReportInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ReportInfo) GetChat() []*ReportChatLine {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+type AdminReportList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*ReportInfo          `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminReportList) Reset() {
+	*x = AdminReportList{}
+	mi := &file_messages_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminReportList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReportList) ProtoMessage() {}
+
+func (x *AdminReportList) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReportList.ProtoReflect.Descriptor instead.
+func (*AdminReportList) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *AdminReportList) GetReports() []*ReportInfo {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+// Marks a report as being dealt with by the admin, so other admins leave it alone
+type AdminClaimReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      int32                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminClaimReport) Reset() {
+	*x = AdminClaimReport{}
+	mi := &file_messages_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminClaimReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminClaimReport) ProtoMessage() {}
+
+func (x *AdminClaimReport) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminClaimReport.ProtoReflect.Descriptor instead.
+func (*AdminClaimReport) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *AdminClaimReport) GetReportId() int32 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+// Closes a report, optionally kicking, banning or muting the reported player's account. A duration of 0 means forever.
+type AdminResolveReport struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReportId        int32                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Resolution      string                 `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	Action          string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // "kick", "ban", "mute" or empty to take no action
+	DurationMinutes uint32                 `protobuf:"varint,4,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AdminResolveReport) Reset() {
+	*x = AdminResolveReport{}
+	mi := &file_messages_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminResolveReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminResolveReport) ProtoMessage() {}
+
+func (x *AdminResolveReport) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminResolveReport.ProtoReflect.Descriptor instead.
+func (*AdminResolveReport) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *AdminResolveReport) GetReportId() int32 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *AdminResolveReport) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *AdminResolveReport) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AdminResolveReport) GetDurationMinutes() uint32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+// The outcome of claiming or resolving a report
+type AdminReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Op            string                 `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"` // "claim" or "resolve"
+	ReportId      int32                  `protobuf:"varint,3,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminReportResponse) Reset() {
+	*x = AdminReportResponse{}
+	mi := &file_messages_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReportResponse) ProtoMessage() {}
+
+func (x *AdminReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReportResponse.ProtoReflect.Descriptor instead.
+func (*AdminReportResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *AdminReportResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *AdminReportResponse) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *AdminReportResponse) GetReportId() int32 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+// Searches what players have said, newest first. Any of the filters can be left empty, or 0 for the times, which are
+// Unix timestamps in seconds.
+type AdminSearchChatLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        string                 `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"` // A character's name, to find what they said and what was whispered to them
+	Since         int64                  `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
+	Until         int64                  `protobuf:"varint,3,opt,name=until,proto3" json:"until,omitempty"`
+	Keyword       string                 `protobuf:"bytes,4,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSearchChatLog) Reset() {
+	*x = AdminSearchChatLog{}
+	mi := &file_messages_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSearchChatLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSearchChatLog) ProtoMessage() {}
+
+func (x *AdminSearchChatLog) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSearchChatLog.ProtoReflect.Descriptor instead.
+func (*AdminSearchChatLog) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *AdminSearchChatLog) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *AdminSearchChatLog) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *AdminSearchChatLog) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *AdminSearchChatLog) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+// Kind is "chat", "yell", "whisper" or "channel", and to is who the whisper was to or which channel it was in
+type ChatLogEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderName    string                 `protobuf:"bytes,1,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	LevelId       int32                  `protobuf:"varint,2,opt,name=level_id,json=levelId,proto3" json:"level_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	RawMsg        string                 `protobuf:"bytes,5,opt,name=raw_msg,json=rawMsg,proto3" json:"raw_msg,omitempty"`
+	CensoredMsg   string                 `protobuf:"bytes,6,opt,name=censored_msg,json=censoredMsg,proto3" json:"censored_msg,omitempty"`
+	SentAt        int64                  `protobuf:"varint,7,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatLogEntry) Reset() {
+	*x = ChatLogEntry{}
+	mi := &file_messages_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatLogEntry) ProtoMessage() {}
+
+func (x *ChatLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatLogEntry.ProtoReflect.Descriptor instead.
+func (*ChatLogEntry) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *ChatLogEntry) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *ChatLogEntry) GetLevelId() int32 {
+	if x != nil {
+		return x.LevelId
+	}
+	return 0
+}
+
+func (x *ChatLogEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ChatLogEntry) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ChatLogEntry) GetRawMsg() string {
+	if x != nil {
+		return x.RawMsg
+	}
+	return ""
+}
+
+func (x *ChatLogEntry) GetCensoredMsg() string {
+	if x != nil {
+		return x.CensoredMsg
+	}
+	return ""
+}
+
+func (x *ChatLogEntry) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
+}
+
+type AdminChatLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Entries       []*ChatLogEntry        `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	Truncated     bool                   `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"` // Whether there were more matches than could be sent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminChatLog) Reset() {
+	*x = AdminChatLog{}
+	mi := &file_messages_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminChatLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminChatLog) ProtoMessage() {}
+
+func (x *AdminChatLog) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminChatLog.ProtoReflect.Descriptor instead.
+func (*AdminChatLog) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *AdminChatLog) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *AdminChatLog) GetEntries() []*ChatLogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *AdminChatLog) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// The time of day in the game world, sent when entering the game and every so often after. A whole game day always
+// lasts day_length_seconds, so clients can keep the clock running between updates.
+type WorldTime struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Day              int64                  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	Minute           int32                  `protobuf:"varint,2,opt,name=minute,proto3" json:"minute,omitempty"` // Minutes since midnight, e.g. 720 is noon
+	DayLengthSeconds uint32                 `protobuf:"varint,3,opt,name=day_length_seconds,json=dayLengthSeconds,proto3" json:"day_length_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WorldTime) Reset() {
+	*x = WorldTime{}
+	mi := &file_messages_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldTime) ProtoMessage() {}
+
+func (x *WorldTime) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldTime.ProtoReflect.Descriptor instead.
+func (*WorldTime) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *WorldTime) GetDay() int64 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *WorldTime) GetMinute() int32 {
+	if x != nil {
+		return x.Minute
+	}
+	return 0
+}
+
+func (x *WorldTime) GetDayLengthSeconds() uint32 {
+	if x != nil {
+		return x.DayLengthSeconds
+	}
+	return 0
+}
+
+// Sent by the client to wave, sit, dance, etc. and passed on to everyone else in the level, with the sender's ID
+type Emote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Emote) Reset() {
+	*x = Emote{}
+	mi := &file_messages_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Emote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Emote) ProtoMessage() {}
+
+func (x *Emote) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Emote.ProtoReflect.Descriptor instead.
+func (*Emote) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *Emote) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Several packets sent together in one frame, to be handled in order as if they had arrived one after the other. Only
+// sent to clients which have asked for batching.
+type PacketBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Packets       []*Packet              `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PacketBatch) Reset() {
+	*x = PacketBatch{}
+	mi := &file_messages_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PacketBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketBatch) ProtoMessage() {}
+
+func (x *PacketBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketBatch.ProtoReflect.Descriptor instead.
+func (*PacketBatch) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *PacketBatch) GetPackets() []*Packet {
+	if x != nil {
+		return x.Packets
+	}
+	return nil
+}
+
+type Packet struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SenderId uint32                 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	// Types that are valid to be assigned to Msg:
+	//
+	//	*Packet_ClientId
+	//	*Packet_LoginRequest
+	//	*Packet_LoginResponse
+	//	*Packet_RegisterRequest
+	//	*Packet_RegisterResponse
+	//	*Packet_Logout
+	//	*Packet_Chat
+	//	*Packet_Yell
+	//	*Packet_Actor
+	//	*Packet_ActorMove
+	//	*Packet_Motd
+	//	*Packet_Disconnect
+	//	*Packet_AdminLoginGranted
+	//	*Packet_SqlQuery
+	//	*Packet_SqlResponse
+	//	*Packet_LevelUpload
+	//	*Packet_LevelUploadResponse
+	//	*Packet_LevelDownload
+	//	*Packet_AdminJoinGameRequest
+	//	*Packet_AdminJoinGameResponse
+	//	*Packet_ServerMessage
+	//	*Packet_PickupGroundItemRequest
+	//	*Packet_PickupGroundItemResponse
+	//	*Packet_Shrub
+	//	*Packet_Ore
+	//	*Packet_Door
+	//	*Packet_Item
+	//	*Packet_GroundItem
+	//	*Packet_ActorInventory
+	//	*Packet_DropItemRequest
+	//	*Packet_DropItemResponse
+	//	*Packet_ChopShrubRequest
+	//	*Packet_ChopShrubResponse
+	//	*Packet_MineOreRequest
+	//	*Packet_MineOreResponse
+	//	*Packet_ItemQuantity
+	//	*Packet_XpReward
+	//	*Packet_SkillsXp
+	//	*Packet_InteractWithNpcResponse
+	//	*Packet_InteractWithNpcRequest
+	//	*Packet_NpcDialogue
+	//	*Packet_BuyRequest
+	//	*Packet_BuyResponse
+	//	*Packet_SellRequest
+	//	*Packet_SellResponse
+	//	*Packet_LevelMetadata
+	//	*Packet_QuestInfo
+	//	*Packet_DespawnGroundItem
+	//	*Packet_Backpressure
+	//	*Packet_PacketBatch
+	//	*Packet_Hello
+	//	*Packet_HelloResponse
+	//	*Packet_ResumeSession
+	//	*Packet_ResumeSessionResponse
+	//	*Packet_ChangePassword
+	//	*Packet_ChangePasswordResponse
+	//	*Packet_AdminResetPassword
+	//	*Packet_AdminResetPasswordResponse
+	//	*Packet_CharacterList
+	//	*Packet_CreateCharacterRequest
+	//	*Packet_CreateCharacterResponse
+	//	*Packet_DeleteCharacterRequest
+	//	*Packet_DeleteCharacterResponse
+	//	*Packet_RenameCharacterRequest
+	//	*Packet_RenameCharacterResponse
+	//	*Packet_SelectCharacterRequest
+	//	*Packet_SelectCharacterResponse
+	//	*Packet_ChangeAppearanceRequest
+	//	*Packet_ChangeAppearanceResponse
+	//	*Packet_AdminKick
+	//	*Packet_AdminBan
+	//	*Packet_AdminUnban
+	//	*Packet_AdminMute
+	//	*Packet_AdminUnmute
+	//	*Packet_AdminModerationResponse
+	//	*Packet_ModerationNotice
+	//	*Packet_AdminGrantRole
+	//	*Packet_AdminRevokeRole
+	//	*Packet_AdminRoleResponse
+	//	*Packet_AdminLookupPlayer
+	//	*Packet_AdminPlayerInfo
+	//	*Packet_AdminEditInventory
+	//	*Packet_AdminGrantXp
+	//	*Packet_AdminTeleport
+	//	*Packet_AdminOpResponse
+	//	*Packet_AdminListOnlinePlayers
+	//	*Packet_AdminOnlinePlayers
+	//	*Packet_AdminListLevels
+	//	*Packet_AdminLevelList
+	//	*Packet_CommandList
+	//	*Packet_Whisper
+	//	*Packet_FriendRequest
+	//	*Packet_AcceptFriendRequest
+	//	*Packet_RemoveFriend
+	//	*Packet_FriendList
+	//	*Packet_FriendPresence
+	//	*Packet_IgnorePlayer
+	//	*Packet_UnignorePlayer
+	//	*Packet_IgnoreList
+	//	*Packet_SocialResponse
+	//	*Packet_JoinChannel
+	//	*Packet_LeaveChannel
+	//	*Packet_ChannelMessage
+	//	*Packet_ChannelJoined
+	//	*Packet_ChannelMemberUpdate
+	//	*Packet_ChannelModerate
+	//	*Packet_ChannelResponse
+	//	*Packet_ListChannels
+	//	*Packet_ChannelList
+	//	*Packet_PartyInvite
+	//	*Packet_PartyAccept
+	//	*Packet_PartyLeave
+	//	*Packet_PartyKick
+	//	*Packet_Party
+	//	*Packet_PartyMemberPosition
+	//	*Packet_PartyQuestCredit
+	//	*Packet_PartyResponse
+	//	*Packet_CreateGuild
+	//	*Packet_GuildInvite
+	//	*Packet_AcceptGuildInvite
+	//	*Packet_LeaveGuild
+	//	*Packet_GuildKick
+	//	*Packet_GuildSetRank
+	//	*Packet_GuildInfo
+	//	*Packet_GuildBankDeposit
+	//	*Packet_GuildBankWithdraw
+	//	*Packet_RequestGuildBank
+	//	*Packet_GuildBank
+	//	*Packet_RequestGuildBankLog
+	//	*Packet_GuildBankLog
+	//	*Packet_GuildResponse
+	//	*Packet_ReportPlayer
+	//	*Packet_ReportResponse
+	//	*Packet_AdminListReports
+	//	*Packet_AdminReportList
+	//	*Packet_AdminClaimReport
+	//	*Packet_AdminResolveReport
+	//	*Packet_AdminReportResponse
+	//	*Packet_AdminSearchChatLog
+	//	*Packet_AdminChatLog
+	//	*Packet_Emote
+	//	*Packet_WorldTime
+	Msg           isPacket_Msg `protobuf_oneof:"msg"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Packet) Reset() {
+	*x = Packet{}
+	mi := &file_messages_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Packet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Packet) ProtoMessage() {}
+
+func (x *Packet) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
+func (*Packet) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *Packet) GetSenderId() uint32 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *Packet) GetMsg() isPacket_Msg {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *Packet) GetClientId() *ClientId {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ClientId); ok {
+			return x.ClientId
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLoginRequest() *LoginRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LoginRequest); ok {
+			return x.LoginRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLoginResponse() *LoginResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LoginResponse); ok {
+			return x.LoginResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRegisterRequest() *RegisterRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RegisterRequest); ok {
+			return x.RegisterRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRegisterResponse() *RegisterResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RegisterResponse); ok {
+			return x.RegisterResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLogout() *Logout {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Logout); ok {
+			return x.Logout
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChat() *Chat {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Chat); ok {
+			return x.Chat
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetYell() *Yell {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Yell); ok {
+			return x.Yell
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetActor() *Actor {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Actor); ok {
+			return x.Actor
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetActorMove() *ActorMove {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ActorMove); ok {
+			return x.ActorMove
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetMotd() *Motd {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Motd); ok {
+			return x.Motd
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDisconnect() *Disconnect {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Disconnect); ok {
+			return x.Disconnect
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminLoginGranted() *AdminLoginGranted {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminLoginGranted); ok {
+			return x.AdminLoginGranted
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSqlQuery() *SqlQuery {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SqlQuery); ok {
+			return x.SqlQuery
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSqlResponse() *SqlResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SqlResponse); ok {
+			return x.SqlResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLevelUpload() *LevelUpload {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LevelUpload); ok {
+			return x.LevelUpload
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLevelUploadResponse() *LevelUploadResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LevelUploadResponse); ok {
+			return x.LevelUploadResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLevelDownload() *LevelDownload {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LevelDownload); ok {
+			return x.LevelDownload
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminJoinGameRequest() *AdminJoinGameRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminJoinGameRequest); ok {
+			return x.AdminJoinGameRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminJoinGameResponse() *AdminJoinGameResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminJoinGameResponse); ok {
+			return x.AdminJoinGameResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetServerMessage() *ServerMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ServerMessage); ok {
+			return x.ServerMessage
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPickupGroundItemRequest() *PickupGroundItemRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PickupGroundItemRequest); ok {
+			return x.PickupGroundItemRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPickupGroundItemResponse() *PickupGroundItemResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PickupGroundItemResponse); ok {
+			return x.PickupGroundItemResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetShrub() *Shrub {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Shrub); ok {
+			return x.Shrub
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetOre() *Ore {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Ore); ok {
+			return x.Ore
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDoor() *Door {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Door); ok {
+			return x.Door
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetItem() *Item {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Item); ok {
+			return x.Item
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGroundItem() *GroundItem {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GroundItem); ok {
+			return x.GroundItem
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetActorInventory() *ActorInventory {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ActorInventory); ok {
+			return x.ActorInventory
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDropItemRequest() *DropItemRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_DropItemRequest); ok {
+			return x.DropItemRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDropItemResponse() *DropItemResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_DropItemResponse); ok {
+			return x.DropItemResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChopShrubRequest() *ChopShrubRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChopShrubRequest); ok {
+			return x.ChopShrubRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChopShrubResponse() *ChopShrubResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChopShrubResponse); ok {
+			return x.ChopShrubResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetMineOreRequest() *MineOreRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_MineOreRequest); ok {
+			return x.MineOreRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetMineOreResponse() *MineOreResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_MineOreResponse); ok {
+			return x.MineOreResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetItemQuantity() *ItemQuantity {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ItemQuantity); ok {
+			return x.ItemQuantity
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetXpReward() *XpReward {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_XpReward); ok {
+			return x.XpReward
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSkillsXp() *SkillsXp {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SkillsXp); ok {
+			return x.SkillsXp
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetInteractWithNpcResponse() *InteractWithNpcResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_InteractWithNpcResponse); ok {
+			return x.InteractWithNpcResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetInteractWithNpcRequest() *InteractWithNpcRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_InteractWithNpcRequest); ok {
+			return x.InteractWithNpcRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetNpcDialogue() *NpcDialogue {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_NpcDialogue); ok {
+			return x.NpcDialogue
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetBuyRequest() *BuyRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_BuyRequest); ok {
+			return x.BuyRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetBuyResponse() *BuyResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_BuyResponse); ok {
+			return x.BuyResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSellRequest() *SellRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SellRequest); ok {
+			return x.SellRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSellResponse() *SellResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SellResponse); ok {
+			return x.SellResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLevelMetadata() *LevelMetadata {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LevelMetadata); ok {
+			return x.LevelMetadata
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetQuestInfo() *QuestInfo {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_QuestInfo); ok {
+			return x.QuestInfo
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDespawnGroundItem() *DespawnGroundItem {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_DespawnGroundItem); ok {
+			return x.DespawnGroundItem
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetBackpressure() *Backpressure {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Backpressure); ok {
+			return x.Backpressure
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPacketBatch() *PacketBatch {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PacketBatch); ok {
+			return x.PacketBatch
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetHello() *Hello {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Hello); ok {
+			return x.Hello
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetHelloResponse() *HelloResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_HelloResponse); ok {
+			return x.HelloResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetResumeSession() *ResumeSession {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ResumeSession); ok {
+			return x.ResumeSession
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetResumeSessionResponse() *ResumeSessionResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ResumeSessionResponse); ok {
+			return x.ResumeSessionResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChangePassword() *ChangePassword {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChangePassword); ok {
+			return x.ChangePassword
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChangePasswordResponse() *ChangePasswordResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChangePasswordResponse); ok {
+			return x.ChangePasswordResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminResetPassword() *AdminResetPassword {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminResetPassword); ok {
+			return x.AdminResetPassword
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminResetPasswordResponse() *AdminResetPasswordResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminResetPasswordResponse); ok {
+			return x.AdminResetPasswordResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetCharacterList() *CharacterList {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_CharacterList); ok {
+			return x.CharacterList
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetCreateCharacterRequest() *CreateCharacterRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_CreateCharacterRequest); ok {
+			return x.CreateCharacterRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetCreateCharacterResponse() *CreateCharacterResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_CreateCharacterResponse); ok {
+			return x.CreateCharacterResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDeleteCharacterRequest() *DeleteCharacterRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_DeleteCharacterRequest); ok {
+			return x.DeleteCharacterRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDeleteCharacterResponse() *DeleteCharacterResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_DeleteCharacterResponse); ok {
+			return x.DeleteCharacterResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRenameCharacterRequest() *RenameCharacterRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RenameCharacterRequest); ok {
+			return x.RenameCharacterRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRenameCharacterResponse() *RenameCharacterResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RenameCharacterResponse); ok {
+			return x.RenameCharacterResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSelectCharacterRequest() *SelectCharacterRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SelectCharacterRequest); ok {
+			return x.SelectCharacterRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSelectCharacterResponse() *SelectCharacterResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SelectCharacterResponse); ok {
+			return x.SelectCharacterResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChangeAppearanceRequest() *ChangeAppearanceRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChangeAppearanceRequest); ok {
+			return x.ChangeAppearanceRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChangeAppearanceResponse() *ChangeAppearanceResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChangeAppearanceResponse); ok {
+			return x.ChangeAppearanceResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminKick() *AdminKick {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminKick); ok {
+			return x.AdminKick
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminBan() *AdminBan {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminBan); ok {
+			return x.AdminBan
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminUnban() *AdminUnban {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminUnban); ok {
+			return x.AdminUnban
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminMute() *AdminMute {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminMute); ok {
+			return x.AdminMute
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminUnmute() *AdminUnmute {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminUnmute); ok {
+			return x.AdminUnmute
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminModerationResponse() *AdminModerationResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminModerationResponse); ok {
+			return x.AdminModerationResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetModerationNotice() *ModerationNotice {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ModerationNotice); ok {
+			return x.ModerationNotice
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminGrantRole() *AdminGrantRole {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminGrantRole); ok {
+			return x.AdminGrantRole
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminRevokeRole() *AdminRevokeRole {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminRevokeRole); ok {
+			return x.AdminRevokeRole
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminRoleResponse() *AdminRoleResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminRoleResponse); ok {
+			return x.AdminRoleResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminLookupPlayer() *AdminLookupPlayer {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminLookupPlayer); ok {
+			return x.AdminLookupPlayer
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminPlayerInfo() *AdminPlayerInfo {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminPlayerInfo); ok {
+			return x.AdminPlayerInfo
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminEditInventory() *AdminEditInventory {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminEditInventory); ok {
+			return x.AdminEditInventory
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminGrantXp() *AdminGrantXp {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminGrantXp); ok {
+			return x.AdminGrantXp
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminTeleport() *AdminTeleport {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminTeleport); ok {
+			return x.AdminTeleport
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminOpResponse() *AdminOpResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminOpResponse); ok {
+			return x.AdminOpResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminListOnlinePlayers() *AdminListOnlinePlayers {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminListOnlinePlayers); ok {
+			return x.AdminListOnlinePlayers
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminOnlinePlayers() *AdminOnlinePlayers {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminOnlinePlayers); ok {
+			return x.AdminOnlinePlayers
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminListLevels() *AdminListLevels {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminListLevels); ok {
+			return x.AdminListLevels
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminLevelList() *AdminLevelList {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminLevelList); ok {
+			return x.AdminLevelList
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetCommandList() *CommandList {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_CommandList); ok {
+			return x.CommandList
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetWhisper() *Whisper {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Whisper); ok {
+			return x.Whisper
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFriendRequest() *FriendRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_FriendRequest); ok {
+			return x.FriendRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAcceptFriendRequest() *AcceptFriendRequest {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AcceptFriendRequest); ok {
+			return x.AcceptFriendRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRemoveFriend() *RemoveFriend {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RemoveFriend); ok {
+			return x.RemoveFriend
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFriendList() *FriendList {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_FriendList); ok {
+			return x.FriendList
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFriendPresence() *FriendPresence {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_FriendPresence); ok {
+			return x.FriendPresence
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetIgnorePlayer() *IgnorePlayer {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_IgnorePlayer); ok {
+			return x.IgnorePlayer
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetUnignorePlayer() *UnignorePlayer {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_UnignorePlayer); ok {
+			return x.UnignorePlayer
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetIgnoreList() *IgnoreList {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_IgnoreList); ok {
+			return x.IgnoreList
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSocialResponse() *SocialResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SocialResponse); ok {
+			return x.SocialResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetJoinChannel() *JoinChannel {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_JoinChannel); ok {
+			return x.JoinChannel
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLeaveChannel() *LeaveChannel {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LeaveChannel); ok {
+			return x.LeaveChannel
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChannelMessage() *ChannelMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChannelMessage); ok {
+			return x.ChannelMessage
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChannelJoined() *ChannelJoined {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChannelJoined); ok {
+			return x.ChannelJoined
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChannelMemberUpdate() *ChannelMemberUpdate {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChannelMemberUpdate); ok {
+			return x.ChannelMemberUpdate
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChannelModerate() *ChannelModerate {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChannelModerate); ok {
+			return x.ChannelModerate
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChannelResponse() *ChannelResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChannelResponse); ok {
+			return x.ChannelResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetListChannels() *ListChannels {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ListChannels); ok {
+			return x.ListChannels
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChannelList() *ChannelList {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChannelList); ok {
+			return x.ChannelList
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyInvite() *PartyInvite {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyInvite); ok {
+			return x.PartyInvite
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyAccept() *PartyAccept {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyAccept); ok {
+			return x.PartyAccept
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyLeave() *PartyLeave {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyLeave); ok {
+			return x.PartyLeave
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyKick() *PartyKick {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyKick); ok {
+			return x.PartyKick
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetParty() *Party {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Party); ok {
+			return x.Party
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyMemberPosition() *PartyMemberPosition {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyMemberPosition); ok {
+			return x.PartyMemberPosition
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyQuestCredit() *PartyQuestCredit {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyQuestCredit); ok {
+			return x.PartyQuestCredit
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyResponse() *PartyResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyResponse); ok {
+			return x.PartyResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetCreateGuild() *CreateGuild {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_CreateGuild); ok {
+			return x.CreateGuild
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildInvite() *GuildInvite {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GuildInvite); ok {
+			return x.GuildInvite
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAcceptGuildInvite() *AcceptGuildInvite {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AcceptGuildInvite); ok {
+			return x.AcceptGuildInvite
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLeaveGuild() *LeaveGuild {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LeaveGuild); ok {
+			return x.LeaveGuild
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildKick() *GuildKick {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GuildKick); ok {
+			return x.GuildKick
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildSetRank() *GuildSetRank {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GuildSetRank); ok {
+			return x.GuildSetRank
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildInfo() *GuildInfo {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GuildInfo); ok {
+			return x.GuildInfo
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildBankDeposit() *GuildBankDeposit {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GuildBankDeposit); ok {
+			return x.GuildBankDeposit
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildBankWithdraw() *GuildBankWithdraw {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GuildBankWithdraw); ok {
+			return x.GuildBankWithdraw
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRequestGuildBank() *RequestGuildBank {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RequestGuildBank); ok {
+			return x.RequestGuildBank
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildBank() *GuildBank {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GuildBank); ok {
+			return x.GuildBank
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRequestGuildBankLog() *RequestGuildBankLog {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RequestGuildBankLog); ok {
+			return x.RequestGuildBankLog
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildBankLog() *GuildBankLog {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GuildBankLog); ok {
+			return x.GuildBankLog
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildResponse() *GuildResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GuildResponse); ok {
+			return x.GuildResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetReportPlayer() *ReportPlayer {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ReportPlayer); ok {
+			return x.ReportPlayer
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetReportResponse() *ReportResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ReportResponse); ok {
+			return x.ReportResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminListReports() *AdminListReports {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminListReports); ok {
+			return x.AdminListReports
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminReportList() *AdminReportList {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminReportList); ok {
+			return x.AdminReportList
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminClaimReport() *AdminClaimReport {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminClaimReport); ok {
+			return x.AdminClaimReport
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminResolveReport() *AdminResolveReport {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminResolveReport); ok {
+			return x.AdminResolveReport
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminReportResponse() *AdminReportResponse {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminReportResponse); ok {
+			return x.AdminReportResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminSearchChatLog() *AdminSearchChatLog {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminSearchChatLog); ok {
+			return x.AdminSearchChatLog
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAdminChatLog() *AdminChatLog {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AdminChatLog); ok {
+			return x.AdminChatLog
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetEmote() *Emote {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Emote); ok {
+			return x.Emote
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetWorldTime() *WorldTime {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_WorldTime); ok {
+			return x.WorldTime
+		}
+	}
+	return nil
+}
+
+type isPacket_Msg interface {
+	isPacket_Msg()
+}
+
+type Packet_ClientId struct {
+	ClientId *ClientId `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3,oneof"`
+}
+
+type Packet_LoginRequest struct {
+	LoginRequest *LoginRequest `protobuf:"bytes,3,opt,name=login_request,json=loginRequest,proto3,oneof"`
+}
+
+type Packet_LoginResponse struct {
+	LoginResponse *LoginResponse `protobuf:"bytes,4,opt,name=login_response,json=loginResponse,proto3,oneof"`
+}
+
+type Packet_RegisterRequest struct {
+	RegisterRequest *RegisterRequest `protobuf:"bytes,5,opt,name=register_request,json=registerRequest,proto3,oneof"`
+}
+
+type Packet_RegisterResponse struct {
+	RegisterResponse *RegisterResponse `protobuf:"bytes,6,opt,name=register_response,json=registerResponse,proto3,oneof"`
+}
+
+type Packet_Logout struct {
+	Logout *Logout `protobuf:"bytes,7,opt,name=logout,proto3,oneof"`
+}
+
+type Packet_Chat struct {
+	Chat *Chat `protobuf:"bytes,8,opt,name=chat,proto3,oneof"`
+}
+
+type Packet_Yell struct {
+	Yell *Yell `protobuf:"bytes,9,opt,name=yell,proto3,oneof"`
+}
+
+type Packet_Actor struct {
+	Actor *Actor `protobuf:"bytes,10,opt,name=actor,proto3,oneof"`
+}
+
+type Packet_ActorMove struct {
+	ActorMove *ActorMove `protobuf:"bytes,11,opt,name=actor_move,json=actorMove,proto3,oneof"`
+}
+
+type Packet_Motd struct {
+	Motd *Motd `protobuf:"bytes,12,opt,name=motd,proto3,oneof"`
+}
+
+type Packet_Disconnect struct {
+	Disconnect *Disconnect `protobuf:"bytes,13,opt,name=disconnect,proto3,oneof"`
+}
+
+type Packet_AdminLoginGranted struct {
+	AdminLoginGranted *AdminLoginGranted `protobuf:"bytes,14,opt,name=admin_login_granted,json=adminLoginGranted,proto3,oneof"`
+}
+
+type Packet_SqlQuery struct {
+	SqlQuery *SqlQuery `protobuf:"bytes,15,opt,name=sql_query,json=sqlQuery,proto3,oneof"`
+}
+
+type Packet_SqlResponse struct {
+	SqlResponse *SqlResponse `protobuf:"bytes,16,opt,name=sql_response,json=sqlResponse,proto3,oneof"`
+}
+
+type Packet_LevelUpload struct {
+	LevelUpload *LevelUpload `protobuf:"bytes,17,opt,name=level_upload,json=levelUpload,proto3,oneof"`
+}
+
+type Packet_LevelUploadResponse struct {
+	LevelUploadResponse *LevelUploadResponse `protobuf:"bytes,18,opt,name=level_upload_response,json=levelUploadResponse,proto3,oneof"`
+}
+
+type Packet_LevelDownload struct {
+	LevelDownload *LevelDownload `protobuf:"bytes,19,opt,name=level_download,json=levelDownload,proto3,oneof"`
+}
+
+type Packet_AdminJoinGameRequest struct {
+	AdminJoinGameRequest *AdminJoinGameRequest `protobuf:"bytes,20,opt,name=admin_join_game_request,json=adminJoinGameRequest,proto3,oneof"`
+}
+
+type Packet_AdminJoinGameResponse struct {
+	AdminJoinGameResponse *AdminJoinGameResponse `protobuf:"bytes,21,opt,name=admin_join_game_response,json=adminJoinGameResponse,proto3,oneof"`
+}
+
+type Packet_ServerMessage struct {
+	ServerMessage *ServerMessage `protobuf:"bytes,22,opt,name=server_message,json=serverMessage,proto3,oneof"`
+}
+
+type Packet_PickupGroundItemRequest struct {
+	PickupGroundItemRequest *PickupGroundItemRequest `protobuf:"bytes,23,opt,name=pickup_ground_item_request,json=pickupGroundItemRequest,proto3,oneof"`
+}
+
+type Packet_PickupGroundItemResponse struct {
+	PickupGroundItemResponse *PickupGroundItemResponse `protobuf:"bytes,24,opt,name=pickup_ground_item_response,json=pickupGroundItemResponse,proto3,oneof"`
+}
+
+type Packet_Shrub struct {
+	Shrub *Shrub `protobuf:"bytes,25,opt,name=shrub,proto3,oneof"`
+}
+
+type Packet_Ore struct {
+	Ore *Ore `protobuf:"bytes,26,opt,name=ore,proto3,oneof"`
+}
+
+type Packet_Door struct {
+	Door *Door `protobuf:"bytes,27,opt,name=door,proto3,oneof"`
+}
+
+type Packet_Item struct {
+	Item *Item `protobuf:"bytes,28,opt,name=Item,proto3,oneof"`
+}
+
+type Packet_GroundItem struct {
+	GroundItem *GroundItem `protobuf:"bytes,29,opt,name=ground_item,json=groundItem,proto3,oneof"`
+}
+
+type Packet_ActorInventory struct {
+	ActorInventory *ActorInventory `protobuf:"bytes,30,opt,name=actor_inventory,json=actorInventory,proto3,oneof"`
+}
+
+type Packet_DropItemRequest struct {
+	DropItemRequest *DropItemRequest `protobuf:"bytes,31,opt,name=drop_item_request,json=dropItemRequest,proto3,oneof"`
+}
+
+type Packet_DropItemResponse struct {
+	DropItemResponse *DropItemResponse `protobuf:"bytes,32,opt,name=drop_item_response,json=dropItemResponse,proto3,oneof"`
+}
+
+type Packet_ChopShrubRequest struct {
+	ChopShrubRequest *ChopShrubRequest `protobuf:"bytes,33,opt,name=chop_shrub_request,json=chopShrubRequest,proto3,oneof"`
+}
+
+type Packet_ChopShrubResponse struct {
+	ChopShrubResponse *ChopShrubResponse `protobuf:"bytes,34,opt,name=chop_shrub_response,json=chopShrubResponse,proto3,oneof"`
+}
+
+type Packet_MineOreRequest struct {
+	MineOreRequest *MineOreRequest `protobuf:"bytes,35,opt,name=mine_ore_request,json=mineOreRequest,proto3,oneof"`
+}
+
+type Packet_MineOreResponse struct {
+	MineOreResponse *MineOreResponse `protobuf:"bytes,36,opt,name=mine_ore_response,json=mineOreResponse,proto3,oneof"`
+}
+
+type Packet_ItemQuantity struct {
+	ItemQuantity *ItemQuantity `protobuf:"bytes,37,opt,name=item_quantity,json=itemQuantity,proto3,oneof"`
+}
+
+type Packet_XpReward struct {
+	XpReward *XpReward `protobuf:"bytes,38,opt,name=xp_reward,json=xpReward,proto3,oneof"`
+}
+
+type Packet_SkillsXp struct {
+	SkillsXp *SkillsXp `protobuf:"bytes,39,opt,name=skills_xp,json=skillsXp,proto3,oneof"`
+}
+
+type Packet_InteractWithNpcResponse struct {
+	InteractWithNpcResponse *InteractWithNpcResponse `protobuf:"bytes,40,opt,name=interact_with_npc_response,json=interactWithNpcResponse,proto3,oneof"`
+}
+
+type Packet_InteractWithNpcRequest struct {
+	InteractWithNpcRequest *InteractWithNpcRequest `protobuf:"bytes,41,opt,name=interact_with_npc_request,json=interactWithNpcRequest,proto3,oneof"`
+}
+
+type Packet_NpcDialogue struct {
+	NpcDialogue *NpcDialogue `protobuf:"bytes,42,opt,name=npc_dialogue,json=npcDialogue,proto3,oneof"`
+}
+
+type Packet_BuyRequest struct {
+	BuyRequest *BuyRequest `protobuf:"bytes,43,opt,name=buy_request,json=buyRequest,proto3,oneof"`
+}
+
+type Packet_BuyResponse struct {
+	BuyResponse *BuyResponse `protobuf:"bytes,44,opt,name=buy_response,json=buyResponse,proto3,oneof"`
+}
+
+type Packet_SellRequest struct {
+	SellRequest *SellRequest `protobuf:"bytes,45,opt,name=sell_request,json=sellRequest,proto3,oneof"`
+}
+
+type Packet_SellResponse struct {
+	SellResponse *SellResponse `protobuf:"bytes,46,opt,name=sell_response,json=sellResponse,proto3,oneof"`
+}
+
+type Packet_LevelMetadata struct {
+	LevelMetadata *LevelMetadata `protobuf:"bytes,47,opt,name=level_metadata,json=levelMetadata,proto3,oneof"`
+}
+
+type Packet_QuestInfo struct {
+	QuestInfo *QuestInfo `protobuf:"bytes,48,opt,name=quest_info,json=questInfo,proto3,oneof"`
+}
+
+type Packet_DespawnGroundItem struct {
+	DespawnGroundItem *DespawnGroundItem `protobuf:"bytes,49,opt,name=despawn_ground_item,json=despawnGroundItem,proto3,oneof"`
+}
+
+type Packet_Backpressure struct {
+	Backpressure *Backpressure `protobuf:"bytes,50,opt,name=backpressure,proto3,oneof"`
+}
+
+type Packet_PacketBatch struct {
+	PacketBatch *PacketBatch `protobuf:"bytes,51,opt,name=packet_batch,json=packetBatch,proto3,oneof"`
+}
+
+type Packet_Hello struct {
+	Hello *Hello `protobuf:"bytes,52,opt,name=hello,proto3,oneof"`
+}
+
+type Packet_HelloResponse struct {
+	HelloResponse *HelloResponse `protobuf:"bytes,53,opt,name=hello_response,json=helloResponse,proto3,oneof"`
+}
+
+type Packet_ResumeSession struct {
+	ResumeSession *ResumeSession `protobuf:"bytes,54,opt,name=resume_session,json=resumeSession,proto3,oneof"`
+}
+
+type Packet_ResumeSessionResponse struct {
+	ResumeSessionResponse *ResumeSessionResponse `protobuf:"bytes,55,opt,name=resume_session_response,json=resumeSessionResponse,proto3,oneof"`
+}
+
+type Packet_ChangePassword struct {
+	ChangePassword *ChangePassword `protobuf:"bytes,56,opt,name=change_password,json=changePassword,proto3,oneof"`
+}
+
+type Packet_ChangePasswordResponse struct {
+	ChangePasswordResponse *ChangePasswordResponse `protobuf:"bytes,57,opt,name=change_password_response,json=changePasswordResponse,proto3,oneof"`
+}
+
+type Packet_AdminResetPassword struct {
+	AdminResetPassword *AdminResetPassword `protobuf:"bytes,58,opt,name=admin_reset_password,json=adminResetPassword,proto3,oneof"`
+}
+
+type Packet_AdminResetPasswordResponse struct {
+	AdminResetPasswordResponse *AdminResetPasswordResponse `protobuf:"bytes,59,opt,name=admin_reset_password_response,json=adminResetPasswordResponse,proto3,oneof"`
+}
+
+type Packet_CharacterList struct {
+	CharacterList *CharacterList `protobuf:"bytes,60,opt,name=character_list,json=characterList,proto3,oneof"`
+}
+
+type Packet_CreateCharacterRequest struct {
+	CreateCharacterRequest *CreateCharacterRequest `protobuf:"bytes,61,opt,name=create_character_request,json=createCharacterRequest,proto3,oneof"`
+}
+
+type Packet_CreateCharacterResponse struct {
+	CreateCharacterResponse *CreateCharacterResponse `protobuf:"bytes,62,opt,name=create_character_response,json=createCharacterResponse,proto3,oneof"`
+}
+
+type Packet_DeleteCharacterRequest struct {
+	DeleteCharacterRequest *DeleteCharacterRequest `protobuf:"bytes,63,opt,name=delete_character_request,json=deleteCharacterRequest,proto3,oneof"`
+}
+
+type Packet_DeleteCharacterResponse struct {
+	DeleteCharacterResponse *DeleteCharacterResponse `protobuf:"bytes,64,opt,name=delete_character_response,json=deleteCharacterResponse,proto3,oneof"`
+}
+
+type Packet_RenameCharacterRequest struct {
+	RenameCharacterRequest *RenameCharacterRequest `protobuf:"bytes,65,opt,name=rename_character_request,json=renameCharacterRequest,proto3,oneof"`
+}
+
+type Packet_RenameCharacterResponse struct {
+	RenameCharacterResponse *RenameCharacterResponse `protobuf:"bytes,66,opt,name=rename_character_response,json=renameCharacterResponse,proto3,oneof"`
+}
+
+type Packet_SelectCharacterRequest struct {
+	SelectCharacterRequest *SelectCharacterRequest `protobuf:"bytes,67,opt,name=select_character_request,json=selectCharacterRequest,proto3,oneof"`
+}
+
+type Packet_SelectCharacterResponse struct {
+	SelectCharacterResponse *SelectCharacterResponse `protobuf:"bytes,68,opt,name=select_character_response,json=selectCharacterResponse,proto3,oneof"`
+}
+
+type Packet_ChangeAppearanceRequest struct {
+	ChangeAppearanceRequest *ChangeAppearanceRequest `protobuf:"bytes,69,opt,name=change_appearance_request,json=changeAppearanceRequest,proto3,oneof"`
+}
+
+type Packet_ChangeAppearanceResponse struct {
+	ChangeAppearanceResponse *ChangeAppearanceResponse `protobuf:"bytes,70,opt,name=change_appearance_response,json=changeAppearanceResponse,proto3,oneof"`
+}
+
+type Packet_AdminKick struct {
+	AdminKick *AdminKick `protobuf:"bytes,71,opt,name=admin_kick,json=adminKick,proto3,oneof"`
+}
+
+type Packet_AdminBan struct {
+	AdminBan *AdminBan `protobuf:"bytes,72,opt,name=admin_ban,json=adminBan,proto3,oneof"`
+}
+
+type Packet_AdminUnban struct {
+	AdminUnban *AdminUnban `protobuf:"bytes,73,opt,name=admin_unban,json=adminUnban,proto3,oneof"`
+}
+
+type Packet_AdminMute struct {
+	AdminMute *AdminMute `protobuf:"bytes,74,opt,name=admin_mute,json=adminMute,proto3,oneof"`
+}
+
+type Packet_AdminUnmute struct {
+	AdminUnmute *AdminUnmute `protobuf:"bytes,75,opt,name=admin_unmute,json=adminUnmute,proto3,oneof"`
+}
+
+type Packet_AdminModerationResponse struct {
+	AdminModerationResponse *AdminModerationResponse `protobuf:"bytes,76,opt,name=admin_moderation_response,json=adminModerationResponse,proto3,oneof"`
+}
+
+type Packet_ModerationNotice struct {
+	ModerationNotice *ModerationNotice `protobuf:"bytes,77,opt,name=moderation_notice,json=moderationNotice,proto3,oneof"`
+}
+
+type Packet_AdminGrantRole struct {
+	AdminGrantRole *AdminGrantRole `protobuf:"bytes,78,opt,name=admin_grant_role,json=adminGrantRole,proto3,oneof"`
+}
+
+type Packet_AdminRevokeRole struct {
+	AdminRevokeRole *AdminRevokeRole `protobuf:"bytes,79,opt,name=admin_revoke_role,json=adminRevokeRole,proto3,oneof"`
+}
+
+type Packet_AdminRoleResponse struct {
+	AdminRoleResponse *AdminRoleResponse `protobuf:"bytes,80,opt,name=admin_role_response,json=adminRoleResponse,proto3,oneof"`
+}
+
+type Packet_AdminLookupPlayer struct {
+	AdminLookupPlayer *AdminLookupPlayer `protobuf:"bytes,81,opt,name=admin_lookup_player,json=adminLookupPlayer,proto3,oneof"`
+}
+
+type Packet_AdminPlayerInfo struct {
+	AdminPlayerInfo *AdminPlayerInfo `protobuf:"bytes,82,opt,name=admin_player_info,json=adminPlayerInfo,proto3,oneof"`
+}
+
+type Packet_AdminEditInventory struct {
+	AdminEditInventory *AdminEditInventory `protobuf:"bytes,83,opt,name=admin_edit_inventory,json=adminEditInventory,proto3,oneof"`
+}
+
+type Packet_AdminGrantXp struct {
+	AdminGrantXp *AdminGrantXp `protobuf:"bytes,84,opt,name=admin_grant_xp,json=adminGrantXp,proto3,oneof"`
+}
+
+type Packet_AdminTeleport struct {
+	AdminTeleport *AdminTeleport `protobuf:"bytes,85,opt,name=admin_teleport,json=adminTeleport,proto3,oneof"`
+}
+
+type Packet_AdminOpResponse struct {
+	AdminOpResponse *AdminOpResponse `protobuf:"bytes,86,opt,name=admin_op_response,json=adminOpResponse,proto3,oneof"`
+}
+
+type Packet_AdminListOnlinePlayers struct {
+	AdminListOnlinePlayers *AdminListOnlinePlayers `protobuf:"bytes,87,opt,name=admin_list_online_players,json=adminListOnlinePlayers,proto3,oneof"`
+}
+
+type Packet_AdminOnlinePlayers struct {
+	AdminOnlinePlayers *AdminOnlinePlayers `protobuf:"bytes,88,opt,name=admin_online_players,json=adminOnlinePlayers,proto3,oneof"`
+}
+
+type Packet_AdminListLevels struct {
+	AdminListLevels *AdminListLevels `protobuf:"bytes,89,opt,name=admin_list_levels,json=adminListLevels,proto3,oneof"`
+}
+
+type Packet_AdminLevelList struct {
+	AdminLevelList *AdminLevelList `protobuf:"bytes,90,opt,name=admin_level_list,json=adminLevelList,proto3,oneof"`
 }
 
-func (x *Packet) GetQuestInfo() *QuestInfo {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_QuestInfo); ok {
-			return x.QuestInfo
-		}
-	}
-	return nil
+type Packet_CommandList struct {
+	CommandList *CommandList `protobuf:"bytes,91,opt,name=command_list,json=commandList,proto3,oneof"`
 }
 
-func (x *Packet) GetDespawnGroundItem() *DespawnGroundItem {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_DespawnGroundItem); ok {
-			return x.DespawnGroundItem
-		}
-	}
-	return nil
+type Packet_Whisper struct {
+	Whisper *Whisper `protobuf:"bytes,92,opt,name=whisper,proto3,oneof"`
 }
 
-type isPacket_Msg interface {
-	isPacket_Msg()
+type Packet_FriendRequest struct {
+	FriendRequest *FriendRequest `protobuf:"bytes,93,opt,name=friend_request,json=friendRequest,proto3,oneof"`
 }
 
-type Packet_ClientId struct {
-	ClientId *ClientId `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3,oneof"`
+type Packet_AcceptFriendRequest struct {
+	AcceptFriendRequest *AcceptFriendRequest `protobuf:"bytes,94,opt,name=accept_friend_request,json=acceptFriendRequest,proto3,oneof"`
 }
 
-type Packet_LoginRequest struct {
-	LoginRequest *LoginRequest `protobuf:"bytes,3,opt,name=login_request,json=loginRequest,proto3,oneof"`
+type Packet_RemoveFriend struct {
+	RemoveFriend *RemoveFriend `protobuf:"bytes,95,opt,name=remove_friend,json=removeFriend,proto3,oneof"`
 }
 
-type Packet_LoginResponse struct {
-	LoginResponse *LoginResponse `protobuf:"bytes,4,opt,name=login_response,json=loginResponse,proto3,oneof"`
+type Packet_FriendList struct {
+	FriendList *FriendList `protobuf:"bytes,96,opt,name=friend_list,json=friendList,proto3,oneof"`
 }
 
-type Packet_RegisterRequest struct {
-	RegisterRequest *RegisterRequest `protobuf:"bytes,5,opt,name=register_request,json=registerRequest,proto3,oneof"`
+type Packet_FriendPresence struct {
+	FriendPresence *FriendPresence `protobuf:"bytes,97,opt,name=friend_presence,json=friendPresence,proto3,oneof"`
 }
 
-type Packet_RegisterResponse struct {
-	RegisterResponse *RegisterResponse `protobuf:"bytes,6,opt,name=register_response,json=registerResponse,proto3,oneof"`
+type Packet_IgnorePlayer struct {
+	IgnorePlayer *IgnorePlayer `protobuf:"bytes,98,opt,name=ignore_player,json=ignorePlayer,proto3,oneof"`
 }
 
-type Packet_Logout struct {
-	Logout *Logout `protobuf:"bytes,7,opt,name=logout,proto3,oneof"`
+type Packet_UnignorePlayer struct {
+	UnignorePlayer *UnignorePlayer `protobuf:"bytes,99,opt,name=unignore_player,json=unignorePlayer,proto3,oneof"`
 }
 
-type Packet_Chat struct {
-	Chat *Chat `protobuf:"bytes,8,opt,name=chat,proto3,oneof"`
+type Packet_IgnoreList struct {
+	IgnoreList *IgnoreList `protobuf:"bytes,100,opt,name=ignore_list,json=ignoreList,proto3,oneof"`
 }
 
-type Packet_Yell struct {
-	Yell *Yell `protobuf:"bytes,9,opt,name=yell,proto3,oneof"`
+type Packet_SocialResponse struct {
+	SocialResponse *SocialResponse `protobuf:"bytes,101,opt,name=social_response,json=socialResponse,proto3,oneof"`
 }
 
-type Packet_Actor struct {
-	Actor *Actor `protobuf:"bytes,10,opt,name=actor,proto3,oneof"`
+type Packet_JoinChannel struct {
+	JoinChannel *JoinChannel `protobuf:"bytes,102,opt,name=join_channel,json=joinChannel,proto3,oneof"`
 }
 
-type Packet_ActorMove struct {
-	ActorMove *ActorMove `protobuf:"bytes,11,opt,name=actor_move,json=actorMove,proto3,oneof"`
+type Packet_LeaveChannel struct {
+	LeaveChannel *LeaveChannel `protobuf:"bytes,103,opt,name=leave_channel,json=leaveChannel,proto3,oneof"`
 }
 
-type Packet_Motd struct {
-	Motd *Motd `protobuf:"bytes,12,opt,name=motd,proto3,oneof"`
+type Packet_ChannelMessage struct {
+	ChannelMessage *ChannelMessage `protobuf:"bytes,104,opt,name=channel_message,json=channelMessage,proto3,oneof"`
 }
 
-type Packet_Disconnect struct {
-	Disconnect *Disconnect `protobuf:"bytes,13,opt,name=disconnect,proto3,oneof"`
+type Packet_ChannelJoined struct {
+	ChannelJoined *ChannelJoined `protobuf:"bytes,105,opt,name=channel_joined,json=channelJoined,proto3,oneof"`
 }
 
-type Packet_AdminLoginGranted struct {
-	AdminLoginGranted *AdminLoginGranted `protobuf:"bytes,14,opt,name=admin_login_granted,json=adminLoginGranted,proto3,oneof"`
+type Packet_ChannelMemberUpdate struct {
+	ChannelMemberUpdate *ChannelMemberUpdate `protobuf:"bytes,106,opt,name=channel_member_update,json=channelMemberUpdate,proto3,oneof"`
 }
 
-type Packet_SqlQuery struct {
-	SqlQuery *SqlQuery `protobuf:"bytes,15,opt,name=sql_query,json=sqlQuery,proto3,oneof"`
+type Packet_ChannelModerate struct {
+	ChannelModerate *ChannelModerate `protobuf:"bytes,107,opt,name=channel_moderate,json=channelModerate,proto3,oneof"`
 }
 
-type Packet_SqlResponse struct {
-	SqlResponse *SqlResponse `protobuf:"bytes,16,opt,name=sql_response,json=sqlResponse,proto3,oneof"`
+type Packet_ChannelResponse struct {
+	ChannelResponse *ChannelResponse `protobuf:"bytes,108,opt,name=channel_response,json=channelResponse,proto3,oneof"`
 }
 
-type Packet_LevelUpload struct {
-	LevelUpload *LevelUpload `protobuf:"bytes,17,opt,name=level_upload,json=levelUpload,proto3,oneof"`
+type Packet_ListChannels struct {
+	ListChannels *ListChannels `protobuf:"bytes,109,opt,name=list_channels,json=listChannels,proto3,oneof"`
 }
 
-type Packet_LevelUploadResponse struct {
-	LevelUploadResponse *LevelUploadResponse `protobuf:"bytes,18,opt,name=level_upload_response,json=levelUploadResponse,proto3,oneof"`
+type Packet_ChannelList struct {
+	ChannelList *ChannelList `protobuf:"bytes,110,opt,name=channel_list,json=channelList,proto3,oneof"`
 }
 
-type Packet_LevelDownload struct {
-	LevelDownload *LevelDownload `protobuf:"bytes,19,opt,name=level_download,json=levelDownload,proto3,oneof"`
+type Packet_PartyInvite struct {
+	PartyInvite *PartyInvite `protobuf:"bytes,111,opt,name=party_invite,json=partyInvite,proto3,oneof"`
 }
 
-type Packet_AdminJoinGameRequest struct {
-	AdminJoinGameRequest *AdminJoinGameRequest `protobuf:"bytes,20,opt,name=admin_join_game_request,json=adminJoinGameRequest,proto3,oneof"`
+type Packet_PartyAccept struct {
+	PartyAccept *PartyAccept `protobuf:"bytes,112,opt,name=party_accept,json=partyAccept,proto3,oneof"`
 }
 
-type Packet_AdminJoinGameResponse struct {
-	AdminJoinGameResponse *AdminJoinGameResponse `protobuf:"bytes,21,opt,name=admin_join_game_response,json=adminJoinGameResponse,proto3,oneof"`
+type Packet_PartyLeave struct {
+	PartyLeave *PartyLeave `protobuf:"bytes,113,opt,name=party_leave,json=partyLeave,proto3,oneof"`
 }
 
-type Packet_ServerMessage struct {
-	ServerMessage *ServerMessage `protobuf:"bytes,22,opt,name=server_message,json=serverMessage,proto3,oneof"`
+type Packet_PartyKick struct {
+	PartyKick *PartyKick `protobuf:"bytes,114,opt,name=party_kick,json=partyKick,proto3,oneof"`
 }
 
-type Packet_PickupGroundItemRequest struct {
-	PickupGroundItemRequest *PickupGroundItemRequest `protobuf:"bytes,23,opt,name=pickup_ground_item_request,json=pickupGroundItemRequest,proto3,oneof"`
+type Packet_Party struct {
+	Party *Party `protobuf:"bytes,115,opt,name=party,proto3,oneof"`
 }
 
-type Packet_PickupGroundItemResponse struct {
-	PickupGroundItemResponse *PickupGroundItemResponse `protobuf:"bytes,24,opt,name=pickup_ground_item_response,json=pickupGroundItemResponse,proto3,oneof"`
+type Packet_PartyMemberPosition struct {
+	PartyMemberPosition *PartyMemberPosition `protobuf:"bytes,116,opt,name=party_member_position,json=partyMemberPosition,proto3,oneof"`
 }
 
-type Packet_Shrub struct {
-	Shrub *Shrub `protobuf:"bytes,25,opt,name=shrub,proto3,oneof"`
+type Packet_PartyQuestCredit struct {
+	PartyQuestCredit *PartyQuestCredit `protobuf:"bytes,117,opt,name=party_quest_credit,json=partyQuestCredit,proto3,oneof"`
 }
 
-type Packet_Ore struct {
-	Ore *Ore `protobuf:"bytes,26,opt,name=ore,proto3,oneof"`
+type Packet_PartyResponse struct {
+	PartyResponse *PartyResponse `protobuf:"bytes,118,opt,name=party_response,json=partyResponse,proto3,oneof"`
 }
 
-type Packet_Door struct {
-	Door *Door `protobuf:"bytes,27,opt,name=door,proto3,oneof"`
+type Packet_CreateGuild struct {
+	CreateGuild *CreateGuild `protobuf:"bytes,119,opt,name=create_guild,json=createGuild,proto3,oneof"`
 }
 
-type Packet_Item struct {
-	Item *Item `protobuf:"bytes,28,opt,name=Item,proto3,oneof"`
+type Packet_GuildInvite struct {
+	GuildInvite *GuildInvite `protobuf:"bytes,120,opt,name=guild_invite,json=guildInvite,proto3,oneof"`
 }
 
-type Packet_GroundItem struct {
-	GroundItem *GroundItem `protobuf:"bytes,29,opt,name=ground_item,json=groundItem,proto3,oneof"`
+type Packet_AcceptGuildInvite struct {
+	AcceptGuildInvite *AcceptGuildInvite `protobuf:"bytes,121,opt,name=accept_guild_invite,json=acceptGuildInvite,proto3,oneof"`
 }
 
-type Packet_ActorInventory struct {
-	ActorInventory *ActorInventory `protobuf:"bytes,30,opt,name=actor_inventory,json=actorInventory,proto3,oneof"`
+type Packet_LeaveGuild struct {
+	LeaveGuild *LeaveGuild `protobuf:"bytes,122,opt,name=leave_guild,json=leaveGuild,proto3,oneof"`
 }
 
-type Packet_DropItemRequest struct {
-	DropItemRequest *DropItemRequest `protobuf:"bytes,31,opt,name=drop_item_request,json=dropItemRequest,proto3,oneof"`
+type Packet_GuildKick struct {
+	GuildKick *GuildKick `protobuf:"bytes,123,opt,name=guild_kick,json=guildKick,proto3,oneof"`
 }
 
-type Packet_DropItemResponse struct {
-	DropItemResponse *DropItemResponse `protobuf:"bytes,32,opt,name=drop_item_response,json=dropItemResponse,proto3,oneof"`
+type Packet_GuildSetRank struct {
+	GuildSetRank *GuildSetRank `protobuf:"bytes,124,opt,name=guild_set_rank,json=guildSetRank,proto3,oneof"`
 }
 
-type Packet_ChopShrubRequest struct {
-	ChopShrubRequest *ChopShrubRequest `protobuf:"bytes,33,opt,name=chop_shrub_request,json=chopShrubRequest,proto3,oneof"`
+type Packet_GuildInfo struct {
+	GuildInfo *GuildInfo `protobuf:"bytes,125,opt,name=guild_info,json=guildInfo,proto3,oneof"`
 }
 
-type Packet_ChopShrubResponse struct {
-	ChopShrubResponse *ChopShrubResponse `protobuf:"bytes,34,opt,name=chop_shrub_response,json=chopShrubResponse,proto3,oneof"`
+type Packet_GuildBankDeposit struct {
+	GuildBankDeposit *GuildBankDeposit `protobuf:"bytes,126,opt,name=guild_bank_deposit,json=guildBankDeposit,proto3,oneof"`
 }
 
-type Packet_MineOreRequest struct {
-	MineOreRequest *MineOreRequest `protobuf:"bytes,35,opt,name=mine_ore_request,json=mineOreRequest,proto3,oneof"`
+type Packet_GuildBankWithdraw struct {
+	GuildBankWithdraw *GuildBankWithdraw `protobuf:"bytes,127,opt,name=guild_bank_withdraw,json=guildBankWithdraw,proto3,oneof"`
 }
 
-type Packet_MineOreResponse struct {
-	MineOreResponse *MineOreResponse `protobuf:"bytes,36,opt,name=mine_ore_response,json=mineOreResponse,proto3,oneof"`
+type Packet_RequestGuildBank struct {
+	RequestGuildBank *RequestGuildBank `protobuf:"bytes,128,opt,name=request_guild_bank,json=requestGuildBank,proto3,oneof"`
 }
 
-type Packet_ItemQuantity struct {
-	ItemQuantity *ItemQuantity `protobuf:"bytes,37,opt,name=item_quantity,json=itemQuantity,proto3,oneof"`
+type Packet_GuildBank struct {
+	GuildBank *GuildBank `protobuf:"bytes,129,opt,name=guild_bank,json=guildBank,proto3,oneof"`
 }
 
-type Packet_XpReward struct {
-	XpReward *XpReward `protobuf:"bytes,38,opt,name=xp_reward,json=xpReward,proto3,oneof"`
+type Packet_RequestGuildBankLog struct {
+	RequestGuildBankLog *RequestGuildBankLog `protobuf:"bytes,130,opt,name=request_guild_bank_log,json=requestGuildBankLog,proto3,oneof"`
 }
 
-type Packet_SkillsXp struct {
-	SkillsXp *SkillsXp `protobuf:"bytes,39,opt,name=skills_xp,json=skillsXp,proto3,oneof"`
+type Packet_GuildBankLog struct {
+	GuildBankLog *GuildBankLog `protobuf:"bytes,131,opt,name=guild_bank_log,json=guildBankLog,proto3,oneof"`
 }
 
-type Packet_InteractWithNpcResponse struct {
-	InteractWithNpcResponse *InteractWithNpcResponse `protobuf:"bytes,40,opt,name=interact_with_npc_response,json=interactWithNpcResponse,proto3,oneof"`
+type Packet_GuildResponse struct {
+	GuildResponse *GuildResponse `protobuf:"bytes,132,opt,name=guild_response,json=guildResponse,proto3,oneof"`
 }
 
-type Packet_InteractWithNpcRequest struct {
-	InteractWithNpcRequest *InteractWithNpcRequest `protobuf:"bytes,41,opt,name=interact_with_npc_request,json=interactWithNpcRequest,proto3,oneof"`
+type Packet_ReportPlayer struct {
+	ReportPlayer *ReportPlayer `protobuf:"bytes,133,opt,name=report_player,json=reportPlayer,proto3,oneof"`
 }
 
-type Packet_NpcDialogue struct {
-	NpcDialogue *NpcDialogue `protobuf:"bytes,42,opt,name=npc_dialogue,json=npcDialogue,proto3,oneof"`
+type Packet_ReportResponse struct {
+	ReportResponse *ReportResponse `protobuf:"bytes,134,opt,name=report_response,json=reportResponse,proto3,oneof"`
 }
 
-type Packet_BuyRequest struct {
-	BuyRequest *BuyRequest `protobuf:"bytes,43,opt,name=buy_request,json=buyRequest,proto3,oneof"`
+type Packet_AdminListReports struct {
+	AdminListReports *AdminListReports `protobuf:"bytes,135,opt,name=admin_list_reports,json=adminListReports,proto3,oneof"`
 }
 
-type Packet_BuyResponse struct {
-	BuyResponse *BuyResponse `protobuf:"bytes,44,opt,name=buy_response,json=buyResponse,proto3,oneof"`
+type Packet_AdminReportList struct {
+	AdminReportList *AdminReportList `protobuf:"bytes,136,opt,name=admin_report_list,json=adminReportList,proto3,oneof"`
 }
 
-type Packet_SellRequest struct {
-	SellRequest *SellRequest `protobuf:"bytes,45,opt,name=sell_request,json=sellRequest,proto3,oneof"`
+type Packet_AdminClaimReport struct {
+	AdminClaimReport *AdminClaimReport `protobuf:"bytes,137,opt,name=admin_claim_report,json=adminClaimReport,proto3,oneof"`
 }
 
-type Packet_SellResponse struct {
-	SellResponse *SellResponse `protobuf:"bytes,46,opt,name=sell_response,json=sellResponse,proto3,oneof"`
+type Packet_AdminResolveReport struct {
+	AdminResolveReport *AdminResolveReport `protobuf:"bytes,138,opt,name=admin_resolve_report,json=adminResolveReport,proto3,oneof"`
 }
 
-type Packet_LevelMetadata struct {
-	LevelMetadata *LevelMetadata `protobuf:"bytes,47,opt,name=level_metadata,json=levelMetadata,proto3,oneof"`
+type Packet_AdminReportResponse struct {
+	AdminReportResponse *AdminReportResponse `protobuf:"bytes,139,opt,name=admin_report_response,json=adminReportResponse,proto3,oneof"`
 }
 
-type Packet_QuestInfo struct {
-	QuestInfo *QuestInfo `protobuf:"bytes,48,opt,name=quest_info,json=questInfo,proto3,oneof"`
+type Packet_AdminSearchChatLog struct {
+	AdminSearchChatLog *AdminSearchChatLog `protobuf:"bytes,140,opt,name=admin_search_chat_log,json=adminSearchChatLog,proto3,oneof"`
 }
 
-type Packet_DespawnGroundItem struct {
-	DespawnGroundItem *DespawnGroundItem `protobuf:"bytes,49,opt,name=despawn_ground_item,json=despawnGroundItem,proto3,oneof"`
+type Packet_AdminChatLog struct {
+	AdminChatLog *AdminChatLog `protobuf:"bytes,141,opt,name=admin_chat_log,json=adminChatLog,proto3,oneof"`
+}
+
+type Packet_Emote struct {
+	Emote *Emote `protobuf:"bytes,142,opt,name=emote,proto3,oneof"`
+}
+
+type Packet_WorldTime struct {
+	WorldTime *WorldTime `protobuf:"bytes,143,opt,name=world_time,json=worldTime,proto3,oneof"`
 }
 
 func (*Packet_ClientId) isPacket_Msg() {}
@@ -3789,6 +11140,194 @@ func (*Packet_QuestInfo) isPacket_Msg() {}
 
 func (*Packet_DespawnGroundItem) isPacket_Msg() {}
 
+func (*Packet_Backpressure) isPacket_Msg() {}
+
+func (*Packet_PacketBatch) isPacket_Msg() {}
+
+func (*Packet_Hello) isPacket_Msg() {}
+
+func (*Packet_HelloResponse) isPacket_Msg() {}
+
+func (*Packet_ResumeSession) isPacket_Msg() {}
+
+func (*Packet_ResumeSessionResponse) isPacket_Msg() {}
+
+func (*Packet_ChangePassword) isPacket_Msg() {}
+
+func (*Packet_ChangePasswordResponse) isPacket_Msg() {}
+
+func (*Packet_AdminResetPassword) isPacket_Msg() {}
+
+func (*Packet_AdminResetPasswordResponse) isPacket_Msg() {}
+
+func (*Packet_CharacterList) isPacket_Msg() {}
+
+func (*Packet_CreateCharacterRequest) isPacket_Msg() {}
+
+func (*Packet_CreateCharacterResponse) isPacket_Msg() {}
+
+func (*Packet_DeleteCharacterRequest) isPacket_Msg() {}
+
+func (*Packet_DeleteCharacterResponse) isPacket_Msg() {}
+
+func (*Packet_RenameCharacterRequest) isPacket_Msg() {}
+
+func (*Packet_RenameCharacterResponse) isPacket_Msg() {}
+
+func (*Packet_SelectCharacterRequest) isPacket_Msg() {}
+
+func (*Packet_SelectCharacterResponse) isPacket_Msg() {}
+
+func (*Packet_ChangeAppearanceRequest) isPacket_Msg() {}
+
+func (*Packet_ChangeAppearanceResponse) isPacket_Msg() {}
+
+func (*Packet_AdminKick) isPacket_Msg() {}
+
+func (*Packet_AdminBan) isPacket_Msg() {}
+
+func (*Packet_AdminUnban) isPacket_Msg() {}
+
+func (*Packet_AdminMute) isPacket_Msg() {}
+
+func (*Packet_AdminUnmute) isPacket_Msg() {}
+
+func (*Packet_AdminModerationResponse) isPacket_Msg() {}
+
+func (*Packet_ModerationNotice) isPacket_Msg() {}
+
+func (*Packet_AdminGrantRole) isPacket_Msg() {}
+
+func (*Packet_AdminRevokeRole) isPacket_Msg() {}
+
+func (*Packet_AdminRoleResponse) isPacket_Msg() {}
+
+func (*Packet_AdminLookupPlayer) isPacket_Msg() {}
+
+func (*Packet_AdminPlayerInfo) isPacket_Msg() {}
+
+func (*Packet_AdminEditInventory) isPacket_Msg() {}
+
+func (*Packet_AdminGrantXp) isPacket_Msg() {}
+
+func (*Packet_AdminTeleport) isPacket_Msg() {}
+
+func (*Packet_AdminOpResponse) isPacket_Msg() {}
+
+func (*Packet_AdminListOnlinePlayers) isPacket_Msg() {}
+
+func (*Packet_AdminOnlinePlayers) isPacket_Msg() {}
+
+func (*Packet_AdminListLevels) isPacket_Msg() {}
+
+func (*Packet_AdminLevelList) isPacket_Msg() {}
+
+func (*Packet_CommandList) isPacket_Msg() {}
+
+func (*Packet_Whisper) isPacket_Msg() {}
+
+func (*Packet_FriendRequest) isPacket_Msg() {}
+
+func (*Packet_AcceptFriendRequest) isPacket_Msg() {}
+
+func (*Packet_RemoveFriend) isPacket_Msg() {}
+
+func (*Packet_FriendList) isPacket_Msg() {}
+
+func (*Packet_FriendPresence) isPacket_Msg() {}
+
+func (*Packet_IgnorePlayer) isPacket_Msg() {}
+
+func (*Packet_UnignorePlayer) isPacket_Msg() {}
+
+func (*Packet_IgnoreList) isPacket_Msg() {}
+
+func (*Packet_SocialResponse) isPacket_Msg() {}
+
+func (*Packet_JoinChannel) isPacket_Msg() {}
+
+func (*Packet_LeaveChannel) isPacket_Msg() {}
+
+func (*Packet_ChannelMessage) isPacket_Msg() {}
+
+func (*Packet_ChannelJoined) isPacket_Msg() {}
+
+func (*Packet_ChannelMemberUpdate) isPacket_Msg() {}
+
+func (*Packet_ChannelModerate) isPacket_Msg() {}
+
+func (*Packet_ChannelResponse) isPacket_Msg() {}
+
+func (*Packet_ListChannels) isPacket_Msg() {}
+
+func (*Packet_ChannelList) isPacket_Msg() {}
+
+func (*Packet_PartyInvite) isPacket_Msg() {}
+
+func (*Packet_PartyAccept) isPacket_Msg() {}
+
+func (*Packet_PartyLeave) isPacket_Msg() {}
+
+func (*Packet_PartyKick) isPacket_Msg() {}
+
+func (*Packet_Party) isPacket_Msg() {}
+
+func (*Packet_PartyMemberPosition) isPacket_Msg() {}
+
+func (*Packet_PartyQuestCredit) isPacket_Msg() {}
+
+func (*Packet_PartyResponse) isPacket_Msg() {}
+
+func (*Packet_CreateGuild) isPacket_Msg() {}
+
+func (*Packet_GuildInvite) isPacket_Msg() {}
+
+func (*Packet_AcceptGuildInvite) isPacket_Msg() {}
+
+func (*Packet_LeaveGuild) isPacket_Msg() {}
+
+func (*Packet_GuildKick) isPacket_Msg() {}
+
+func (*Packet_GuildSetRank) isPacket_Msg() {}
+
+func (*Packet_GuildInfo) isPacket_Msg() {}
+
+func (*Packet_GuildBankDeposit) isPacket_Msg() {}
+
+func (*Packet_GuildBankWithdraw) isPacket_Msg() {}
+
+func (*Packet_RequestGuildBank) isPacket_Msg() {}
+
+func (*Packet_GuildBank) isPacket_Msg() {}
+
+func (*Packet_RequestGuildBankLog) isPacket_Msg() {}
+
+func (*Packet_GuildBankLog) isPacket_Msg() {}
+
+func (*Packet_GuildResponse) isPacket_Msg() {}
+
+func (*Packet_ReportPlayer) isPacket_Msg() {}
+
+func (*Packet_ReportResponse) isPacket_Msg() {}
+
+func (*Packet_AdminListReports) isPacket_Msg() {}
+
+func (*Packet_AdminReportList) isPacket_Msg() {}
+
+func (*Packet_AdminClaimReport) isPacket_Msg() {}
+
+func (*Packet_AdminResolveReport) isPacket_Msg() {}
+
+func (*Packet_AdminReportResponse) isPacket_Msg() {}
+
+func (*Packet_AdminSearchChatLog) isPacket_Msg() {}
+
+func (*Packet_AdminChatLog) isPacket_Msg() {}
+
+func (*Packet_Emote) isPacket_Msg() {}
+
+func (*Packet_WorldTime) isPacket_Msg() {}
+
 var File_messages_proto protoreflect.FileDescriptor
 
 var file_messages_proto_rawDesc = []byte{
@@ -3804,43 +11343,65 @@ var file_messages_proto_rawDesc = []byte{
 	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
 	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x3f, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x64, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69,
 	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65,
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x0f, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x5f,
-	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
-	0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x26, 0x0a,
-	0x0f, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
-	0x67, 0x69, 0x6f, 0x6e, 0x59, 0x22, 0x42, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x0a, 0x06, 0x4c, 0x6f, 0x67,
-	0x6f, 0x75, 0x74, 0x22, 0x18, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d,
-	0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x50, 0x0a,
-	0x04, 0x59, 0x65, 0x6c, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x70,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x56, 0x69, 0x70, 0x12, 0x10, 0x0a,
-	0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22,
-	0xae, 0x01, 0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x70, 0x72,
-	0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x78, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0d, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x58, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69,
-	0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x70, 0x72, 0x69,
-	0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x59, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f,
-	0x76, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x56, 0x69, 0x70,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xcf,
+	0x01, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x70,
+	0x72, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x58, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x70, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x59, 0x12, 0x34, 0x0a, 0x0a, 0x61, 0x70,
+	0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x22, 0x42, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x22, 0x18,
+	0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x50, 0x0a, 0x04, 0x59, 0x65, 0x6c, 0x6c,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x69, 0x73, 0x56, 0x69, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x66, 0x0a, 0x0a, 0x41, 0x70,
+	0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x68, 0x61, 0x69, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x66, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x75, 0x74, 0x66, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x6c, 0x65,
+	0x74, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x61, 0x6c, 0x65, 0x74,
+	0x74, 0x65, 0x22, 0xcb, 0x02, 0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f,
+	0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x78, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x58, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73,
+	0x70, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x59, 0x12, 0x15, 0x0a, 0x06,
+	0x69, 0x73, 0x5f, 0x76, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73,
+	0x56, 0x69, 0x70, 0x12, 0x34, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x61,
+	0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x75, 0x69,
+	0x6c, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x75,
+	0x69, 0x6c, 0x64, 0x54, 0x61, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x6e, 0x69, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b,
 	0x22, 0x2b, 0x0a, 0x09, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x0e, 0x0a,
 	0x02, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x64, 0x78, 0x12, 0x0e, 0x0a,
 	0x02, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x64, 0x79, 0x22, 0x18, 0x0a,
@@ -3851,202 +11412,222 @@ var file_messages_proto_rawDesc = []byte{
 	0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x20, 0x0a, 0x06,
 	0x53, 0x71, 0x6c, 0x52, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x7d,
-	0x0a, 0x0b, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
-	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x53, 0x71, 0x6c, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x2c, 0x0a,
-	0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12,
-	0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a,
-	0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x4f, 0x0a, 0x05, 0x53,
-	0x68, 0x72, 0x75, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x4d, 0x0a, 0x03,
-	0x4f, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x9b,
+	0x01, 0x0a, 0x0b, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x53, 0x71, 0x6c, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0c,
+	0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x4f, 0x0a, 0x05, 0x53, 0x68,
+	0x72, 0x75, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
 	0x02, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01,
 	0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12,
 	0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0xd5, 0x01, 0x0a, 0x04,
-	0x44, 0x6f, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x1d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x67, 0x64, 0x5f, 0x72, 0x65, 0x73,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x64, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x47, 0x64, 0x52,
-	0x65, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x23, 0x0a, 0x0d, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x59,
-	0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c,
-	0x0a, 0x01, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x15, 0x0a, 0x06,
-	0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6b, 0x65,
-	0x79, 0x49, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x70,
-	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x25, 0x0a,
-	0x0e, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x48, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x08, 0x68,
-	0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x22, 0x93,
-	0x02, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x65,
-	0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x70,
-	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x26, 0x0a, 0x0f, 0x73,
-	0x70, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69,
-	0x6f, 0x6e, 0x59, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x70,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x09, 0x74, 0x6f,
-	0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74,
-	0x73, 0x5f, 0x76, 0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x67, 0x72, 0x61,
-	0x6e, 0x74, 0x73, 0x56, 0x69, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x61,
-	0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x61, 0x62, 0x6c, 0x65, 0x22, 0xc9, 0x01, 0x0a, 0x0a, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49,
-	0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x01, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x73,
-	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x65,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f,
-	0x64, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x53, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x64,
-	0x22, 0xb0, 0x02, 0x0a, 0x0b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x12, 0x1e, 0x0a, 0x0b, 0x67, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x64, 0x52, 0x65, 0x73, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x73, 0x63, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x73, 0x63, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x41, 0x0a,
-	0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x12, 0x25, 0x0a, 0x05, 0x73, 0x68, 0x72, 0x75, 0x62, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x68, 0x72, 0x75, 0x62,
-	0x52, 0x05, 0x73, 0x68, 0x72, 0x75, 0x62, 0x12, 0x1f, 0x0a, 0x03, 0x6f, 0x72, 0x65, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x4f, 0x72, 0x65, 0x52, 0x03, 0x6f, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x64, 0x6f, 0x6f, 0x72,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x44, 0x6f, 0x6f, 0x72, 0x52, 0x04, 0x64, 0x6f, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x0b,
-	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49,
-	0x74, 0x65, 0x6d, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x64,
-	0x62, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x64, 0x62, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x67,
-	0x64, 0x5f, 0x72, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x67, 0x64, 0x52, 0x65, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x0d, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x21, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6d, 0x73, 0x67, 0x22, 0x3f, 0x0a, 0x17, 0x50, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x47, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x24, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49,
-	0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x50, 0x69, 0x63, 0x6b, 0x75, 0x70,
-	0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x74, 0x65,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x67,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x0f, 0x44, 0x72, 0x6f,
-	0x70, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x04,
-	0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d,
-	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x82, 0x01, 0x0a,
-	0x10, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x4e, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x22, 0x53, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74,
-	0x6f, 0x72, 0x79, 0x12, 0x41, 0x0a, 0x10, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5f, 0x71, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x51, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x10, 0x43, 0x68, 0x6f, 0x70, 0x53, 0x68,
-	0x72, 0x75, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68,
-	0x72, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68,
-	0x72, 0x75, 0x62, 0x49, 0x64, 0x22, 0x5e, 0x0a, 0x11, 0x43, 0x68, 0x6f, 0x70, 0x53, 0x68, 0x72,
-	0x75, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68,
-	0x72, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68,
-	0x72, 0x75, 0x62, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x0e, 0x4d, 0x69, 0x6e, 0x65, 0x4f, 0x72, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x22, 0x58,
-	0x0a, 0x0f, 0x4d, 0x69, 0x6e, 0x65, 0x4f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x05, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x4d, 0x0a, 0x03, 0x4f,
+	0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78,
+	0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0xd5, 0x01, 0x0a, 0x04, 0x44,
+	0x6f, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x1d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x67, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x47, 0x64, 0x52, 0x65,
+	0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x12,
+	0x0c, 0x0a, 0x01, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a,
+	0x01, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x6b,
+	0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6b, 0x65, 0x79,
+	0x49, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x48, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x08, 0x68, 0x61,
+	0x72, 0x76, 0x65, 0x73, 0x74, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x22, 0x93, 0x02,
+	0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x5f, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x70, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x70,
+	0x72, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x59, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x09, 0x74, 0x6f, 0x6f,
+	0x6c, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x5f, 0x76, 0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x73, 0x56, 0x69, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x61,
+	0x62, 0x6c, 0x65, 0x22, 0xc9, 0x01, 0x0a, 0x0a, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x01, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x65, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64,
+	0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x64, 0x22,
+	0xb0, 0x02, 0x0a, 0x0b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x1e, 0x0a, 0x0b, 0x67, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x64, 0x52, 0x65, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x73, 0x63, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x74, 0x73, 0x63, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x41, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x25, 0x0a, 0x05, 0x73, 0x68, 0x72, 0x75, 0x62, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x68, 0x72, 0x75, 0x62, 0x52,
+	0x05, 0x73, 0x68, 0x72, 0x75, 0x62, 0x12, 0x1f, 0x0a, 0x03, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f,
+	0x72, 0x65, 0x52, 0x03, 0x6f, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x64, 0x6f, 0x6f, 0x72, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x44, 0x6f, 0x6f, 0x72, 0x52, 0x04, 0x64, 0x6f, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x0b, 0x67,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74,
+	0x65, 0x6d, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x64, 0x62,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x64, 0x62, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x67, 0x64,
+	0x5f, 0x72, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x67, 0x64, 0x52, 0x65, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x0d, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x21, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x73, 0x67, 0x22, 0x3f, 0x0a, 0x17, 0x50, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x47, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74,
+	0x65, 0x6d, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x50, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x47,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x35, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x67, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x08, 0x58, 0x70, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x70,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x78, 0x70, 0x22, 0x3d, 0x0a, 0x08, 0x53, 0x6b,
-	0x69, 0x6c, 0x6c, 0x73, 0x58, 0x70, 0x12, 0x31, 0x0a, 0x0a, 0x78, 0x70, 0x5f, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x58, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x09,
-	0x78, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x33, 0x0a, 0x16, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x70, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x64,
-	0x0a, 0x17, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x70,
-	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x0f, 0x44, 0x72, 0x6f, 0x70,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12,
+	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x82, 0x01, 0x0a, 0x10,
+	0x44, 0x72, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x4e, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x22, 0x53, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x41, 0x0a, 0x10, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5f, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x0f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x10, 0x43, 0x68, 0x6f, 0x70, 0x53, 0x68, 0x72,
+	0x75, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x72,
+	0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x72,
+	0x75, 0x62, 0x49, 0x64, 0x22, 0x5e, 0x0a, 0x11, 0x43, 0x68, 0x6f, 0x70, 0x53, 0x68, 0x72, 0x75,
+	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x72,
+	0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x72,
+	0x75, 0x62, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x0b, 0x4e, 0x70, 0x63, 0x44, 0x69, 0x61, 0x6c, 0x6f,
-	0x67, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x22, 0x7b, 0x0a, 0x0a, 0x42, 0x75,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x73, 0x68, 0x6f, 0x70,
-	0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72,
-	0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x9f, 0x01, 0x0a, 0x0b, 0x42, 0x75, 0x79, 0x52,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x0e, 0x4d, 0x69, 0x6e, 0x65, 0x4f, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x22, 0x58, 0x0a,
+	0x0f, 0x4d, 0x69, 0x6e, 0x65, 0x4f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x08, 0x58, 0x70, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x78, 0x70, 0x22, 0x3d, 0x0a, 0x08, 0x53, 0x6b, 0x69,
+	0x6c, 0x6c, 0x73, 0x58, 0x70, 0x12, 0x31, 0x0a, 0x0a, 0x78, 0x70, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x58, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x09, 0x78,
+	0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x33, 0x0a, 0x16, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x70, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x64, 0x0a,
+	0x17, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x70, 0x63,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x0b, 0x4e, 0x70, 0x63, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67,
+	0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x22, 0x7b, 0x0a, 0x0a, 0x42, 0x75, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x73, 0x68, 0x6f, 0x70, 0x5f,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x9f, 0x01, 0x0a, 0x0b, 0x42, 0x75, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x73, 0x68, 0x6f, 0x70, 0x5f, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x63,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x71, 0x74,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x07, 0x69, 0x74, 0x65, 0x6d, 0x51, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x0a, 0x0b, 0x53, 0x65, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x73, 0x68, 0x6f, 0x70, 0x5f,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xa0, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x6c, 0x6c, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x73, 0x68, 0x6f, 0x70, 0x5f,
 	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41,
@@ -4056,251 +11637,1238 @@ var file_messages_proto_rawDesc = []byte{
 	0x52, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x51, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65,
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x0a, 0x0b, 0x53, 0x65, 0x6c,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x73, 0x68, 0x6f, 0x70,
-	0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72,
-	0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xa0, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x6c, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x73, 0x68, 0x6f, 0x70,
-	0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72,
-	0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f,
-	0x71, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x51, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x0d, 0x4c, 0x65,
-	0x76, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0b, 0x67,
-	0x64, 0x5f, 0x72, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x67, 0x64, 0x52, 0x65, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0b, 0x64,
-	0x62, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x64, 0x62, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x89, 0x02, 0x0a, 0x09,
-	0x51, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a,
-	0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x4e, 0x70, 0x63, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x52, 0x0d, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x12, 0x33, 0x0a, 0x0d, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74,
-	0x65, 0x6d, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d,
-	0x12, 0x44, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x69,
-	0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x70, 0x63, 0x44, 0x69, 0x61, 0x6c, 0x6f,
-	0x67, 0x75, 0x65, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x44, 0x69,
-	0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x54, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x70, 0x61,
-	0x77, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x24, 0x0a, 0x0e,
-	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d,
-	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x64, 0x22, 0xa5, 0x18,
-	0x0a, 0x06, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x48, 0x00, 0x52, 0x08,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x69,
-	0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
-	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x6f, 0x67, 0x69,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
-	0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x49, 0x0a, 0x11, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x10, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06,
-	0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x48, 0x00,
-	0x52, 0x06, 0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x24,
-	0x0a, 0x04, 0x79, 0x65, 0x6c, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x59, 0x65, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x04,
-	0x79, 0x65, 0x6c, 0x6c, 0x12, 0x27, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
-	0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x34, 0x0a,
-	0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x74,
-	0x6f, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x48, 0x00, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4d,
-	0x6f, 0x76, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x6d, 0x6f, 0x74, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x6f, 0x74,
-	0x64, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x6f, 0x74, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x64, 0x69, 0x73,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x12, 0x4d, 0x0a, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
-	0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x11, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64,
-	0x12, 0x31, 0x0a, 0x09, 0x73, 0x71, 0x6c, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53,
-	0x71, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x08, 0x73, 0x71, 0x6c, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x71, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x48, 0x00, 0x52, 0x0b, 0x73, 0x71, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3a, 0x0a, 0x0c, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x18,
-	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0b,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x53, 0x0a, 0x15, 0x6c,
-	0x65, 0x76, 0x65, 0x6c, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x13, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x12, 0x57, 0x0a, 0x17, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6a, 0x6f, 0x69, 0x6e,
-	0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x14, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x69, 0x6e,
-	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x18, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f,
-	0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
-	0x52, 0x15, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x60, 0x0a, 0x1a, 0x70, 0x69, 0x63,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x0d, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0b, 0x67, 0x64,
+	0x5f, 0x72, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x67, 0x64, 0x52, 0x65, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0b, 0x64, 0x62,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x64, 0x62, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x89, 0x02, 0x0a, 0x09, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0e,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x4e, 0x70, 0x63, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x52, 0x0d, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x12, 0x33, 0x0a, 0x0d, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x44, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x61,
+	0x6c, 0x6f, 0x67, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x70, 0x63, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67,
+	0x75, 0x65, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x44, 0x69, 0x61,
+	0x6c, 0x6f, 0x67, 0x75, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x54, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x67,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x5d, 0x0a, 0x0c,
+	0x42, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4d, 0x73, 0x22, 0x56, 0x0a, 0x05, 0x48,
+	0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x0d, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12,
+	0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x22, 0x34, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6c, 0x0a,
+	0x15, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x72, 0x0a, 0x0e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6c, 0x64,
+	0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
+	0x48, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x12, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7b, 0x0a, 0x1a, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x65, 0x6d,
+	0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xd4, 0x01, 0x0a, 0x0d, 0x43, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26,
+	0x0a, 0x0f, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65,
+	0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x59, 0x12, 0x19,
+	0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x61, 0x70, 0x70,
+	0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0x6f, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x37, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x63,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73,
+	0x22, 0xb2, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x26, 0x0a, 0x0f, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x5f, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x70, 0x72, 0x69, 0x74,
+	0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x73, 0x70, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x59, 0x12,
+	0x34, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x61,
+	0x72, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x49, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3b, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x49, 0x0a,
+	0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x16, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x17, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x16, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x49, 0x0a, 0x17, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7b, 0x0a, 0x17,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x77, 0x61, 0x72, 0x64, 0x72,
+	0x6f, 0x62, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x77, 0x61, 0x72, 0x64, 0x72, 0x6f, 0x62, 0x65, 0x41, 0x63, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x61,
+	0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x4a, 0x0a, 0x18, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x41, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x09, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4b, 0x69,
+	0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x69, 0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42,
+	0x61, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x22, 0x28, 0x0a, 0x0a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6a, 0x0a, 0x09, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x75, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x29, 0x0a, 0x0b, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x7d, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x61, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x22, 0x40, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x41, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x11, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f,
+	0x6f, 0x6b, 0x75, 0x70, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xef,
+	0x01, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x61,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x61, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x75, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x75, 0x74, 0x65,
+	0x22, 0x74, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x5b, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x58, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x6b,
+	0x69, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x02, 0x78, 0x70, 0x22, 0x6d, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6c, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x01, 0x79, 0x22, 0x78, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4f, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x18, 0x0a, 0x16,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x59, 0x0a, 0x0c, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01,
+	0x79, 0x22, 0x46, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x22, 0x55, 0x0a, 0x09,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x67, 0x64, 0x5f,
+	0x72, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x67, 0x64, 0x52, 0x65, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x22, 0x3d, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x73, 0x22, 0x6a, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x41, 0x72, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9d,
+	0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x41, 0x72, 0x67, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x40,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x31, 0x0a,
+	0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x22, 0x63, 0x0a, 0x07, 0x57, 0x68, 0x69, 0x73, 0x70, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x23, 0x0a, 0x0d, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x29, 0x0a, 0x13, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6a, 0x0a, 0x06, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x22, 0x38, 0x0a, 0x0a, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x22,
+	0x3c, 0x0a, 0x0e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x22, 0x0a,
+	0x0c, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x24, 0x0a, 0x0e, 0x55, 0x6e, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x22, 0x0a, 0x0a, 0x49, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x0e, 0x53,
+	0x6f, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x21, 0x0a, 0x0b, 0x4a, 0x6f, 0x69,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x22, 0x0a, 0x0c,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x76, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
+	0x17, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x0d, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x07,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x22, 0x5b, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x22, 0x57, 0x0a,
+	0x0f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x0e, 0x0a, 0x0c, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x67, 0x0a, 0x0b, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6a, 0x6f,
+	0x69, 0x6e, 0x65, 0x64, 0x22, 0x40, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x21, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x21, 0x0a, 0x0b, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x0c, 0x0a, 0x0a,
+	0x50, 0x61, 0x72, 0x74, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x22, 0x1f, 0x0a, 0x09, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x4b, 0x69, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x0b, 0x50,
+	0x61, 0x72, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x52, 0x0a, 0x05, 0x50, 0x61, 0x72, 0x74, 0x79, 0x12,
+	0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x60, 0x0a, 0x13, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x64,
+	0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c,
+	0x0a, 0x01, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x51, 0x0a, 0x10,
+	0x50, 0x61, 0x72, 0x74, 0x79, 0x51, 0x75, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x6b, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x0b,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x22, 0x40, 0x0a, 0x0b, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x32, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x75,
+	0x69, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x0c, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x22, 0x1f, 0x0a, 0x09, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4b, 0x69,
+	0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x36, 0x0a, 0x0c, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x53,
+	0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x4d,
+	0x0a, 0x0b, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xaa, 0x01,
+	0x0a, 0x09, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x10, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x22,
+	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x53,
+	0x0a, 0x11, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x22, 0x12, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x22, 0x4e, 0x0a, 0x09, 0x47, 0x75, 0x69, 0x6c, 0x64,
+	0x42, 0x61, 0x6e, 0x6b, 0x12, 0x41, 0x0a, 0x10, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5f, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x4c, 0x6f, 0x67, 0x22, 0x86,
+	0x01, 0x0a, 0x11, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x4c, 0x6f, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x45, 0x0a, 0x0c, 0x47, 0x75, 0x69, 0x6c, 0x64,
+	0x42, 0x61, 0x6e, 0x6b, 0x4c, 0x6f, 0x67, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x4c, 0x6f, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x6b,
+	0x0a, 0x0d, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x0a, 0x0c, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3d, 0x0a,
+	0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x22, 0x80, 0x01, 0x0a,
+	0x0e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x22,
+	0xf2, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x04,
+	0x63, 0x68, 0x61, 0x74, 0x22, 0x41, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x2f, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22,
+	0x72, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xc3, 0x01, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x74,
+	0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x61, 0x77, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x77, 0x4d, 0x73,
+	0x67, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x6d, 0x73,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x65,
+	0x64, 0x4d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x22, 0x8e, 0x01,
+	0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x2e,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4c,
+	0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x63,
+	0x0a, 0x09, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x61, 0x79, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x10, 0x64, 0x61, 0x79, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0x1b, 0x0a, 0x05, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x39, 0x0a, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x2a, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0xe2, 0x4c, 0x0a, 0x06,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x49, 0x0a, 0x11, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x6c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x48, 0x00, 0x52, 0x06,
+	0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x43, 0x68, 0x61, 0x74, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x04,
+	0x79, 0x65, 0x6c, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x59, 0x65, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x79, 0x65,
+	0x6c, 0x6c, 0x12, 0x27, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x0a, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72,
+	0x4d, 0x6f, 0x76, 0x65, 0x48, 0x00, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4d, 0x6f, 0x76,
+	0x65, 0x12, 0x24, 0x0a, 0x04, 0x6d, 0x6f, 0x74, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x6f, 0x74, 0x64, 0x48,
+	0x00, 0x52, 0x04, 0x6d, 0x6f, 0x74, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12,
+	0x4d, 0x0a, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x11, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x31,
+	0x0a, 0x09, 0x73, 0x71, 0x6c, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x71, 0x6c,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x08, 0x73, 0x71, 0x6c, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x71, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x0b, 0x73, 0x71, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x0c, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x53, 0x0a, 0x15, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x13, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x0e, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x48,
+	0x00, 0x52, 0x0d, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x57, 0x0a, 0x17, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x14, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x18, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x69, 0x6e,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x15,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x60, 0x0a, 0x1a, 0x70, 0x69, 0x63, 0x6b, 0x75,
+	0x70, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x47, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x17, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x1b, 0x70, 0x69, 0x63,
 	0x6b, 0x75, 0x70, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x47,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x48, 0x00, 0x52, 0x17, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x1b, 0x70,
-	0x69, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x74, 0x65,
-	0x6d, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x69, 0x63, 0x6b,
-	0x75, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x18, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x47, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x27, 0x0a, 0x05, 0x73, 0x68, 0x72, 0x75, 0x62, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x68, 0x72, 0x75, 0x62,
-	0x48, 0x00, 0x52, 0x05, 0x73, 0x68, 0x72, 0x75, 0x62, 0x12, 0x21, 0x0a, 0x03, 0x6f, 0x72, 0x65,
-	0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x4f, 0x72, 0x65, 0x48, 0x00, 0x52, 0x03, 0x6f, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x04,
-	0x64, 0x6f, 0x6f, 0x72, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x04, 0x64, 0x6f,
-	0x6f, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d,
-	0x48, 0x00, 0x52, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x37, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75,
-	0x6e, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49,
-	0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x43, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x6e,
-	0x74, 0x6f, 0x72, 0x79, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x76, 0x65, 0x6e,
-	0x74, 0x6f, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x76,
-	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x69,
-	0x74, 0x65, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x72, 0x6f,
-	0x70, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f,
-	0x64, 0x72, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x4a, 0x0a, 0x12, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x10, 0x64, 0x72, 0x6f, 0x70, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x63,
-	0x68, 0x6f, 0x70, 0x5f, 0x73, 0x68, 0x72, 0x75, 0x62, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x43, 0x68, 0x6f, 0x70, 0x53, 0x68, 0x72, 0x75, 0x62, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x63, 0x68, 0x6f, 0x70, 0x53, 0x68, 0x72, 0x75, 0x62,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x13, 0x63, 0x68, 0x6f, 0x70, 0x5f,
-	0x73, 0x68, 0x72, 0x75, 0x62, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x22,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x43, 0x68, 0x6f, 0x70, 0x53, 0x68, 0x72, 0x75, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x48, 0x00, 0x52, 0x11, 0x63, 0x68, 0x6f, 0x70, 0x53, 0x68, 0x72, 0x75, 0x62, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x65, 0x5f, 0x6f,
-	0x72, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x6e, 0x65,
-	0x4f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x69,
-	0x6e, 0x65, 0x4f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x11,
-	0x6d, 0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x4f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x65, 0x4f, 0x72, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x71, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x09, 0x78, 0x70, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x58, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x00, 0x52, 0x08, 0x78,
-	0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
-	0x73, 0x5f, 0x78, 0x70, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x58, 0x70, 0x48, 0x00,
-	0x52, 0x08, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x58, 0x70, 0x12, 0x60, 0x0a, 0x1a, 0x69, 0x6e,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x69, 0x63, 0x6b, 0x75, 0x70,
+	0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x18, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x47, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
+	0x0a, 0x05, 0x73, 0x68, 0x72, 0x75, 0x62, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x68, 0x72, 0x75, 0x62, 0x48, 0x00,
+	0x52, 0x05, 0x73, 0x68, 0x72, 0x75, 0x62, 0x12, 0x21, 0x0a, 0x03, 0x6f, 0x72, 0x65, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x4f, 0x72, 0x65, 0x48, 0x00, 0x52, 0x03, 0x6f, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x6f,
+	0x6f, 0x72, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x04, 0x64, 0x6f, 0x6f, 0x72,
+	0x12, 0x24, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00,
+	0x52, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x37, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65,
+	0x6d, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x43, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x76, 0x65, 0x6e,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x64, 0x72,
+	0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a,
+	0x12, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x10, 0x64, 0x72, 0x6f, 0x70, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x63, 0x68, 0x6f,
+	0x70, 0x5f, 0x73, 0x68, 0x72, 0x75, 0x62, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x43, 0x68, 0x6f, 0x70, 0x53, 0x68, 0x72, 0x75, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x10, 0x63, 0x68, 0x6f, 0x70, 0x53, 0x68, 0x72, 0x75, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x13, 0x63, 0x68, 0x6f, 0x70, 0x5f, 0x73, 0x68,
+	0x72, 0x75, 0x62, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x22, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68,
+	0x6f, 0x70, 0x53, 0x68, 0x72, 0x75, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x11, 0x63, 0x68, 0x6f, 0x70, 0x53, 0x68, 0x72, 0x75, 0x62, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x72, 0x65,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x4f, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x65,
+	0x4f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x11, 0x6d, 0x69,
+	0x6e, 0x65, 0x5f, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x4f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x65, 0x4f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x31, 0x0a, 0x09, 0x78, 0x70, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
+	0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x58, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x00, 0x52, 0x08, 0x78, 0x70, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x5f,
+	0x78, 0x70, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x58, 0x70, 0x48, 0x00, 0x52, 0x08,
+	0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x58, 0x70, 0x12, 0x60, 0x0a, 0x1a, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6e, 0x70, 0x63, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x57, 0x69, 0x74, 0x68, 0x4e, 0x70, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x17, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x4e,
+	0x70, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x19, 0x69, 0x6e,
 	0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6e, 0x70, 0x63, 0x5f,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61,
-	0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x70, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x48, 0x00, 0x52, 0x17, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74,
-	0x68, 0x4e, 0x70, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x19,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6e, 0x70,
-	0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x70, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x48, 0x00, 0x52, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74,
-	0x68, 0x4e, 0x70, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x6e,
-	0x70, 0x63, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x18, 0x2a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x70, 0x63,
-	0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6e, 0x70, 0x63, 0x44,
-	0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x62, 0x75, 0x79, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x42, 0x75, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x75, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x3a, 0x0a, 0x0c, 0x62, 0x75, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x42, 0x75, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
-	0x0b, 0x62, 0x75, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c,
-	0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x2d, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x65,
-	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x65, 0x6c,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x73, 0x65, 0x6c, 0x6c,
-	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x65, 0x6c, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x0a, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x4d, 0x0a, 0x13, 0x64, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x6e,
-	0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x47,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x11, 0x64, 0x65, 0x73,
-	0x70, 0x61, 0x77, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x05,
-	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x2a, 0x2b, 0x0a, 0x0b, 0x48, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x09,
-	0x0a, 0x05, 0x53, 0x48, 0x52, 0x55, 0x42, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x52, 0x45,
-	0x10, 0x02, 0x42, 0x0d, 0x5a, 0x0b, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
+	0x74, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x70, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x4e,
+	0x70, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x6e, 0x70, 0x63,
+	0x5f, 0x64, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x70, 0x63, 0x44, 0x69,
+	0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6e, 0x70, 0x63, 0x44, 0x69, 0x61,
+	0x6c, 0x6f, 0x67, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x62, 0x75, 0x79, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x42, 0x75, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x0a, 0x62, 0x75, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a,
+	0x0a, 0x0c, 0x62, 0x75, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x2c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x42, 0x75, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x62,
+	0x75, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x65,
+	0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x48, 0x00, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4d, 0x0a,
+	0x13, 0x64, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x47, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x11, 0x64, 0x65, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3c, 0x0a, 0x0c,
+	0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x32, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x42, 0x61,
+	0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x61,
+	0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x27, 0x0a, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x18,
+	0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x12,
+	0x40, 0x0a, 0x0e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x0d, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x40, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x59, 0x0a, 0x17, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x37,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x15, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
+	0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x38, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x5c, 0x0a, 0x18, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x39, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x50, 0x0a, 0x14, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x00, 0x52,
+	0x12, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x69, 0x0a, 0x1d, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x1a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x0e, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x3c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x5c, 0x0a, 0x18, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x3d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f,
+	0x0a, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x3e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5c, 0x0a, 0x18, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x3f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a,
+	0x19, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x40, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x17, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c,
+	0x0a, 0x18, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x41, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x19,
+	0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x42, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x17, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a,
+	0x18, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x43, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x16, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x19, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x44, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x17, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x19,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x45, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x41, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x17, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x70, 0x70, 0x65,
+	0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x62, 0x0a,
+	0x1a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x46, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x41, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x18, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41,
+	0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6b, 0x69, 0x63, 0x6b, 0x18,
+	0x47, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4b, 0x69, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x4b, 0x69, 0x63, 0x6b, 0x12, 0x31, 0x0a, 0x09, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x62, 0x61, 0x6e, 0x18, 0x48, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6e, 0x48, 0x00,
+	0x52, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6e, 0x12, 0x37, 0x0a, 0x0b, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x6e, 0x62, 0x61, 0x6e, 0x18, 0x49, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x55, 0x6e, 0x62, 0x61, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e,
+	0x62, 0x61, 0x6e, 0x12, 0x34, 0x0a, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x75, 0x74,
+	0x65, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x09,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x75, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x75, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x55,
+	0x6e, 0x6d, 0x75, 0x74, 0x65, 0x12, 0x5f, 0x0a, 0x19, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x17, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x18, 0x4d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52,
+	0x10, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63,
+	0x65, 0x12, 0x44, 0x0a, 0x10, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x4e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x4f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x48, 0x00, 0x52,
+	0x0f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x4d, 0x0a, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x50, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x11, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4d, 0x0a, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x5f,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x51, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x48, 0x00, 0x52, 0x11, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x47,
+	0x0a, 0x11, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x52, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x50, 0x0a, 0x14, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x18,
+	0x53, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x48, 0x00, 0x52, 0x12, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x45, 0x64, 0x69, 0x74,
+	0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x78, 0x70, 0x18, 0x54, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x58, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x58, 0x70, 0x12, 0x40, 0x0a, 0x0e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x74, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x55, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x47, 0x0a, 0x11, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x56, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x0f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x4f, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x19, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x57, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x16, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x12, 0x50, 0x0a, 0x14, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x58, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x48,
+	0x00, 0x52, 0x12, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x11, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x59, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x44,
+	0x0a, 0x10, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x2d, 0x0a, 0x07, 0x77, 0x68, 0x69, 0x73, 0x70, 0x65, 0x72, 0x18, 0x5c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x57, 0x68, 0x69,
+	0x73, 0x70, 0x65, 0x72, 0x48, 0x00, 0x52, 0x07, 0x77, 0x68, 0x69, 0x73, 0x70, 0x65, 0x72, 0x12,
+	0x40, 0x0a, 0x0e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x5d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x53, 0x0a, 0x15, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x5e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x13, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x5f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x18, 0x5f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x37, 0x0a, 0x0b, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x60, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x0a, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x43,
+	0x0a, 0x0f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x61, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x48, 0x00, 0x52, 0x0e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x18, 0x62, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x43, 0x0a, 0x0f, 0x75, 0x6e, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x75, 0x6e, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x43, 0x0a, 0x0f, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x48, 0x00, 0x52, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x3d, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x48, 0x00, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x43, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x15, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x6a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x10,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x6b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x6d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x6c,
+	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x6e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x18, 0x70, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x48, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12,
+	0x37, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x18, 0x71,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x5f, 0x6b, 0x69, 0x63, 0x6b, 0x18, 0x72, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4b, 0x69, 0x63,
+	0x6b, 0x48, 0x00, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x79, 0x4b, 0x69, 0x63, 0x6b, 0x12, 0x27,
+	0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x73, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x48, 0x00,
+	0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x53, 0x0a, 0x15, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x74, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x13, 0x70, 0x61, 0x72, 0x74, 0x79, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x12,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x18, 0x75, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x51, 0x75, 0x65, 0x73, 0x74, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x48, 0x00, 0x52, 0x10, 0x70, 0x61, 0x72, 0x74, 0x79, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x76, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x77, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x78, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x12, 0x4d, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x67, 0x75, 0x69,
+	0x6c, 0x64, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x79, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x48, 0x00, 0x52, 0x11,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x12, 0x37, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64,
+	0x18, 0x7a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x0a,
+	0x6c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x67, 0x75,
+	0x69, 0x6c, 0x64, 0x5f, 0x6b, 0x69, 0x63, 0x6b, 0x18, 0x7b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4b,
+	0x69, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x4b, 0x69, 0x63, 0x6b,
+	0x12, 0x3e, 0x0a, 0x0e, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x61,
+	0x6e, 0x6b, 0x18, 0x7c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b,
+	0x48, 0x00, 0x52, 0x0c, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b,
+	0x12, 0x34, 0x0a, 0x0a, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x7d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x67, 0x75, 0x69,
+	0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4a, 0x0a, 0x12, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f,
+	0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x7e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x48, 0x00,
+	0x52, 0x10, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x12, 0x4d, 0x0a, 0x13, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x6b,
+	0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x7f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64,
+	0x42, 0x61, 0x6e, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x48, 0x00, 0x52, 0x11,
+	0x67, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x12, 0x4b, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x75, 0x69,
+	0x6c, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x18, 0x80, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x10, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x12, 0x35,
+	0x0a, 0x0a, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x18, 0x81, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47,
+	0x75, 0x69, 0x6c, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x42, 0x61, 0x6e, 0x6b, 0x12, 0x55, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x6c, 0x6f, 0x67, 0x18,
+	0x82, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x61,
+	0x6e, 0x6b, 0x4c, 0x6f, 0x67, 0x48, 0x00, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x4c, 0x6f, 0x67, 0x12, 0x3f, 0x0a, 0x0e,
+	0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x83,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x4c, 0x6f, 0x67, 0x48, 0x00, 0x52,
+	0x0c, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x4c, 0x6f, 0x67, 0x12, 0x41, 0x0a,
+	0x0e, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x84, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x0d, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x18, 0x85, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x44, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x86, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x12, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x87, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x48,
+	0x00, 0x52, 0x10, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x11, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x88, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4b, 0x0a,
+	0x12, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x89, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x10, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x51, 0x0a, 0x14, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x8a, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x12, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x54, 0x0a,
+	0x15, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x8b, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x13,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x15, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x8c, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x6f,
+	0x67, 0x48, 0x00, 0x52, 0x12, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x43, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x3f, 0x0a, 0x0e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x8d, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x18, 0x8e, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x8f, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x09,
+	0x77, 0x6f, 0x72, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x2a, 0x2b, 0x0a, 0x0b, 0x48, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x48, 0x52,
+	0x55, 0x42, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x52, 0x45, 0x10, 0x02, 0x42, 0x0d, 0x5a,
+	0x0b, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4316,152 +12884,400 @@ func file_messages_proto_rawDescGZIP() []byte {
 }
 
 var file_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
+var file_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 161)
 var file_messages_proto_goTypes = []any{
-	(Harvestable)(0),                 // 0: messages.Harvestable
-	(*Response)(nil),                 // 1: messages.Response
-	(*ClientId)(nil),                 // 2: messages.ClientId
-	(*LoginRequest)(nil),             // 3: messages.LoginRequest
-	(*LoginResponse)(nil),            // 4: messages.LoginResponse
-	(*RegisterRequest)(nil),          // 5: messages.RegisterRequest
-	(*RegisterResponse)(nil),         // 6: messages.RegisterResponse
-	(*Logout)(nil),                   // 7: messages.Logout
-	(*Chat)(nil),                     // 8: messages.Chat
-	(*Yell)(nil),                     // 9: messages.Yell
-	(*Actor)(nil),                    // 10: messages.Actor
-	(*ActorMove)(nil),                // 11: messages.ActorMove
-	(*Motd)(nil),                     // 12: messages.Motd
-	(*Disconnect)(nil),               // 13: messages.Disconnect
-	(*AdminLoginGranted)(nil),        // 14: messages.AdminLoginGranted
-	(*SqlQuery)(nil),                 // 15: messages.SqlQuery
-	(*SqlRow)(nil),                   // 16: messages.SqlRow
-	(*SqlResponse)(nil),              // 17: messages.SqlResponse
-	(*CollisionPoint)(nil),           // 18: messages.CollisionPoint
-	(*Shrub)(nil),                    // 19: messages.Shrub
-	(*Ore)(nil),                      // 20: messages.Ore
-	(*Door)(nil),                     // 21: messages.Door
-	(*ToolProps)(nil),                // 22: messages.ToolProps
-	(*Item)(nil),                     // 23: messages.Item
-	(*GroundItem)(nil),               // 24: messages.GroundItem
-	(*LevelUpload)(nil),              // 25: messages.LevelUpload
-	(*LevelUploadResponse)(nil),      // 26: messages.LevelUploadResponse
-	(*LevelDownload)(nil),            // 27: messages.LevelDownload
-	(*AdminJoinGameRequest)(nil),     // 28: messages.AdminJoinGameRequest
-	(*AdminJoinGameResponse)(nil),    // 29: messages.AdminJoinGameResponse
-	(*ServerMessage)(nil),            // 30: messages.ServerMessage
-	(*PickupGroundItemRequest)(nil),  // 31: messages.PickupGroundItemRequest
-	(*PickupGroundItemResponse)(nil), // 32: messages.PickupGroundItemResponse
-	(*DropItemRequest)(nil),          // 33: messages.DropItemRequest
-	(*DropItemResponse)(nil),         // 34: messages.DropItemResponse
-	(*ItemQuantity)(nil),             // 35: messages.ItemQuantity
-	(*ActorInventory)(nil),           // 36: messages.ActorInventory
-	(*ChopShrubRequest)(nil),         // 37: messages.ChopShrubRequest
-	(*ChopShrubResponse)(nil),        // 38: messages.ChopShrubResponse
-	(*MineOreRequest)(nil),           // 39: messages.MineOreRequest
-	(*MineOreResponse)(nil),          // 40: messages.MineOreResponse
-	(*XpReward)(nil),                 // 41: messages.XpReward
-	(*SkillsXp)(nil),                 // 42: messages.SkillsXp
-	(*InteractWithNpcRequest)(nil),   // 43: messages.InteractWithNpcRequest
-	(*InteractWithNpcResponse)(nil),  // 44: messages.InteractWithNpcResponse
-	(*NpcDialogue)(nil),              // 45: messages.NpcDialogue
-	(*BuyRequest)(nil),               // 46: messages.BuyRequest
-	(*BuyResponse)(nil),              // 47: messages.BuyResponse
-	(*SellRequest)(nil),              // 48: messages.SellRequest
-	(*SellResponse)(nil),             // 49: messages.SellResponse
-	(*LevelMetadata)(nil),            // 50: messages.LevelMetadata
-	(*QuestInfo)(nil),                // 51: messages.QuestInfo
-	(*DespawnGroundItem)(nil),        // 52: messages.DespawnGroundItem
-	(*Packet)(nil),                   // 53: messages.Packet
+	(Harvestable)(0),                   // 0: messages.Harvestable
+	(*Response)(nil),                   // 1: messages.Response
+	(*ClientId)(nil),                   // 2: messages.ClientId
+	(*LoginRequest)(nil),               // 3: messages.LoginRequest
+	(*LoginResponse)(nil),              // 4: messages.LoginResponse
+	(*RegisterRequest)(nil),            // 5: messages.RegisterRequest
+	(*RegisterResponse)(nil),           // 6: messages.RegisterResponse
+	(*Logout)(nil),                     // 7: messages.Logout
+	(*Chat)(nil),                       // 8: messages.Chat
+	(*Yell)(nil),                       // 9: messages.Yell
+	(*Appearance)(nil),                 // 10: messages.Appearance
+	(*Actor)(nil),                      // 11: messages.Actor
+	(*ActorMove)(nil),                  // 12: messages.ActorMove
+	(*Motd)(nil),                       // 13: messages.Motd
+	(*Disconnect)(nil),                 // 14: messages.Disconnect
+	(*AdminLoginGranted)(nil),          // 15: messages.AdminLoginGranted
+	(*SqlQuery)(nil),                   // 16: messages.SqlQuery
+	(*SqlRow)(nil),                     // 17: messages.SqlRow
+	(*SqlResponse)(nil),                // 18: messages.SqlResponse
+	(*CollisionPoint)(nil),             // 19: messages.CollisionPoint
+	(*Shrub)(nil),                      // 20: messages.Shrub
+	(*Ore)(nil),                        // 21: messages.Ore
+	(*Door)(nil),                       // 22: messages.Door
+	(*ToolProps)(nil),                  // 23: messages.ToolProps
+	(*Item)(nil),                       // 24: messages.Item
+	(*GroundItem)(nil),                 // 25: messages.GroundItem
+	(*LevelUpload)(nil),                // 26: messages.LevelUpload
+	(*LevelUploadResponse)(nil),        // 27: messages.LevelUploadResponse
+	(*LevelDownload)(nil),              // 28: messages.LevelDownload
+	(*AdminJoinGameRequest)(nil),       // 29: messages.AdminJoinGameRequest
+	(*AdminJoinGameResponse)(nil),      // 30: messages.AdminJoinGameResponse
+	(*ServerMessage)(nil),              // 31: messages.ServerMessage
+	(*PickupGroundItemRequest)(nil),    // 32: messages.PickupGroundItemRequest
+	(*PickupGroundItemResponse)(nil),   // 33: messages.PickupGroundItemResponse
+	(*DropItemRequest)(nil),            // 34: messages.DropItemRequest
+	(*DropItemResponse)(nil),           // 35: messages.DropItemResponse
+	(*ItemQuantity)(nil),               // 36: messages.ItemQuantity
+	(*ActorInventory)(nil),             // 37: messages.ActorInventory
+	(*ChopShrubRequest)(nil),           // 38: messages.ChopShrubRequest
+	(*ChopShrubResponse)(nil),          // 39: messages.ChopShrubResponse
+	(*MineOreRequest)(nil),             // 40: messages.MineOreRequest
+	(*MineOreResponse)(nil),            // 41: messages.MineOreResponse
+	(*XpReward)(nil),                   // 42: messages.XpReward
+	(*SkillsXp)(nil),                   // 43: messages.SkillsXp
+	(*InteractWithNpcRequest)(nil),     // 44: messages.InteractWithNpcRequest
+	(*InteractWithNpcResponse)(nil),    // 45: messages.InteractWithNpcResponse
+	(*NpcDialogue)(nil),                // 46: messages.NpcDialogue
+	(*BuyRequest)(nil),                 // 47: messages.BuyRequest
+	(*BuyResponse)(nil),                // 48: messages.BuyResponse
+	(*SellRequest)(nil),                // 49: messages.SellRequest
+	(*SellResponse)(nil),               // 50: messages.SellResponse
+	(*LevelMetadata)(nil),              // 51: messages.LevelMetadata
+	(*QuestInfo)(nil),                  // 52: messages.QuestInfo
+	(*DespawnGroundItem)(nil),          // 53: messages.DespawnGroundItem
+	(*Backpressure)(nil),               // 54: messages.Backpressure
+	(*Hello)(nil),                      // 55: messages.Hello
+	(*HelloResponse)(nil),              // 56: messages.HelloResponse
+	(*ResumeSession)(nil),              // 57: messages.ResumeSession
+	(*ResumeSessionResponse)(nil),      // 58: messages.ResumeSessionResponse
+	(*ChangePassword)(nil),             // 59: messages.ChangePassword
+	(*ChangePasswordResponse)(nil),     // 60: messages.ChangePasswordResponse
+	(*AdminResetPassword)(nil),         // 61: messages.AdminResetPassword
+	(*AdminResetPasswordResponse)(nil), // 62: messages.AdminResetPasswordResponse
+	(*CharacterInfo)(nil),              // 63: messages.CharacterInfo
+	(*CharacterList)(nil),              // 64: messages.CharacterList
+	(*CreateCharacterRequest)(nil),     // 65: messages.CreateCharacterRequest
+	(*CreateCharacterResponse)(nil),    // 66: messages.CreateCharacterResponse
+	(*DeleteCharacterRequest)(nil),     // 67: messages.DeleteCharacterRequest
+	(*DeleteCharacterResponse)(nil),    // 68: messages.DeleteCharacterResponse
+	(*RenameCharacterRequest)(nil),     // 69: messages.RenameCharacterRequest
+	(*RenameCharacterResponse)(nil),    // 70: messages.RenameCharacterResponse
+	(*SelectCharacterRequest)(nil),     // 71: messages.SelectCharacterRequest
+	(*SelectCharacterResponse)(nil),    // 72: messages.SelectCharacterResponse
+	(*ChangeAppearanceRequest)(nil),    // 73: messages.ChangeAppearanceRequest
+	(*ChangeAppearanceResponse)(nil),   // 74: messages.ChangeAppearanceResponse
+	(*AdminKick)(nil),                  // 75: messages.AdminKick
+	(*AdminBan)(nil),                   // 76: messages.AdminBan
+	(*AdminUnban)(nil),                 // 77: messages.AdminUnban
+	(*AdminMute)(nil),                  // 78: messages.AdminMute
+	(*AdminUnmute)(nil),                // 79: messages.AdminUnmute
+	(*AdminModerationResponse)(nil),    // 80: messages.AdminModerationResponse
+	(*ModerationNotice)(nil),           // 81: messages.ModerationNotice
+	(*AdminGrantRole)(nil),             // 82: messages.AdminGrantRole
+	(*AdminRevokeRole)(nil),            // 83: messages.AdminRevokeRole
+	(*AdminRoleResponse)(nil),          // 84: messages.AdminRoleResponse
+	(*AdminLookupPlayer)(nil),          // 85: messages.AdminLookupPlayer
+	(*AdminPlayerInfo)(nil),            // 86: messages.AdminPlayerInfo
+	(*AdminEditInventory)(nil),         // 87: messages.AdminEditInventory
+	(*AdminGrantXp)(nil),               // 88: messages.AdminGrantXp
+	(*AdminTeleport)(nil),              // 89: messages.AdminTeleport
+	(*AdminOpResponse)(nil),            // 90: messages.AdminOpResponse
+	(*AdminListOnlinePlayers)(nil),     // 91: messages.AdminListOnlinePlayers
+	(*OnlinePlayer)(nil),               // 92: messages.OnlinePlayer
+	(*AdminOnlinePlayers)(nil),         // 93: messages.AdminOnlinePlayers
+	(*AdminListLevels)(nil),            // 94: messages.AdminListLevels
+	(*LevelInfo)(nil),                  // 95: messages.LevelInfo
+	(*AdminLevelList)(nil),             // 96: messages.AdminLevelList
+	(*CommandArg)(nil),                 // 97: messages.CommandArg
+	(*CommandInfo)(nil),                // 98: messages.CommandInfo
+	(*CommandList)(nil),                // 99: messages.CommandList
+	(*Whisper)(nil),                    // 100: messages.Whisper
+	(*FriendRequest)(nil),              // 101: messages.FriendRequest
+	(*AcceptFriendRequest)(nil),        // 102: messages.AcceptFriendRequest
+	(*RemoveFriend)(nil),               // 103: messages.RemoveFriend
+	(*Friend)(nil),                     // 104: messages.Friend
+	(*FriendList)(nil),                 // 105: messages.FriendList
+	(*FriendPresence)(nil),             // 106: messages.FriendPresence
+	(*IgnorePlayer)(nil),               // 107: messages.IgnorePlayer
+	(*UnignorePlayer)(nil),             // 108: messages.UnignorePlayer
+	(*IgnoreList)(nil),                 // 109: messages.IgnoreList
+	(*SocialResponse)(nil),             // 110: messages.SocialResponse
+	(*JoinChannel)(nil),                // 111: messages.JoinChannel
+	(*LeaveChannel)(nil),               // 112: messages.LeaveChannel
+	(*ChannelMessage)(nil),             // 113: messages.ChannelMessage
+	(*ChannelJoined)(nil),              // 114: messages.ChannelJoined
+	(*ChannelMemberUpdate)(nil),        // 115: messages.ChannelMemberUpdate
+	(*ChannelModerate)(nil),            // 116: messages.ChannelModerate
+	(*ChannelResponse)(nil),            // 117: messages.ChannelResponse
+	(*ListChannels)(nil),               // 118: messages.ListChannels
+	(*ChannelInfo)(nil),                // 119: messages.ChannelInfo
+	(*ChannelList)(nil),                // 120: messages.ChannelList
+	(*PartyInvite)(nil),                // 121: messages.PartyInvite
+	(*PartyAccept)(nil),                // 122: messages.PartyAccept
+	(*PartyLeave)(nil),                 // 123: messages.PartyLeave
+	(*PartyKick)(nil),                  // 124: messages.PartyKick
+	(*PartyMember)(nil),                // 125: messages.PartyMember
+	(*Party)(nil),                      // 126: messages.Party
+	(*PartyMemberPosition)(nil),        // 127: messages.PartyMemberPosition
+	(*PartyQuestCredit)(nil),           // 128: messages.PartyQuestCredit
+	(*PartyResponse)(nil),              // 129: messages.PartyResponse
+	(*CreateGuild)(nil),                // 130: messages.CreateGuild
+	(*GuildInvite)(nil),                // 131: messages.GuildInvite
+	(*AcceptGuildInvite)(nil),          // 132: messages.AcceptGuildInvite
+	(*LeaveGuild)(nil),                 // 133: messages.LeaveGuild
+	(*GuildKick)(nil),                  // 134: messages.GuildKick
+	(*GuildSetRank)(nil),               // 135: messages.GuildSetRank
+	(*GuildMember)(nil),                // 136: messages.GuildMember
+	(*GuildInfo)(nil),                  // 137: messages.GuildInfo
+	(*GuildBankDeposit)(nil),           // 138: messages.GuildBankDeposit
+	(*GuildBankWithdraw)(nil),          // 139: messages.GuildBankWithdraw
+	(*RequestGuildBank)(nil),           // 140: messages.RequestGuildBank
+	(*GuildBank)(nil),                  // 141: messages.GuildBank
+	(*RequestGuildBankLog)(nil),        // 142: messages.RequestGuildBankLog
+	(*GuildBankLogEntry)(nil),          // 143: messages.GuildBankLogEntry
+	(*GuildBankLog)(nil),               // 144: messages.GuildBankLog
+	(*GuildResponse)(nil),              // 145: messages.GuildResponse
+	(*ReportPlayer)(nil),               // 146: messages.ReportPlayer
+	(*ReportResponse)(nil),             // 147: messages.ReportResponse
+	(*AdminListReports)(nil),           // 148: messages.AdminListReports
+	(*ReportChatLine)(nil),             // 149: messages.ReportChatLine
+	(*ReportInfo)(nil),                 // 150: messages.ReportInfo
+	(*AdminReportList)(nil),            // 151: messages.AdminReportList
+	(*AdminClaimReport)(nil),           // 152: messages.AdminClaimReport
+	(*AdminResolveReport)(nil),         // 153: messages.AdminResolveReport
+	(*AdminReportResponse)(nil),        // 154: messages.AdminReportResponse
+	(*AdminSearchChatLog)(nil),         // 155: messages.AdminSearchChatLog
+	(*ChatLogEntry)(nil),               // 156: messages.ChatLogEntry
+	(*AdminChatLog)(nil),               // 157: messages.AdminChatLog
+	(*WorldTime)(nil),                  // 158: messages.WorldTime
+	(*Emote)(nil),                      // 159: messages.Emote
+	(*PacketBatch)(nil),                // 160: messages.PacketBatch
+	(*Packet)(nil),                     // 161: messages.Packet
 }
 var file_messages_proto_depIdxs = []int32{
-	1,  // 0: messages.LoginResponse.response:type_name -> messages.Response
-	1,  // 1: messages.RegisterResponse.response:type_name -> messages.Response
-	1,  // 2: messages.SqlResponse.response:type_name -> messages.Response
-	16, // 3: messages.SqlResponse.rows:type_name -> messages.SqlRow
-	0,  // 4: messages.ToolProps.harvests:type_name -> messages.Harvestable
-	22, // 5: messages.Item.tool_props:type_name -> messages.ToolProps
-	23, // 6: messages.GroundItem.item:type_name -> messages.Item
-	18, // 7: messages.LevelUpload.collision_point:type_name -> messages.CollisionPoint
-	19, // 8: messages.LevelUpload.shrub:type_name -> messages.Shrub
-	20, // 9: messages.LevelUpload.ore:type_name -> messages.Ore
-	21, // 10: messages.LevelUpload.door:type_name -> messages.Door
-	24, // 11: messages.LevelUpload.ground_item:type_name -> messages.GroundItem
-	1,  // 12: messages.LevelUploadResponse.response:type_name -> messages.Response
-	1,  // 13: messages.AdminJoinGameResponse.response:type_name -> messages.Response
-	24, // 14: messages.PickupGroundItemResponse.ground_item:type_name -> messages.GroundItem
-	1,  // 15: messages.PickupGroundItemResponse.response:type_name -> messages.Response
-	23, // 16: messages.DropItemRequest.item:type_name -> messages.Item
-	23, // 17: messages.DropItemResponse.item:type_name -> messages.Item
-	1,  // 18: messages.DropItemResponse.response:type_name -> messages.Response
-	23, // 19: messages.ItemQuantity.item:type_name -> messages.Item
-	35, // 20: messages.ActorInventory.items_quantities:type_name -> messages.ItemQuantity
-	1,  // 21: messages.ChopShrubResponse.response:type_name -> messages.Response
-	1,  // 22: messages.MineOreResponse.response:type_name -> messages.Response
-	41, // 23: messages.SkillsXp.xp_rewards:type_name -> messages.XpReward
-	1,  // 24: messages.InteractWithNpcResponse.response:type_name -> messages.Response
-	23, // 25: messages.BuyRequest.item:type_name -> messages.Item
-	35, // 26: messages.BuyResponse.item_qty:type_name -> messages.ItemQuantity
-	1,  // 27: messages.BuyResponse.response:type_name -> messages.Response
-	23, // 28: messages.SellRequest.item:type_name -> messages.Item
-	35, // 29: messages.SellResponse.item_qty:type_name -> messages.ItemQuantity
-	1,  // 30: messages.SellResponse.response:type_name -> messages.Response
-	45, // 31: messages.QuestInfo.start_dialogue:type_name -> messages.NpcDialogue
-	23, // 32: messages.QuestInfo.required_item:type_name -> messages.Item
-	45, // 33: messages.QuestInfo.completed_dialogue:type_name -> messages.NpcDialogue
-	23, // 34: messages.QuestInfo.reward_item:type_name -> messages.Item
-	2,  // 35: messages.Packet.client_id:type_name -> messages.ClientId
-	3,  // 36: messages.Packet.login_request:type_name -> messages.LoginRequest
-	4,  // 37: messages.Packet.login_response:type_name -> messages.LoginResponse
-	5,  // 38: messages.Packet.register_request:type_name -> messages.RegisterRequest
-	6,  // 39: messages.Packet.register_response:type_name -> messages.RegisterResponse
-	7,  // 40: messages.Packet.logout:type_name -> messages.Logout
-	8,  // 41: messages.Packet.chat:type_name -> messages.Chat
-	9,  // 42: messages.Packet.yell:type_name -> messages.Yell
-	10, // 43: messages.Packet.actor:type_name -> messages.Actor
-	11, // 44: messages.Packet.actor_move:type_name -> messages.ActorMove
-	12, // 45: messages.Packet.motd:type_name -> messages.Motd
-	13, // 46: messages.Packet.disconnect:type_name -> messages.Disconnect
-	14, // 47: messages.Packet.admin_login_granted:type_name -> messages.AdminLoginGranted
-	15, // 48: messages.Packet.sql_query:type_name -> messages.SqlQuery
-	17, // 49: messages.Packet.sql_response:type_name -> messages.SqlResponse
-	25, // 50: messages.Packet.level_upload:type_name -> messages.LevelUpload
-	26, // 51: messages.Packet.level_upload_response:type_name -> messages.LevelUploadResponse
-	27, // 52: messages.Packet.level_download:type_name -> messages.LevelDownload
-	28, // 53: messages.Packet.admin_join_game_request:type_name -> messages.AdminJoinGameRequest
-	29, // 54: messages.Packet.admin_join_game_response:type_name -> messages.AdminJoinGameResponse
-	30, // 55: messages.Packet.server_message:type_name -> messages.ServerMessage
-	31, // 56: messages.Packet.pickup_ground_item_request:type_name -> messages.PickupGroundItemRequest
-	32, // 57: messages.Packet.pickup_ground_item_response:type_name -> messages.PickupGroundItemResponse
-	19, // 58: messages.Packet.shrub:type_name -> messages.Shrub
-	20, // 59: messages.Packet.ore:type_name -> messages.Ore
-	21, // 60: messages.Packet.door:type_name -> messages.Door
-	23, // 61: messages.Packet.Item:type_name -> messages.Item
-	24, // 62: messages.Packet.ground_item:type_name -> messages.GroundItem
-	36, // 63: messages.Packet.actor_inventory:type_name -> messages.ActorInventory
-	33, // 64: messages.Packet.drop_item_request:type_name -> messages.DropItemRequest
-	34, // 65: messages.Packet.drop_item_response:type_name -> messages.DropItemResponse
-	37, // 66: messages.Packet.chop_shrub_request:type_name -> messages.ChopShrubRequest
-	38, // 67: messages.Packet.chop_shrub_response:type_name -> messages.ChopShrubResponse
-	39, // 68: messages.Packet.mine_ore_request:type_name -> messages.MineOreRequest
-	40, // 69: messages.Packet.mine_ore_response:type_name -> messages.MineOreResponse
-	35, // 70: messages.Packet.item_quantity:type_name -> messages.ItemQuantity
-	41, // 71: messages.Packet.xp_reward:type_name -> messages.XpReward
-	42, // 72: messages.Packet.skills_xp:type_name -> messages.SkillsXp
-	44, // 73: messages.Packet.interact_with_npc_response:type_name -> messages.InteractWithNpcResponse
-	43, // 74: messages.Packet.interact_with_npc_request:type_name -> messages.InteractWithNpcRequest
-	45, // 75: messages.Packet.npc_dialogue:type_name -> messages.NpcDialogue
-	46, // 76: messages.Packet.buy_request:type_name -> messages.BuyRequest
-	47, // 77: messages.Packet.buy_response:type_name -> messages.BuyResponse
-	48, // 78: messages.Packet.sell_request:type_name -> messages.SellRequest
-	49, // 79: messages.Packet.sell_response:type_name -> messages.SellResponse
-	50, // 80: messages.Packet.level_metadata:type_name -> messages.LevelMetadata
-	51, // 81: messages.Packet.quest_info:type_name -> messages.QuestInfo
-	52, // 82: messages.Packet.despawn_ground_item:type_name -> messages.DespawnGroundItem
-	83, // [83:83] is the sub-list for method output_type
-	83, // [83:83] is the sub-list for method input_type
-	83, // [83:83] is the sub-list for extension type_name
-	83, // [83:83] is the sub-list for extension extendee
-	0,  // [0:83] is the sub-list for field type_name
+	1,   // 0: messages.LoginResponse.response:type_name -> messages.Response
+	10,  // 1: messages.RegisterRequest.appearance:type_name -> messages.Appearance
+	1,   // 2: messages.RegisterResponse.response:type_name -> messages.Response
+	10,  // 3: messages.Actor.appearance:type_name -> messages.Appearance
+	1,   // 4: messages.SqlResponse.response:type_name -> messages.Response
+	17,  // 5: messages.SqlResponse.rows:type_name -> messages.SqlRow
+	0,   // 6: messages.ToolProps.harvests:type_name -> messages.Harvestable
+	23,  // 7: messages.Item.tool_props:type_name -> messages.ToolProps
+	24,  // 8: messages.GroundItem.item:type_name -> messages.Item
+	19,  // 9: messages.LevelUpload.collision_point:type_name -> messages.CollisionPoint
+	20,  // 10: messages.LevelUpload.shrub:type_name -> messages.Shrub
+	21,  // 11: messages.LevelUpload.ore:type_name -> messages.Ore
+	22,  // 12: messages.LevelUpload.door:type_name -> messages.Door
+	25,  // 13: messages.LevelUpload.ground_item:type_name -> messages.GroundItem
+	1,   // 14: messages.LevelUploadResponse.response:type_name -> messages.Response
+	1,   // 15: messages.AdminJoinGameResponse.response:type_name -> messages.Response
+	25,  // 16: messages.PickupGroundItemResponse.ground_item:type_name -> messages.GroundItem
+	1,   // 17: messages.PickupGroundItemResponse.response:type_name -> messages.Response
+	24,  // 18: messages.DropItemRequest.item:type_name -> messages.Item
+	24,  // 19: messages.DropItemResponse.item:type_name -> messages.Item
+	1,   // 20: messages.DropItemResponse.response:type_name -> messages.Response
+	24,  // 21: messages.ItemQuantity.item:type_name -> messages.Item
+	36,  // 22: messages.ActorInventory.items_quantities:type_name -> messages.ItemQuantity
+	1,   // 23: messages.ChopShrubResponse.response:type_name -> messages.Response
+	1,   // 24: messages.MineOreResponse.response:type_name -> messages.Response
+	42,  // 25: messages.SkillsXp.xp_rewards:type_name -> messages.XpReward
+	1,   // 26: messages.InteractWithNpcResponse.response:type_name -> messages.Response
+	24,  // 27: messages.BuyRequest.item:type_name -> messages.Item
+	36,  // 28: messages.BuyResponse.item_qty:type_name -> messages.ItemQuantity
+	1,   // 29: messages.BuyResponse.response:type_name -> messages.Response
+	24,  // 30: messages.SellRequest.item:type_name -> messages.Item
+	36,  // 31: messages.SellResponse.item_qty:type_name -> messages.ItemQuantity
+	1,   // 32: messages.SellResponse.response:type_name -> messages.Response
+	46,  // 33: messages.QuestInfo.start_dialogue:type_name -> messages.NpcDialogue
+	24,  // 34: messages.QuestInfo.required_item:type_name -> messages.Item
+	46,  // 35: messages.QuestInfo.completed_dialogue:type_name -> messages.NpcDialogue
+	24,  // 36: messages.QuestInfo.reward_item:type_name -> messages.Item
+	1,   // 37: messages.HelloResponse.response:type_name -> messages.Response
+	1,   // 38: messages.ResumeSessionResponse.response:type_name -> messages.Response
+	1,   // 39: messages.ChangePasswordResponse.response:type_name -> messages.Response
+	1,   // 40: messages.AdminResetPasswordResponse.response:type_name -> messages.Response
+	10,  // 41: messages.CharacterInfo.appearance:type_name -> messages.Appearance
+	63,  // 42: messages.CharacterList.characters:type_name -> messages.CharacterInfo
+	10,  // 43: messages.CreateCharacterRequest.appearance:type_name -> messages.Appearance
+	1,   // 44: messages.CreateCharacterResponse.response:type_name -> messages.Response
+	1,   // 45: messages.DeleteCharacterResponse.response:type_name -> messages.Response
+	1,   // 46: messages.RenameCharacterResponse.response:type_name -> messages.Response
+	1,   // 47: messages.SelectCharacterResponse.response:type_name -> messages.Response
+	10,  // 48: messages.ChangeAppearanceRequest.appearance:type_name -> messages.Appearance
+	1,   // 49: messages.ChangeAppearanceResponse.response:type_name -> messages.Response
+	1,   // 50: messages.AdminModerationResponse.response:type_name -> messages.Response
+	1,   // 51: messages.AdminRoleResponse.response:type_name -> messages.Response
+	1,   // 52: messages.AdminPlayerInfo.response:type_name -> messages.Response
+	63,  // 53: messages.AdminPlayerInfo.characters:type_name -> messages.CharacterInfo
+	1,   // 54: messages.AdminOpResponse.response:type_name -> messages.Response
+	92,  // 55: messages.AdminOnlinePlayers.players:type_name -> messages.OnlinePlayer
+	95,  // 56: messages.AdminLevelList.levels:type_name -> messages.LevelInfo
+	97,  // 57: messages.CommandInfo.args:type_name -> messages.CommandArg
+	98,  // 58: messages.CommandList.commands:type_name -> messages.CommandInfo
+	104, // 59: messages.FriendList.friends:type_name -> messages.Friend
+	1,   // 60: messages.SocialResponse.response:type_name -> messages.Response
+	113, // 61: messages.ChannelJoined.history:type_name -> messages.ChannelMessage
+	1,   // 62: messages.ChannelResponse.response:type_name -> messages.Response
+	119, // 63: messages.ChannelList.channels:type_name -> messages.ChannelInfo
+	125, // 64: messages.Party.members:type_name -> messages.PartyMember
+	52,  // 65: messages.PartyQuestCredit.quest:type_name -> messages.QuestInfo
+	1,   // 66: messages.PartyResponse.response:type_name -> messages.Response
+	136, // 67: messages.GuildInfo.members:type_name -> messages.GuildMember
+	24,  // 68: messages.GuildBankDeposit.item:type_name -> messages.Item
+	24,  // 69: messages.GuildBankWithdraw.item:type_name -> messages.Item
+	36,  // 70: messages.GuildBank.items_quantities:type_name -> messages.ItemQuantity
+	24,  // 71: messages.GuildBankLogEntry.item:type_name -> messages.Item
+	143, // 72: messages.GuildBankLog.entries:type_name -> messages.GuildBankLogEntry
+	1,   // 73: messages.GuildResponse.response:type_name -> messages.Response
+	1,   // 74: messages.ReportResponse.response:type_name -> messages.Response
+	149, // 75: messages.ReportInfo.chat:type_name -> messages.ReportChatLine
+	150, // 76: messages.AdminReportList.reports:type_name -> messages.ReportInfo
+	1,   // 77: messages.AdminReportResponse.response:type_name -> messages.Response
+	1,   // 78: messages.AdminChatLog.response:type_name -> messages.Response
+	156, // 79: messages.AdminChatLog.entries:type_name -> messages.ChatLogEntry
+	161, // 80: messages.PacketBatch.packets:type_name -> messages.Packet
+	2,   // 81: messages.Packet.client_id:type_name -> messages.ClientId
+	3,   // 82: messages.Packet.login_request:type_name -> messages.LoginRequest
+	4,   // 83: messages.Packet.login_response:type_name -> messages.LoginResponse
+	5,   // 84: messages.Packet.register_request:type_name -> messages.RegisterRequest
+	6,   // 85: messages.Packet.register_response:type_name -> messages.RegisterResponse
+	7,   // 86: messages.Packet.logout:type_name -> messages.Logout
+	8,   // 87: messages.Packet.chat:type_name -> messages.Chat
+	9,   // 88: messages.Packet.yell:type_name -> messages.Yell
+	11,  // 89: messages.Packet.actor:type_name -> messages.Actor
+	12,  // 90: messages.Packet.actor_move:type_name -> messages.ActorMove
+	13,  // 91: messages.Packet.motd:type_name -> messages.Motd
+	14,  // 92: messages.Packet.disconnect:type_name -> messages.Disconnect
+	15,  // 93: messages.Packet.admin_login_granted:type_name -> messages.AdminLoginGranted
+	16,  // 94: messages.Packet.sql_query:type_name -> messages.SqlQuery
+	18,  // 95: messages.Packet.sql_response:type_name -> messages.SqlResponse
+	26,  // 96: messages.Packet.level_upload:type_name -> messages.LevelUpload
+	27,  // 97: messages.Packet.level_upload_response:type_name -> messages.LevelUploadResponse
+	28,  // 98: messages.Packet.level_download:type_name -> messages.LevelDownload
+	29,  // 99: messages.Packet.admin_join_game_request:type_name -> messages.AdminJoinGameRequest
+	30,  // 100: messages.Packet.admin_join_game_response:type_name -> messages.AdminJoinGameResponse
+	31,  // 101: messages.Packet.server_message:type_name -> messages.ServerMessage
+	32,  // 102: messages.Packet.pickup_ground_item_request:type_name -> messages.PickupGroundItemRequest
+	33,  // 103: messages.Packet.pickup_ground_item_response:type_name -> messages.PickupGroundItemResponse
+	20,  // 104: messages.Packet.shrub:type_name -> messages.Shrub
+	21,  // 105: messages.Packet.ore:type_name -> messages.Ore
+	22,  // 106: messages.Packet.door:type_name -> messages.Door
+	24,  // 107: messages.Packet.Item:type_name -> messages.Item
+	25,  // 108: messages.Packet.ground_item:type_name -> messages.GroundItem
+	37,  // 109: messages.Packet.actor_inventory:type_name -> messages.ActorInventory
+	34,  // 110: messages.Packet.drop_item_request:type_name -> messages.DropItemRequest
+	35,  // 111: messages.Packet.drop_item_response:type_name -> messages.DropItemResponse
+	38,  // 112: messages.Packet.chop_shrub_request:type_name -> messages.ChopShrubRequest
+	39,  // 113: messages.Packet.chop_shrub_response:type_name -> messages.ChopShrubResponse
+	40,  // 114: messages.Packet.mine_ore_request:type_name -> messages.MineOreRequest
+	41,  // 115: messages.Packet.mine_ore_response:type_name -> messages.MineOreResponse
+	36,  // 116: messages.Packet.item_quantity:type_name -> messages.ItemQuantity
+	42,  // 117: messages.Packet.xp_reward:type_name -> messages.XpReward
+	43,  // 118: messages.Packet.skills_xp:type_name -> messages.SkillsXp
+	45,  // 119: messages.Packet.interact_with_npc_response:type_name -> messages.InteractWithNpcResponse
+	44,  // 120: messages.Packet.interact_with_npc_request:type_name -> messages.InteractWithNpcRequest
+	46,  // 121: messages.Packet.npc_dialogue:type_name -> messages.NpcDialogue
+	47,  // 122: messages.Packet.buy_request:type_name -> messages.BuyRequest
+	48,  // 123: messages.Packet.buy_response:type_name -> messages.BuyResponse
+	49,  // 124: messages.Packet.sell_request:type_name -> messages.SellRequest
+	50,  // 125: messages.Packet.sell_response:type_name -> messages.SellResponse
+	51,  // 126: messages.Packet.level_metadata:type_name -> messages.LevelMetadata
+	52,  // 127: messages.Packet.quest_info:type_name -> messages.QuestInfo
+	53,  // 128: messages.Packet.despawn_ground_item:type_name -> messages.DespawnGroundItem
+	54,  // 129: messages.Packet.backpressure:type_name -> messages.Backpressure
+	160, // 130: messages.Packet.packet_batch:type_name -> messages.PacketBatch
+	55,  // 131: messages.Packet.hello:type_name -> messages.Hello
+	56,  // 132: messages.Packet.hello_response:type_name -> messages.HelloResponse
+	57,  // 133: messages.Packet.resume_session:type_name -> messages.ResumeSession
+	58,  // 134: messages.Packet.resume_session_response:type_name -> messages.ResumeSessionResponse
+	59,  // 135: messages.Packet.change_password:type_name -> messages.ChangePassword
+	60,  // 136: messages.Packet.change_password_response:type_name -> messages.ChangePasswordResponse
+	61,  // 137: messages.Packet.admin_reset_password:type_name -> messages.AdminResetPassword
+	62,  // 138: messages.Packet.admin_reset_password_response:type_name -> messages.AdminResetPasswordResponse
+	64,  // 139: messages.Packet.character_list:type_name -> messages.CharacterList
+	65,  // 140: messages.Packet.create_character_request:type_name -> messages.CreateCharacterRequest
+	66,  // 141: messages.Packet.create_character_response:type_name -> messages.CreateCharacterResponse
+	67,  // 142: messages.Packet.delete_character_request:type_name -> messages.DeleteCharacterRequest
+	68,  // 143: messages.Packet.delete_character_response:type_name -> messages.DeleteCharacterResponse
+	69,  // 144: messages.Packet.rename_character_request:type_name -> messages.RenameCharacterRequest
+	70,  // 145: messages.Packet.rename_character_response:type_name -> messages.RenameCharacterResponse
+	71,  // 146: messages.Packet.select_character_request:type_name -> messages.SelectCharacterRequest
+	72,  // 147: messages.Packet.select_character_response:type_name -> messages.SelectCharacterResponse
+	73,  // 148: messages.Packet.change_appearance_request:type_name -> messages.ChangeAppearanceRequest
+	74,  // 149: messages.Packet.change_appearance_response:type_name -> messages.ChangeAppearanceResponse
+	75,  // 150: messages.Packet.admin_kick:type_name -> messages.AdminKick
+	76,  // 151: messages.Packet.admin_ban:type_name -> messages.AdminBan
+	77,  // 152: messages.Packet.admin_unban:type_name -> messages.AdminUnban
+	78,  // 153: messages.Packet.admin_mute:type_name -> messages.AdminMute
+	79,  // 154: messages.Packet.admin_unmute:type_name -> messages.AdminUnmute
+	80,  // 155: messages.Packet.admin_moderation_response:type_name -> messages.AdminModerationResponse
+	81,  // 156: messages.Packet.moderation_notice:type_name -> messages.ModerationNotice
+	82,  // 157: messages.Packet.admin_grant_role:type_name -> messages.AdminGrantRole
+	83,  // 158: messages.Packet.admin_revoke_role:type_name -> messages.AdminRevokeRole
+	84,  // 159: messages.Packet.admin_role_response:type_name -> messages.AdminRoleResponse
+	85,  // 160: messages.Packet.admin_lookup_player:type_name -> messages.AdminLookupPlayer
+	86,  // 161: messages.Packet.admin_player_info:type_name -> messages.AdminPlayerInfo
+	87,  // 162: messages.Packet.admin_edit_inventory:type_name -> messages.AdminEditInventory
+	88,  // 163: messages.Packet.admin_grant_xp:type_name -> messages.AdminGrantXp
+	89,  // 164: messages.Packet.admin_teleport:type_name -> messages.AdminTeleport
+	90,  // 165: messages.Packet.admin_op_response:type_name -> messages.AdminOpResponse
+	91,  // 166: messages.Packet.admin_list_online_players:type_name -> messages.AdminListOnlinePlayers
+	93,  // 167: messages.Packet.admin_online_players:type_name -> messages.AdminOnlinePlayers
+	94,  // 168: messages.Packet.admin_list_levels:type_name -> messages.AdminListLevels
+	96,  // 169: messages.Packet.admin_level_list:type_name -> messages.AdminLevelList
+	99,  // 170: messages.Packet.command_list:type_name -> messages.CommandList
+	100, // 171: messages.Packet.whisper:type_name -> messages.Whisper
+	101, // 172: messages.Packet.friend_request:type_name -> messages.FriendRequest
+	102, // 173: messages.Packet.accept_friend_request:type_name -> messages.AcceptFriendRequest
+	103, // 174: messages.Packet.remove_friend:type_name -> messages.RemoveFriend
+	105, // 175: messages.Packet.friend_list:type_name -> messages.FriendList
+	106, // 176: messages.Packet.friend_presence:type_name -> messages.FriendPresence
+	107, // 177: messages.Packet.ignore_player:type_name -> messages.IgnorePlayer
+	108, // 178: messages.Packet.unignore_player:type_name -> messages.UnignorePlayer
+	109, // 179: messages.Packet.ignore_list:type_name -> messages.IgnoreList
+	110, // 180: messages.Packet.social_response:type_name -> messages.SocialResponse
+	111, // 181: messages.Packet.join_channel:type_name -> messages.JoinChannel
+	112, // 182: messages.Packet.leave_channel:type_name -> messages.LeaveChannel
+	113, // 183: messages.Packet.channel_message:type_name -> messages.ChannelMessage
+	114, // 184: messages.Packet.channel_joined:type_name -> messages.ChannelJoined
+	115, // 185: messages.Packet.channel_member_update:type_name -> messages.ChannelMemberUpdate
+	116, // 186: messages.Packet.channel_moderate:type_name -> messages.ChannelModerate
+	117, // 187: messages.Packet.channel_response:type_name -> messages.ChannelResponse
+	118, // 188: messages.Packet.list_channels:type_name -> messages.ListChannels
+	120, // 189: messages.Packet.channel_list:type_name -> messages.ChannelList
+	121, // 190: messages.Packet.party_invite:type_name -> messages.PartyInvite
+	122, // 191: messages.Packet.party_accept:type_name -> messages.PartyAccept
+	123, // 192: messages.Packet.party_leave:type_name -> messages.PartyLeave
+	124, // 193: messages.Packet.party_kick:type_name -> messages.PartyKick
+	126, // 194: messages.Packet.party:type_name -> messages.Party
+	127, // 195: messages.Packet.party_member_position:type_name -> messages.PartyMemberPosition
+	128, // 196: messages.Packet.party_quest_credit:type_name -> messages.PartyQuestCredit
+	129, // 197: messages.Packet.party_response:type_name -> messages.PartyResponse
+	130, // 198: messages.Packet.create_guild:type_name -> messages.CreateGuild
+	131, // 199: messages.Packet.guild_invite:type_name -> messages.GuildInvite
+	132, // 200: messages.Packet.accept_guild_invite:type_name -> messages.AcceptGuildInvite
+	133, // 201: messages.Packet.leave_guild:type_name -> messages.LeaveGuild
+	134, // 202: messages.Packet.guild_kick:type_name -> messages.GuildKick
+	135, // 203: messages.Packet.guild_set_rank:type_name -> messages.GuildSetRank
+	137, // 204: messages.Packet.guild_info:type_name -> messages.GuildInfo
+	138, // 205: messages.Packet.guild_bank_deposit:type_name -> messages.GuildBankDeposit
+	139, // 206: messages.Packet.guild_bank_withdraw:type_name -> messages.GuildBankWithdraw
+	140, // 207: messages.Packet.request_guild_bank:type_name -> messages.RequestGuildBank
+	141, // 208: messages.Packet.guild_bank:type_name -> messages.GuildBank
+	142, // 209: messages.Packet.request_guild_bank_log:type_name -> messages.RequestGuildBankLog
+	144, // 210: messages.Packet.guild_bank_log:type_name -> messages.GuildBankLog
+	145, // 211: messages.Packet.guild_response:type_name -> messages.GuildResponse
+	146, // 212: messages.Packet.report_player:type_name -> messages.ReportPlayer
+	147, // 213: messages.Packet.report_response:type_name -> messages.ReportResponse
+	148, // 214: messages.Packet.admin_list_reports:type_name -> messages.AdminListReports
+	151, // 215: messages.Packet.admin_report_list:type_name -> messages.AdminReportList
+	152, // 216: messages.Packet.admin_claim_report:type_name -> messages.AdminClaimReport
+	153, // 217: messages.Packet.admin_resolve_report:type_name -> messages.AdminResolveReport
+	154, // 218: messages.Packet.admin_report_response:type_name -> messages.AdminReportResponse
+	155, // 219: messages.Packet.admin_search_chat_log:type_name -> messages.AdminSearchChatLog
+	157, // 220: messages.Packet.admin_chat_log:type_name -> messages.AdminChatLog
+	159, // 221: messages.Packet.emote:type_name -> messages.Emote
+	158, // 222: messages.Packet.world_time:type_name -> messages.WorldTime
+	223, // [223:223] is the sub-list for method output_type
+	223, // [223:223] is the sub-list for method input_type
+	223, // [223:223] is the sub-list for extension type_name
+	223, // [223:223] is the sub-list for extension extendee
+	0,   // [0:223] is the sub-list for field type_name
 }
 
 func init() { file_messages_proto_init() }
@@ -4472,7 +13288,7 @@ func file_messages_proto_init() {
 	file_messages_proto_msgTypes[0].OneofWrappers = []any{
 		(*Response_Msg)(nil),
 	}
-	file_messages_proto_msgTypes[52].OneofWrappers = []any{
+	file_messages_proto_msgTypes[160].OneofWrappers = []any{
 		(*Packet_ClientId)(nil),
 		(*Packet_LoginRequest)(nil),
 		(*Packet_LoginResponse)(nil),
@@ -4521,6 +13337,100 @@ func file_messages_proto_init() {
 		(*Packet_LevelMetadata)(nil),
 		(*Packet_QuestInfo)(nil),
 		(*Packet_DespawnGroundItem)(nil),
+		(*Packet_Backpressure)(nil),
+		(*Packet_PacketBatch)(nil),
+		(*Packet_Hello)(nil),
+		(*Packet_HelloResponse)(nil),
+		(*Packet_ResumeSession)(nil),
+		(*Packet_ResumeSessionResponse)(nil),
+		(*Packet_ChangePassword)(nil),
+		(*Packet_ChangePasswordResponse)(nil),
+		(*Packet_AdminResetPassword)(nil),
+		(*Packet_AdminResetPasswordResponse)(nil),
+		(*Packet_CharacterList)(nil),
+		(*Packet_CreateCharacterRequest)(nil),
+		(*Packet_CreateCharacterResponse)(nil),
+		(*Packet_DeleteCharacterRequest)(nil),
+		(*Packet_DeleteCharacterResponse)(nil),
+		(*Packet_RenameCharacterRequest)(nil),
+		(*Packet_RenameCharacterResponse)(nil),
+		(*Packet_SelectCharacterRequest)(nil),
+		(*Packet_SelectCharacterResponse)(nil),
+		(*Packet_ChangeAppearanceRequest)(nil),
+		(*Packet_ChangeAppearanceResponse)(nil),
+		(*Packet_AdminKick)(nil),
+		(*Packet_AdminBan)(nil),
+		(*Packet_AdminUnban)(nil),
+		(*Packet_AdminMute)(nil),
+		(*Packet_AdminUnmute)(nil),
+		(*Packet_AdminModerationResponse)(nil),
+		(*Packet_ModerationNotice)(nil),
+		(*Packet_AdminGrantRole)(nil),
+		(*Packet_AdminRevokeRole)(nil),
+		(*Packet_AdminRoleResponse)(nil),
+		(*Packet_AdminLookupPlayer)(nil),
+		(*Packet_AdminPlayerInfo)(nil),
+		(*Packet_AdminEditInventory)(nil),
+		(*Packet_AdminGrantXp)(nil),
+		(*Packet_AdminTeleport)(nil),
+		(*Packet_AdminOpResponse)(nil),
+		(*Packet_AdminListOnlinePlayers)(nil),
+		(*Packet_AdminOnlinePlayers)(nil),
+		(*Packet_AdminListLevels)(nil),
+		(*Packet_AdminLevelList)(nil),
+		(*Packet_CommandList)(nil),
+		(*Packet_Whisper)(nil),
+		(*Packet_FriendRequest)(nil),
+		(*Packet_AcceptFriendRequest)(nil),
+		(*Packet_RemoveFriend)(nil),
+		(*Packet_FriendList)(nil),
+		(*Packet_FriendPresence)(nil),
+		(*Packet_IgnorePlayer)(nil),
+		(*Packet_UnignorePlayer)(nil),
+		(*Packet_IgnoreList)(nil),
+		(*Packet_SocialResponse)(nil),
+		(*Packet_JoinChannel)(nil),
+		(*Packet_LeaveChannel)(nil),
+		(*Packet_ChannelMessage)(nil),
+		(*Packet_ChannelJoined)(nil),
+		(*Packet_ChannelMemberUpdate)(nil),
+		(*Packet_ChannelModerate)(nil),
+		(*Packet_ChannelResponse)(nil),
+		(*Packet_ListChannels)(nil),
+		(*Packet_ChannelList)(nil),
+		(*Packet_PartyInvite)(nil),
+		(*Packet_PartyAccept)(nil),
+		(*Packet_PartyLeave)(nil),
+		(*Packet_PartyKick)(nil),
+		(*Packet_Party)(nil),
+		(*Packet_PartyMemberPosition)(nil),
+		(*Packet_PartyQuestCredit)(nil),
+		(*Packet_PartyResponse)(nil),
+		(*Packet_CreateGuild)(nil),
+		(*Packet_GuildInvite)(nil),
+		(*Packet_AcceptGuildInvite)(nil),
+		(*Packet_LeaveGuild)(nil),
+		(*Packet_GuildKick)(nil),
+		(*Packet_GuildSetRank)(nil),
+		(*Packet_GuildInfo)(nil),
+		(*Packet_GuildBankDeposit)(nil),
+		(*Packet_GuildBankWithdraw)(nil),
+		(*Packet_RequestGuildBank)(nil),
+		(*Packet_GuildBank)(nil),
+		(*Packet_RequestGuildBankLog)(nil),
+		(*Packet_GuildBankLog)(nil),
+		(*Packet_GuildResponse)(nil),
+		(*Packet_ReportPlayer)(nil),
+		(*Packet_ReportResponse)(nil),
+		(*Packet_AdminListReports)(nil),
+		(*Packet_AdminReportList)(nil),
+		(*Packet_AdminClaimReport)(nil),
+		(*Packet_AdminResolveReport)(nil),
+		(*Packet_AdminReportResponse)(nil),
+		(*Packet_AdminSearchChatLog)(nil),
+		(*Packet_AdminChatLog)(nil),
+		(*Packet_Emote)(nil),
+		(*Packet_WorldTime)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4528,7 +13438,7 @@ func file_messages_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_messages_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   53,
+			NumMessages:   161,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
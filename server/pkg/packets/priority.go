@@ -0,0 +1,40 @@
+package packets
+
+import "strings"
+
+// How urgently a packet from a client should be processed relative to the client's other packets. Packets of the same
+// priority are always processed in the order the client sent them, but a packet can overtake ones of a lower priority
+// that were sent before it.
+type Priority int
+
+const (
+	// Gameplay, e.g. movement, chopping, trading and chat commands. Kept in a single queue so e.g. a move can never
+	// overtake the chop or drop the player sent before it.
+	PriorityNormal Priority = iota
+	// Chat, which can afford to wait a tick or two. Gameplay sent after a message might be processed before it, so it
+	// might be said from wherever the player has got to by then.
+	PriorityLow
+	// Logging out and disconnecting, which are only processed once everything sent before them has been. They get a
+	// queue to themselves so a flood of chat can't push them out.
+	PriorityLast
+
+	NumPriorities int = iota
+)
+
+// Returns the priority a message should be processed with, so that e.g. a flood of chat can't starve movement
+func PriorityOf(message Msg) Priority {
+	switch message := message.(type) {
+	case *Packet_Logout, *Packet_Disconnect:
+		return PriorityLast
+	case *Packet_Chat:
+		// Commands can move the player or change what they have, so they need to stay in order with the rest of gameplay
+		if strings.HasPrefix(message.Chat.GetMsg(), "/") {
+			return PriorityNormal
+		}
+		return PriorityLow
+	case *Packet_Yell, *Packet_Whisper, *Packet_ChannelMessage:
+		return PriorityLow
+	default:
+		return PriorityNormal
+	}
+}
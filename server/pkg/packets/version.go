@@ -0,0 +1,56 @@
+package packets
+
+// The version of the protocol in messages.proto. Bump this whenever a change would break clients built against the
+// previous version, and raise MinProtocolVersion if the server can no longer talk to them.
+const ProtocolVersion uint32 = 11
+
+// The oldest protocol version the server still supports
+const MinProtocolVersion uint32 = 1
+
+// The version assumed for clients which never say hello, i.e. those built before the handshake existed
+const LegacyProtocolVersion uint32 = 1
+
+// The first version where clients are told when the server drops their packets. Backpressure came in just before the
+// handshake, so clients which never say hello might not understand it.
+const BackpressureProtocolVersion uint32 = 2
+
+// The first version where clients pick a character after logging in. Older clients are put straight into the game as
+// their account's first character.
+const CharacterSelectProtocolVersion uint32 = 3
+
+// The first version where clients are sent the chat commands they can use when they enter the game
+const CommandListProtocolVersion uint32 = 4
+
+// The first version with whispers, friends and ignore lists. Older clients are sent whispers as server messages.
+const SocialProtocolVersion uint32 = 5
+
+// The first version with chat channels. Older clients still hear yells, which go through the global channel.
+const ChannelsProtocolVersion uint32 = 6
+
+// The first version with parties. Older clients are told when they're invited, but can't accept.
+const PartiesProtocolVersion uint32 = 7
+
+// The first version with guilds. Older clients are told when they're invited, but can't accept.
+const GuildsProtocolVersion uint32 = 8
+
+// The first version where players can report each other. Older clients can still use /report, and are told how it went
+// in a server message.
+const ReportsProtocolVersion uint32 = 9
+
+// The first version with emotes. Older clients are told what other players are doing in a server message.
+const EmotesProtocolVersion uint32 = 10
+
+// The first version with the world clock. Older clients aren't told the time, but can still ask with /time.
+const WorldClockProtocolVersion uint32 = 11
+
+// Optional features a client can ask for in its hello
+const (
+	// The client understands PacketBatch, so several packets can be sent in one frame
+	CapabilityBatching = "batching"
+
+	// The client would like frames compressed, if permessage-deflate was negotiated for its websocket
+	CapabilityCompression = "compression"
+)
+
+// The capabilities the server knows how to provide
+var SupportedCapabilities = []string{CapabilityBatching, CapabilityCompression}
@@ -0,0 +1,40 @@
+package password
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const MinLength = 8
+
+// bcrypt ignores anything past this many bytes, so a longer password would only give a false sense of security
+const MaxLength = 72
+
+// Checks a password someone has chosen for themselves is strong enough, returning why not if it isn't
+func Validate(password, username string) error {
+	if len(password) < MinLength {
+		return fmt.Errorf("must be at least %d characters long", MinLength)
+	}
+	if len(password) > MaxLength {
+		return fmt.Errorf("must be at most %d characters long", MaxLength)
+	}
+	if username != "" && strings.Contains(strings.ToLower(password), strings.ToLower(username)) {
+		return errors.New("must not contain your username")
+	}
+
+	hasLetter, hasOther := false, false
+	for _, r := range password {
+		if unicode.IsLetter(r) {
+			hasLetter = true
+		} else if !unicode.IsSpace(r) {
+			hasOther = true
+		}
+	}
+	if !hasLetter || !hasOther {
+		return errors.New("must contain at least one letter and at least one number or symbol")
+	}
+
+	return nil
+}
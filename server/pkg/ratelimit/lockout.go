@@ -0,0 +1,112 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Counts strikes against keys (e.g. IP addresses or usernames) and locks a key out once it racks up too many within a
+// window. Each lockout a key earns is twice as long as its last, up to a maximum. Safe for concurrent use.
+type Lockout struct {
+	maxStrikes  int
+	window      time.Duration
+	baseLockout time.Duration
+	maxLockout  time.Duration
+
+	entries map[string]*lockoutEntry
+	mux     sync.Mutex
+
+	lastPrune time.Time
+}
+
+type lockoutEntry struct {
+	strikes     []time.Time // Within the window, oldest first
+	lockouts    int         // How many times the key has been locked out, so the next one can be longer
+	lockedUntil time.Time
+	lastStrike  time.Time
+}
+
+func NewLockout(maxStrikes int, window, baseLockout, maxLockout time.Duration) *Lockout {
+	return &Lockout{
+		maxStrikes:  maxStrikes,
+		window:      window,
+		baseLockout: baseLockout,
+		maxLockout:  maxLockout,
+		entries:     make(map[string]*lockoutEntry),
+	}
+}
+
+// Returns how much longer the key is locked out for, or 0 if it isn't
+func (l *Lockout) Remaining(key string) time.Duration {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	entry, exists := l.entries[key]
+	if !exists {
+		return 0
+	}
+	return max(0, time.Until(entry.lockedUntil))
+}
+
+// Counts a strike against the key. If that's one too many, the key is locked out and the length of the lockout is
+// returned, otherwise 0.
+func (l *Lockout) Strike(key string) time.Duration {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	now := time.Now()
+	l.prune(now)
+
+	entry, exists := l.entries[key]
+	if !exists {
+		entry = &lockoutEntry{}
+		l.entries[key] = entry
+	}
+
+	cutoff := now.Add(-l.window)
+	for len(entry.strikes) > 0 && entry.strikes[0].Before(cutoff) {
+		entry.strikes = entry.strikes[1:]
+	}
+	entry.strikes = append(entry.strikes, now)
+	entry.lastStrike = now
+
+	if len(entry.strikes) < l.maxStrikes {
+		return 0
+	}
+
+	lockout := l.baseLockout << min(entry.lockouts, 30)
+	if lockout <= 0 || lockout > l.maxLockout {
+		lockout = l.maxLockout
+	}
+	entry.lockouts++
+	entry.lockedUntil = now.Add(lockout)
+	entry.strikes = nil
+	return lockout
+}
+
+// Forgets the key's strikes, e.g. after a successful login. Lockouts it has already earned still count towards how
+// long the next one will be, until the key has gone quiet for a while.
+func (l *Lockout) Clear(key string) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	if entry, exists := l.entries[key]; exists {
+		entry.strikes = nil
+	}
+}
+
+// Forgets keys that haven't had a strike for long enough that they'd start from scratch anyway, so the map doesn't
+// grow forever. Must be called with the lock held.
+func (l *Lockout) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < l.window {
+		return
+	}
+	l.lastPrune = now
+
+	forgetAfter := max(l.window, l.maxLockout)
+	for key, entry := range l.entries {
+		if now.Sub(entry.lastStrike) > forgetAfter && now.After(entry.lockedUntil) {
+			delete(l.entries, key)
+		}
+	}
+}